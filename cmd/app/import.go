@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	adminapp_artist "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	adminapp_event "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	adminapp_order "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	adminapp_promotor "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/promotor"
+	adminapp_taxonomy "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/taxonomy"
+	adminapp_ticket "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	adminapp_venue "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	customerapp_follow "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/follow"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/applogger"
+	"github.com/tsel-ticketmaster/tm-event/pkg/kafka"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+	"github.com/tsel-ticketmaster/tm-event/pkg/validator"
+)
+
+const importEventsUsage = "usage: app import-events [-format CSV|JSONL] [-mode ALL_OR_NOTHING|PER_EVENT] [-dry-run] [-report errors.csv] <file>"
+
+// importEvents runs the "app import-events" command, it creates the events of the file as the admin's app does and
+// prints the outcome of every event.
+func importEvents(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("import-events", flag.ContinueOnError)
+	format := flags.String("format", "", "format of the file, taken from its extension by default")
+	mode := flags.String("mode", adminapp_event.EventImportModeAllOrNothing, "ALL_OR_NOTHING or PER_EVENT")
+	dryRun := flags.Bool("dry-run", false, "only report what would be created")
+	reportFile := flags.String("report", "", "file to write the csv of the errors to")
+	if err := flags.Parse(args); err != nil {
+		return errors.New(importEventsUsage)
+	}
+	if flags.NArg() != 1 {
+		return errors.New(importEventsUsage)
+	}
+
+	file := flags.Arg(0)
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	req := adminapp_event.ImportEventsRequest{
+		Format:  strings.ToUpper(*format),
+		Mode:    strings.ToUpper(*mode),
+		DryRun:  *dryRun,
+		Content: content,
+	}
+
+	validate := validator.Get()
+	if err := validation.Struct(ctx, validate, req); err != nil {
+		return err
+	}
+
+	logger := applogger.GetLogrus()
+
+	psqldb, err := postgresql.GetDatabase()
+	if err != nil {
+		return err
+	}
+	defer psqldb.Close()
+
+	txManager := postgresql.NewTxManager(postgresql.TxManagerProperty{
+		DB:         psqldb,
+		MaxRetries: c.Postgresql.TxMaxRetries,
+		Backoff:    c.Postgresql.TxBackoff,
+	})
+
+	// the followers are notified of the created events as they are by the admin's app, the notifications are flushed
+	// when the command returns
+	publisher := pubsub.PublisherFromConfluentKafkaProducer(logger, kafka.NewProducer())
+	defer publisher.Close()
+
+	followNotifier := customerapp_follow.NewNotifier(customerapp_follow.NotifierProperty{
+		Logger:                 logger,
+		FollowRepository:       customerapp_follow.NewFollowRepository(logger, psqldb),
+		EventRepository:        customerapp_follow.NewEventRepository(logger, psqldb),
+		NotificationRepository: customerapp_follow.NewNotificationRepository(logger, psqldb),
+		Publisher:              publisher,
+	})
+
+	eventUseCase := adminapp_event.NewEventUseCase(adminapp_event.EventUseCaseProperty{
+		Logger:                       logger,
+		Location:                     c.Application.Timezone,
+		Timeout:                      c.Application.Timeout,
+		Validate:                     validate,
+		EventRepository:              adminapp_event.NewEventRepository(logger, psqldb),
+		ArtistRepository:             adminapp_event.NewArtistRepository(logger, psqldb),
+		PromotorRepository:           adminapp_event.NewPromotorRepository(logger, psqldb),
+		ShowRepository:               adminapp_event.NewShowRepository(logger, psqldb),
+		LocationRepository:           adminapp_event.NewLocationRepository(logger, psqldb),
+		EventTranslationRepository:   adminapp_event.NewEventTranslationRepository(logger, psqldb),
+		ShowTranslationRepository:    adminapp_event.NewShowTranslationRepository(logger, psqldb),
+		TaxonomyRepository:           adminapp_event.NewTaxonomyRepository(logger, psqldb),
+		OrderRuleDayRepository:       adminapp_order.NewOrderRuleDayRepository(logger, psqldb),
+		OrderRuleRangeDateRepository: adminapp_order.NewOrderRuleRangeDateRepository(logger, psqldb),
+		TicketStockRepository:        adminapp_ticket.NewTicketStockRepository(logger, psqldb),
+		VenueRepository:              adminapp_venue.NewVenueRepository(logger, psqldb),
+		ArtistRegistryRepository:     adminapp_artist.NewArtistRepository(logger, psqldb),
+		PromotorRegistryRepository:   adminapp_promotor.NewPromotorRepository(logger, psqldb),
+		TaxonomyRegistryRepository:   adminapp_taxonomy.NewTaxonomyRepository(logger, psqldb),
+		FollowerNotifier:             followNotifier,
+		TxManager:                    txManager,
+	})
+
+	resp, err := eventUseCase.ImportEvents(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ROW\tEVENT\tSTATUS\tEVENT ID")
+	for _, e := range resp.Events {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", e.Row, e.Ref, e.Status, e.EventID)
+	}
+	w.Flush()
+
+	fmt.Printf("%d events, %d created, %d failed\n", resp.Total, resp.Created, resp.Failed)
+	for _, e := range resp.Errors {
+		fmt.Printf("row %d: %s: %s\n", e.Row, e.Field, e.Message)
+	}
+
+	if *reportFile != "" {
+		report, err := resp.ErrorReportCSV()
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*reportFile, report, 0o644); err != nil {
+			return err
+		}
+	}
+
+	if resp.Failed > 0 {
+		return fmt.Errorf("import-events: %d of %d events are not valid", resp.Failed, resp.Total)
+	}
+
+	return nil
+}
@@ -11,15 +11,35 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 	"github.com/tsel-ticketmaster/tm-event/config"
+	adminapp_artist "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	adminapp_attendee "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/attendee"
 	adminapp_event "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	adminapp_hold "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/hold"
+	adminapp_media "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/media"
 	adminapp_order "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	adminapp_pricing "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/pricing"
+	adminapp_promo "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/promo"
+	adminapp_promotor "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/promotor"
+	adminapp_report "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/report"
+	adminapp_seat "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/seat"
+	adminapp_taxonomy "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/taxonomy"
 	adminapp_ticket "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	adminapp_venue "github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	customerapp_artist "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/artist"
 	customerapp_event "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/event"
+	customerapp_follow "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/follow"
+	customerapp_media "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/media"
+	customerapp_promo "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/promo"
+	customerapp_promotor "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/promotor"
+	customerapp_seat "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/seat"
+	customerapp_taxonomy "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/taxonomy"
 	customerapp_ticket "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	customerapp_waitlist "github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/waitlist"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/jwt"
 	internalMiddleare "github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
 	"github.com/tsel-ticketmaster/tm-event/pkg/applogger"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
 	"github.com/tsel-ticketmaster/tm-event/pkg/kafka"
 	"github.com/tsel-ticketmaster/tm-event/pkg/middleware"
 	"github.com/tsel-ticketmaster/tm-event/pkg/monitoring"
@@ -27,6 +47,7 @@ import (
 	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
 	"github.com/tsel-ticketmaster/tm-event/pkg/redis"
 	"github.com/tsel-ticketmaster/tm-event/pkg/server"
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
 	"github.com/tsel-ticketmaster/tm-event/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 )
@@ -47,6 +68,24 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate(ctx, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			cancel()
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "import-events" {
+		if err := importEvents(ctx, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			cancel()
+			os.Exit(1)
+		}
+		return
+	}
+
 	logger := applogger.GetLogrus()
 
 	mon := monitoring.NewOpenTelemetry(
@@ -61,11 +100,24 @@ func main() {
 
 	jsonWebToken := jwt.NewJSONWebToken(c.JWT.PrivateKey, c.JWT.PublicKey)
 
-	psqldb := postgresql.GetDatabase()
-	if err := psqldb.Ping(); err != nil {
+	psqldb, err := postgresql.GetDatabase()
+	if err != nil {
+		logger.WithContext(ctx).WithError(err).Fatal()
+	}
+	if err := psqldb.Ping(context.Background()); err != nil {
 		logger.WithContext(ctx).WithError(err).Error()
 	}
 
+	// the customer catalog reads are balanced over the read replicas, the writes and the locking reads stay on the
+	// primary
+	replicaSet := postgresql.GetReplicaSet()
+
+	txManager := postgresql.NewTxManager(postgresql.TxManagerProperty{
+		DB:         psqldb,
+		MaxRetries: c.Postgresql.TxMaxRetries,
+		Backoff:    c.Postgresql.TxBackoff,
+	})
+
 	publisher := pubsub.PublisherFromConfluentKafkaProducer(logger, kafka.NewProducer())
 
 	rc := redis.GetClient()
@@ -83,54 +135,323 @@ func main() {
 		otelmux.Middleware(c.Application.Name),
 		middleware.HTTPResponseTraceInjection,
 		middleware.NewHTTPRequestLogger(logger, c.Application.Debug).Middleware,
+		i18n.Middleware,
+		postgresql.ReadYourWritesMiddleware,
 	)
 
+	// the media are stored on the local file system and served by the app itself, the storage is meant to be replaced
+	// by an object storage in production
+	mediaStorage := storage.NewLocalStorage(c.Media.Local.Dir, c.Media.BaseURL)
+	router.PathPrefix("/tm-event/v1/media/").Handler(http.StripPrefix("/tm-event/v1/media/", http.FileServer(http.Dir(mediaStorage.Dir()))))
+
+	// the followers of the events and the artists are notified by both of the apps, the admin's app adds the shows
+	// while the customer's app sells the tickets
+	customerappFollowRepo := customerapp_follow.NewFollowRepository(logger, psqldb)
+	customerappFollowEventRepo := customerapp_follow.NewEventRepository(logger, psqldb)
+	customerappFollowArtistRepo := customerapp_follow.NewArtistRepository(logger, psqldb)
+	customerappFollowNotificationRepo := customerapp_follow.NewNotificationRepository(logger, psqldb)
+	customerappFollowNotifier := customerapp_follow.NewNotifier(customerapp_follow.NotifierProperty{
+		Logger:                 logger,
+		FollowRepository:       customerappFollowRepo,
+		EventRepository:        customerappFollowEventRepo,
+		NotificationRepository: customerappFollowNotificationRepo,
+		Publisher:              publisher,
+	})
+
+	// the tickets which are back on the sale are offered to the waitlists first, they are returned by both of the apps
+	customerappTicketStockRepo := customerapp_ticket.NewTicketStockRepository(logger, psqldb)
+	customerappWaitlistEntryRepo := customerapp_waitlist.NewWaitlistEntryRepository(logger, psqldb)
+	customerappWaitlistOfferer := customerapp_waitlist.NewOfferer(customerapp_waitlist.OffererProperty{
+		Logger:                  logger,
+		WaitlistEntryRepository: customerappWaitlistEntryRepo,
+		TicketStockRepository:   customerappTicketStockRepo,
+		Publisher:               publisher,
+		TxManager:               txManager,
+	})
+
 	// admin's app
+	adminappVenueRepository := adminapp_venue.NewVenueRepository(logger, psqldb)
+	adminappVenueUseCase := adminapp_venue.NewVenueUseCase(adminapp_venue.VenueUseCaseProperty{
+		Logger:          logger,
+		Location:        c.Application.Timezone,
+		Timeout:         c.Application.Timeout,
+		VenueRepository: adminappVenueRepository,
+	})
+	adminapp_venue.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappVenueUseCase)
+	adminappArtistRegistryRepository := adminapp_artist.NewArtistRepository(logger, psqldb)
+	adminappArtistUseCase := adminapp_artist.NewArtistUseCase(adminapp_artist.ArtistUseCaseProperty{
+		Logger:           logger,
+		Location:         c.Application.Timezone,
+		Timeout:          c.Application.Timeout,
+		ArtistRepository: adminappArtistRegistryRepository,
+		TxManager:        txManager,
+	})
+	adminapp_artist.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappArtistUseCase)
+	adminappPromotorRegistryRepository := adminapp_promotor.NewPromotorRepository(logger, psqldb)
+	adminappPromotorUseCase := adminapp_promotor.NewPromotorUseCase(adminapp_promotor.PromotorUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		PromotorRepository: adminappPromotorRegistryRepository,
+		TxManager:          txManager,
+	})
+	adminapp_promotor.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappPromotorUseCase)
+	adminappTaxonomyRegistryRepository := adminapp_taxonomy.NewTaxonomyRepository(logger, psqldb)
+	adminappTaxonomyUseCase := adminapp_taxonomy.NewTaxonomyUseCase(adminapp_taxonomy.TaxonomyUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		TaxonomyRepository: adminappTaxonomyRegistryRepository,
+	})
+	adminapp_taxonomy.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappTaxonomyUseCase)
 	adminappEventRepository := adminapp_event.NewEventRepository(logger, psqldb)
-	adminappArtistRepository := adminapp_event.NewArtistRepository(logger, psqldb)
-	adminappPromotorRepository := adminapp_event.NewPromotorRepository(logger, psqldb)
+	adminappEventArtistRepository := adminapp_event.NewArtistRepository(logger, psqldb)
+	adminappEventPromotorRepository := adminapp_event.NewPromotorRepository(logger, psqldb)
 	adminappShowRepository := adminapp_event.NewShowRepository(logger, psqldb)
 	adminappLocationRepository := adminapp_event.NewLocationRepository(logger, psqldb)
-	adminappOrderRuleRangeDateRepository := adminapp_order.NewOrderRuleRangeDateRepository(logger, psqldb)
+	adminappEventTranslationRepository := adminapp_event.NewEventTranslationRepository(logger, psqldb)
+	adminappShowTranslationRepository := adminapp_event.NewShowTranslationRepository(logger, psqldb)
+	adminappEventTaxonomyRepository := adminapp_event.NewTaxonomyRepository(logger, psqldb)
 	adminappOrderRuleDayRepository := adminapp_order.NewOrderRuleDayRepository(logger, psqldb)
+	adminappOrderRuleRangeDateRepository := adminapp_order.NewOrderRuleRangeDateRepository(logger, psqldb)
 	adminappTicketStockRepository := adminapp_ticket.NewTicketStockRepository(logger, psqldb)
 	adminappEventUseCase := adminapp_event.NewEventUseCase(adminapp_event.EventUseCaseProperty{
 		Logger:                       logger,
 		Location:                     c.Application.Timezone,
 		Timeout:                      c.Application.Timeout,
+		Validate:                     validate,
 		EventRepository:              adminappEventRepository,
-		ArtistRepository:             adminappArtistRepository,
-		PromotorRepository:           adminappPromotorRepository,
+		ArtistRepository:             adminappEventArtistRepository,
+		PromotorRepository:           adminappEventPromotorRepository,
 		ShowRepository:               adminappShowRepository,
 		LocationRepository:           adminappLocationRepository,
+		EventTranslationRepository:   adminappEventTranslationRepository,
+		ShowTranslationRepository:    adminappShowTranslationRepository,
+		TaxonomyRepository:           adminappEventTaxonomyRepository,
 		OrderRuleDayRepository:       adminappOrderRuleDayRepository,
 		OrderRuleRangeDateRepository: adminappOrderRuleRangeDateRepository,
 		TicketStockRepository:        adminappTicketStockRepository,
+		VenueRepository:              adminappVenueRepository,
+		ArtistRegistryRepository:     adminappArtistRegistryRepository,
+		PromotorRegistryRepository:   adminappPromotorRegistryRepository,
+		TaxonomyRegistryRepository:   adminappTaxonomyRegistryRepository,
+		FollowerNotifier:             customerappFollowNotifier,
+		TxManager:                    txManager,
 	})
 	adminapp_event.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappEventUseCase)
+	adminappSeatMapRepository := adminapp_seat.NewSeatMapRepository(logger, psqldb)
+	adminappSeatRepository := adminapp_seat.NewSeatRepository(logger, psqldb)
+	adminappShowSeatRepository := adminapp_seat.NewShowSeatRepository(logger, psqldb)
+	adminappSeatUseCase := adminapp_seat.NewSeatUseCase(adminapp_seat.SeatUseCaseProperty{
+		Logger:                logger,
+		Location:              c.Application.Timezone,
+		Timeout:               c.Application.Timeout,
+		SeatMapRepository:     adminappSeatMapRepository,
+		SeatRepository:        adminappSeatRepository,
+		ShowSeatRepository:    adminappShowSeatRepository,
+		TicketStockRepository: adminappTicketStockRepository,
+		VenueRepository:       adminappVenueRepository,
+		TxManager:             txManager,
+	})
+	adminapp_seat.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappSeatUseCase)
+	adminappPriceRuleRepository := adminapp_pricing.NewPriceRuleRepository(logger, psqldb)
+	adminappPriceChangeRepository := adminapp_pricing.NewPriceChangeRepository(logger, psqldb)
+	adminappPricingUseCase := adminapp_pricing.NewPricingUseCase(adminapp_pricing.PricingUseCaseProperty{
+		Logger:                logger,
+		Location:              c.Application.Timezone,
+		Timeout:               c.Application.Timeout,
+		PriceRuleRepository:   adminappPriceRuleRepository,
+		PriceChangeRepository: adminappPriceChangeRepository,
+		TicketStockRepository: adminappTicketStockRepository,
+		TxManager:             txManager,
+	})
+	adminapp_pricing.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappPricingUseCase)
+	adminappPromoCodeRepository := adminapp_promo.NewPromoCodeRepository(logger, psqldb)
+	adminappPromoUseCase := adminapp_promo.NewPromoUseCase(adminapp_promo.PromoUseCaseProperty{
+		Logger:              logger,
+		Location:            c.Application.Timezone,
+		Timeout:             c.Application.Timeout,
+		PromoCodeRepository: adminappPromoCodeRepository,
+		EventRepository:     adminappEventRepository,
+		ShowRepository:      adminappShowRepository,
+	})
+	adminapp_promo.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappPromoUseCase)
+	adminappTicketHoldRepository := adminapp_hold.NewTicketHoldRepository(logger, psqldb)
+	adminappAcquiredTicketRepository := adminapp_ticket.NewAcquiredTicketRepository(logger, psqldb)
+	adminappHoldUseCase := adminapp_hold.NewHoldUseCase(adminapp_hold.HoldUseCaseProperty{
+		Logger:                   logger,
+		Location:                 c.Application.Timezone,
+		Timeout:                  c.Application.Timeout,
+		TicketHoldRepository:     adminappTicketHoldRepository,
+		TicketStockRepository:    adminappTicketStockRepository,
+		AcquiredTicketRepository: adminappAcquiredTicketRepository,
+		EventRepository:          adminappEventRepository,
+		ShowRepository:           adminappShowRepository,
+		LocationRepository:       adminappLocationRepository,
+		VenueRepository:          adminappVenueRepository,
+		Publisher:                publisher,
+		WaitlistOfferer:          customerappWaitlistOfferer,
+		TxManager:                txManager,
+	})
+	adminapp_hold.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappHoldUseCase)
+	adminappTicketStockUseCase := adminapp_ticket.NewTicketStockUseCase(adminapp_ticket.TicketStockUseCaseProperty{
+		Logger:                logger,
+		Location:              c.Application.Timezone,
+		Timeout:               c.Application.Timeout,
+		TicketStockRepository: adminappTicketStockRepository,
+		VenueRepository:       adminappVenueRepository,
+		WaitlistOfferer:       customerappWaitlistOfferer,
+		TxManager:             txManager,
+	})
+	adminapp_ticket.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappTicketStockUseCase)
+
+	adminappMediaRepository := adminapp_media.NewMediaRepository(logger, psqldb)
+	adminappMediaVariantRepository := adminapp_media.NewMediaVariantRepository(logger, psqldb)
+	adminappMediaUseCase := adminapp_media.NewMediaUseCase(adminapp_media.MediaUseCaseProperty{
+		Logger:                 logger,
+		Timeout:                c.Application.Timeout,
+		Storage:                mediaStorage,
+		MediaRepository:        adminappMediaRepository,
+		MediaVariantRepository: adminappMediaVariantRepository,
+		EventRepository:        adminappEventRepository,
+		ArtistRepository:       adminappArtistRegistryRepository,
+		TxManager:              txManager,
+	})
+	adminapp_media.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappMediaUseCase)
+	adminappSalesRepository := adminapp_report.NewSalesRepository(logger, psqldb, replicaSet)
+	adminappReportUseCase := adminapp_report.NewReportUseCase(adminapp_report.ReportUseCaseProperty{
+		Logger:          logger,
+		Location:        c.Application.Timezone,
+		Timeout:         c.Application.Timeout,
+		SalesRepository: adminappSalesRepository,
+	})
+	adminapp_report.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappReportUseCase)
+	adminappAttendeeRepository := adminapp_attendee.NewAttendeeRepository(logger, psqldb)
+	adminappAttendeeUseCase := adminapp_attendee.NewAttendeeUseCase(adminapp_attendee.AttendeeUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		AttendeeRepository: adminappAttendeeRepository,
+		EventRepository:    adminappEventRepository,
+		ShowRepository:     adminappShowRepository,
+		PromotorRepository: adminappEventPromotorRepository,
+		TxManager:          txManager,
+	})
+	adminapp_attendee.InitHTTPHandler(router, adminSessionMiddleware, validate, adminappAttendeeUseCase)
 
 	// customer's app
-	customerappEventRepo := customerapp_event.NewEventRepository(logger, psqldb)
-	customerappShowRepo := customerapp_event.NewShowRepository(logger, psqldb)
+	customerappMediaRepo := customerapp_media.NewMediaRepository(logger, psqldb)
+	customerappEventRepo := customerapp_event.NewEventRepository(logger, psqldb, replicaSet)
+	customerappShowRepo := customerapp_event.NewShowRepository(logger, psqldb, replicaSet)
 	customerappLocationRepo := customerapp_event.NewLocationRepository(logger, psqldb)
-	customerappArtistRepo := customerapp_event.NewArtistRepository(logger, psqldb)
-	customerappPromotorRepo := customerapp_event.NewPromotorRepository(logger, psqldb)
-	customerappTicketStockRepo := customerapp_ticket.NewTicketStockRepository(logger, psqldb)
+	customerappEventTranslationRepo := customerapp_event.NewEventTranslationRepository(logger, psqldb)
+	customerappShowTranslationRepo := customerapp_event.NewShowTranslationRepository(logger, psqldb)
+	customerappTaxonomyRepo := customerapp_event.NewTaxonomyRepository(logger, psqldb)
+	customerappVenueRepo := customerapp_event.NewVenueRepository(logger, psqldb)
+	customerappArtistRepo := customerapp_event.NewArtistRepository(logger, psqldb, replicaSet)
+	customerappPromotorRepo := customerapp_event.NewPromotorRepository(logger, psqldb, replicaSet)
 	customerappAcquiredTicketRepo := customerapp_ticket.NewAcquiredTicketRepository(logger, psqldb)
+	customerappPriceRuleRepo := customerapp_ticket.NewPriceRuleRepository(logger, psqldb)
+	customerappPriceChangeRepo := customerapp_ticket.NewPriceChangeRepository(logger, psqldb)
+	customerappPriceQuoteRepo := customerapp_ticket.NewPriceQuoteRepository(logger, psqldb)
+	customerappShowSeatRepo := customerapp_seat.NewShowSeatRepository(logger, psqldb)
+	customerappPromoCodeRepo := customerapp_promo.NewPromoCodeRepository(logger, psqldb)
+	customerappPromoRedemptionRepo := customerapp_promo.NewPromoRedemptionRepository(logger, psqldb)
 	customerappEventUseCase := customerapp_event.NewEventUseCase(customerapp_event.EventUseCaseProperty{
-		Logger:                   logger,
-		Location:                 c.Application.Timezone,
-		Timeout:                  c.Application.Timeout,
-		EventRepository:          customerappEventRepo,
-		ArtistRepository:         customerappArtistRepo,
-		PromotorRepository:       customerappPromotorRepo,
-		ShowRepository:           customerappShowRepo,
-		LocationRepository:       customerappLocationRepo,
-		TicketStockRepository:    customerappTicketStockRepo,
-		AcquiredTicketRepository: customerappAcquiredTicketRepo,
-		Publisher:                publisher,
+		Logger:                     logger,
+		Location:                   c.Application.Timezone,
+		Timeout:                    c.Application.Timeout,
+		EventRepository:            customerappEventRepo,
+		ArtistRepository:           customerappArtistRepo,
+		PromotorRepository:         customerappPromotorRepo,
+		ShowRepository:             customerappShowRepo,
+		LocationRepository:         customerappLocationRepo,
+		EventTranslationRepository: customerappEventTranslationRepo,
+		ShowTranslationRepository:  customerappShowTranslationRepo,
+		TaxonomyRepository:         customerappTaxonomyRepo,
+		VenueRepository:            customerappVenueRepo,
+		TicketStockRepository:      customerappTicketStockRepo,
+		AcquiredTicketRepository:   customerappAcquiredTicketRepo,
+		PriceRuleRepository:        customerappPriceRuleRepo,
+		PriceChangeRepository:      customerappPriceChangeRepo,
+		PriceQuoteRepository:       customerappPriceQuoteRepo,
+		ShowSeatRepository:         customerappShowSeatRepo,
+		PromoCodeRepository:        customerappPromoCodeRepo,
+		PromoRedemptionRepository:  customerappPromoRedemptionRepo,
+		WaitlistEntryRepository:    customerappWaitlistEntryRepo,
+		MediaRepository:            customerappMediaRepo,
+		Storage:                    mediaStorage,
+		Publisher:                  publisher,
+		FollowNotifier:             customerappFollowNotifier,
+		WaitlistOfferer:            customerappWaitlistOfferer,
+		TxManager:                  txManager,
 	})
 	customerapp_event.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappEventUseCase)
+	customerappArtistRegistryRepo := customerapp_artist.NewArtistRepository(logger, psqldb)
+	customerappArtistUseCase := customerapp_artist.NewArtistUseCase(customerapp_artist.ArtistUseCaseProperty{
+		Logger:           logger,
+		Location:         c.Application.Timezone,
+		Timeout:          c.Application.Timeout,
+		ArtistRepository: customerappArtistRegistryRepo,
+		MediaRepository:  customerappMediaRepo,
+		Storage:          mediaStorage,
+	})
+	customerapp_artist.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappArtistUseCase)
+	customerappPromotorRegistryRepo := customerapp_promotor.NewPromotorRepository(logger, psqldb)
+	customerappPromotorUseCase := customerapp_promotor.NewPromotorUseCase(customerapp_promotor.PromotorUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		PromotorRepository: customerappPromotorRegistryRepo,
+	})
+	customerapp_promotor.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappPromotorUseCase)
+	customerappTaxonomyRegistryRepo := customerapp_taxonomy.NewTaxonomyRepository(logger, psqldb, replicaSet)
+	customerappTaxonomyUseCase := customerapp_taxonomy.NewTaxonomyUseCase(customerapp_taxonomy.TaxonomyUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		TaxonomyRepository: customerappTaxonomyRegistryRepo,
+	})
+	customerapp_taxonomy.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappTaxonomyUseCase)
+	customerappSeatUseCase := customerapp_seat.NewSeatUseCase(customerapp_seat.SeatUseCaseProperty{
+		Logger:             logger,
+		Location:           c.Application.Timezone,
+		Timeout:            c.Application.Timeout,
+		ShowSeatRepository: customerappShowSeatRepo,
+		TxManager:          txManager,
+	})
+	customerapp_seat.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappSeatUseCase)
+	customerappPromoUseCase := customerapp_promo.NewPromoUseCase(customerapp_promo.PromoUseCaseProperty{
+		Logger:                       logger,
+		Location:                     c.Application.Timezone,
+		Timeout:                      c.Application.Timeout,
+		PromoCodeRepository:          customerappPromoCodeRepo,
+		PromoRedemptionRepository:    customerappPromoRedemptionRepo,
+		TicketStockRepository:        customerappTicketStockRepo,
+		PriceRuleRepository:          customerappPriceRuleRepo,
+		OrderRuleRangeDateRepository: adminappOrderRuleRangeDateRepository,
+		TxManager:                    txManager,
+	})
+	customerapp_promo.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappPromoUseCase)
+	customerappFollowUseCase := customerapp_follow.NewFollowUseCase(customerapp_follow.FollowUseCaseProperty{
+		Logger:           logger,
+		Location:         c.Application.Timezone,
+		Timeout:          c.Application.Timeout,
+		FollowRepository: customerappFollowRepo,
+		EventRepository:  customerappFollowEventRepo,
+		ArtistRepository: customerappFollowArtistRepo,
+	})
+	customerapp_follow.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappFollowUseCase)
+	customerappWaitlistUseCase := customerapp_waitlist.NewWaitlistUseCase(customerapp_waitlist.WaitlistUseCaseProperty{
+		Logger:                  logger,
+		Location:                c.Application.Timezone,
+		Timeout:                 c.Application.Timeout,
+		WaitlistEntryRepository: customerappWaitlistEntryRepo,
+		TicketStockRepository:   customerappTicketStockRepo,
+		Offerer:                 customerappWaitlistOfferer,
+		TxManager:               txManager,
+	})
+	customerapp_waitlist.InitHTTPHandler(router, customerSessionMiddleware, validate, customerappWaitlistUseCase)
 	orderPaidSubscriber := pubsub.SubscriberFromConfluentKafkaConsumer(pubsub.ConfluentKafkaConsumerProperty{
 		Logger: logger,
 		Topic:  "order-paid",
@@ -140,6 +461,29 @@ func main() {
 		Consumer: kafka.NewConsumer(CustomerApp, true),
 	})
 	orderPaidSubscriber.Subscribe()
+	orderRefundedSubscriber := pubsub.SubscriberFromConfluentKafkaConsumer(pubsub.ConfluentKafkaConsumerProperty{
+		Logger: logger,
+		Topic:  customerapp_event.OrderRefundedTopic,
+		EventHandler: &customerapp_event.OrderRefundedEventHandler{
+			EventUseCase: customerappEventUseCase,
+		},
+		Consumer: kafka.NewConsumer(CustomerApp, true),
+	})
+	orderRefundedSubscriber.Subscribe()
+	onSaleScheduler := customerapp_follow.NewOnSaleScheduler(customerapp_follow.OnSaleSchedulerProperty{
+		Logger:   logger,
+		Notifier: customerappFollowNotifier,
+		Interval: customerapp_follow.OnSaleCheckInterval,
+		Timeout:  c.Application.Timeout,
+	})
+	onSaleScheduler.Start()
+	offerScheduler := customerapp_waitlist.NewOfferScheduler(customerapp_waitlist.OfferSchedulerProperty{
+		Logger:   logger,
+		Offerer:  customerappWaitlistOfferer,
+		Interval: customerapp_waitlist.OfferCheckInterval,
+		Timeout:  c.Application.Timeout,
+	})
+	offerScheduler.Start()
 
 	handler := middleware.SetChain(
 		router,
@@ -171,8 +515,12 @@ func main() {
 
 	srv.Shutdown(ctx)
 	orderPaidSubscriber.Close()
+	orderRefundedSubscriber.Close()
+	onSaleScheduler.Stop()
+	offerScheduler.Stop()
 	publisher.Close()
 	psqldb.Close()
+	replicaSet.Close()
 	rc.Close()
 	mon.Stop(ctx)
 }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+const migrateUsage = "usage: app migrate up | down [steps] | status"
+
+// migrate runs the "app migrate" command against the configured database, the pods which start it at the same time
+// wait for each other on the advisory lock of the migrations.
+func migrate(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.New(migrateUsage)
+	}
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	if err != nil {
+		return err
+	}
+
+	psqldb, err := postgresql.GetDatabase()
+	if err != nil {
+		return err
+	}
+	defer psqldb.Close()
+
+	migrator := migration.NewMigrator(psqldb, ms)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %06d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) < 1 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("migrate: invalid steps '%s'", args[1])
+			}
+		}
+
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %06d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) < 1 {
+			fmt.Println("no applied migrations")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%06d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	return nil
+}
@@ -57,6 +57,10 @@ type Config struct {
 		SSLMode      string
 		MaxOpenConns int
 		MaxIdleConns int
+		TxMaxRetries int
+		TxBackoff    time.Duration
+		// ReplicaHosts are the host:port of the read replicas, they share the credentials of the primary.
+		ReplicaHosts []string
 	}
 	Redis struct {
 		Addrs    []string
@@ -76,6 +80,12 @@ type Config struct {
 		ProjectID      string
 		ServiceAccount []byte
 	}
+	Media struct {
+		BaseURL string
+		Local   struct {
+			Dir string
+		}
+	}
 }
 
 func (cfg *Config) application() {
@@ -132,6 +142,17 @@ func (c *Config) postgresql() {
 	c.Postgresql.SSLMode = os.Getenv("POSTGRESQL_SSLMODE")
 	c.Postgresql.MaxOpenConns, _ = strconv.Atoi(os.Getenv("POSTGRESQL_MAX_OPEN_CONNS"))
 	c.Postgresql.MaxIdleConns, _ = strconv.Atoi(os.Getenv("POSTGRESQL_MAX_IDLE_CONNS"))
+	c.Postgresql.TxMaxRetries, _ = strconv.Atoi(os.Getenv("POSTGRESQL_TX_MAX_RETRIES"))
+
+	txBackoffInMs, _ := strconv.Atoi(os.Getenv("POSTGRESQL_TX_BACKOFF"))
+	c.Postgresql.TxBackoff = time.Duration(txBackoffInMs) * time.Millisecond
+
+	c.Postgresql.ReplicaHosts = make([]string, 0)
+	for _, host := range strings.Split(os.Getenv("POSTGRESQL_REPLICA_HOSTS"), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			c.Postgresql.ReplicaHosts = append(c.Postgresql.ReplicaHosts, host)
+		}
+	}
 }
 
 func (cfg *Config) redis() {
@@ -155,6 +176,11 @@ func (cfg *Config) gcp() {
 	cfg.GCP.ProjectID = os.Getenv("GCP_PROJECT_ID")
 }
 
+func (cfg *Config) media() {
+	cfg.Media.BaseURL = os.Getenv("MEDIA_BASE_URL")
+	cfg.Media.Local.Dir = os.Getenv("MEDIA_LOCAL_DIR")
+}
+
 func load() *Config {
 	cfg := new(Config)
 	cfg.application()
@@ -166,6 +192,7 @@ func load() *Config {
 	cfg.redis()
 	cfg.kafka()
 	cfg.gcp()
+	cfg.media()
 	return cfg
 }
 
@@ -0,0 +1,283 @@
+package artist
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ArtistRepository interface {
+	Save(ctx context.Context, a Artist) error
+	FindByID(ctx context.Context, ID string) (Artist, error)
+	FindBySlug(ctx context.Context, slug string) (Artist, error)
+	FindMany(ctx context.Context, offset, limit int) ([]Artist, error)
+	Count(ctx context.Context) (int64, error)
+	CountEvents(ctx context.Context, ID string) (int64, error)
+	Update(ctx context.Context, ID string, a Artist) error
+	Delete(ctx context.Context, ID string) error
+	Merge(ctx context.Context, ID string, duplicateIDs []string) error
+}
+
+type artistRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewArtistRepository(logger *logrus.Logger, db *pgxpool.Pool) ArtistRepository {
+	return &artistRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *artistRepository) findOne(ctx context.Context, query string, arg string) (Artist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	row := cmd.QueryRowContext(ctx, query, arg)
+
+	var data Artist
+	err := row.Scan(&data.ID, &data.Slug, &data.Name, &data.Bio, &data.ImageURL, &data.CreatedAt, &data.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("artist"), arg))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Artist{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting artist's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindByID implements ArtistRepository.
+func (r *artistRepository) FindByID(ctx context.Context, ID string) (Artist, error) {
+	query := `
+		SELECT
+			id, slug, name, bio, image_url, created_at, updated_at
+		FROM artist
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, ID)
+}
+
+// FindBySlug implements ArtistRepository.
+func (r *artistRepository) FindBySlug(ctx context.Context, slug string) (Artist, error) {
+	query := `
+		SELECT
+			id, slug, name, bio, image_url, created_at, updated_at
+		FROM artist
+		WHERE
+			slug = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, slug)
+}
+
+// FindMany implements ArtistRepository.
+func (r *artistRepository) FindMany(ctx context.Context, offset int, limit int) ([]Artist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, slug, name, bio, image_url, created_at, updated_at
+		FROM artist
+		ORDER BY name ASC
+		OFFSET $1
+		LIMIT $2
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of artist's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Artist, 0)
+	for rows.Next() {
+		var a Artist
+		err := rows.Scan(&a.ID, &a.Slug, &a.Name, &a.Bio, &a.ImageURL, &a.CreatedAt, &a.UpdatedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of artist's prorperties")
+		}
+
+		data = append(data, a)
+	}
+
+	return data, nil
+}
+
+// Count implements ArtistRepository.
+func (r *artistRepository) Count(ctx context.Context) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM artist`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of artist's prorperties")
+	}
+
+	return count, nil
+}
+
+// CountEvents implements ArtistRepository.
+func (r *artistRepository) CountEvents(ctx context.Context, ID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(event_id) FROM event_artist WHERE artist_id = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, ID)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting events of artist")
+	}
+
+	return count, nil
+}
+
+// Save implements ArtistRepository.
+func (r *artistRepository) Save(ctx context.Context, a Artist) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO artist
+		(
+			id, slug, name, bio, image_url, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, a.ID, a.Slug, a.Name, a.Bio, a.ImageURL, a.CreatedAt, a.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving artist's prorperties")
+	}
+
+	return nil
+}
+
+// Update implements ArtistRepository.
+func (r *artistRepository) Update(ctx context.Context, ID string, a Artist) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE artist
+		SET
+			slug = $1,
+			name = $2,
+			bio = $3,
+			image_url = $4,
+			updated_at = $5
+		WHERE id = $6
+	`
+
+	_, err := cmd.ExecContext(ctx, query, a.Slug, a.Name, a.Bio, a.ImageURL, a.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating artist's prorperties")
+	}
+
+	return nil
+}
+
+// Delete implements ArtistRepository.
+func (r *artistRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM artist WHERE id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting artist's prorperties")
+	}
+
+	return nil
+}
+
+// Merge implements ArtistRepository. The events, the followers and the media of the duplicates are relinked to
+// the artist with the given id, the ones which are already linked to it are not linked twice, then the duplicates are
+// deleted.
+func (r *artistRepository) Merge(ctx context.Context, ID string, duplicateIDs []string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{
+			query: `
+				INSERT INTO event_artist
+				(
+					event_id, artist_id
+				)
+				SELECT DISTINCT d.event_id, $1
+				FROM event_artist d
+				WHERE
+					d.artist_id = ANY($2)
+					AND NOT EXISTS (
+						SELECT 1 FROM event_artist t WHERE t.event_id = d.event_id AND t.artist_id = $1
+					)
+			`,
+			args: []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM event_artist WHERE artist_id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+		{
+			query: `
+				INSERT INTO follow
+				(
+					customer_id, target_type, target_id, created_at
+				)
+				SELECT customer_id, target_type, $1, MIN(created_at)
+				FROM follow
+				WHERE target_type = 'ARTIST' AND target_id = ANY($2)
+				GROUP BY customer_id, target_type
+				ON CONFLICT (customer_id, target_type, target_id) DO NOTHING
+			`,
+			args: []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM follow WHERE target_type = 'ARTIST' AND target_id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+		{
+			query: `UPDATE media SET owner_id = $1 WHERE owner_type = 'ARTIST' AND owner_id = ANY($2)`,
+			args:  []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM artist WHERE id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+	}
+
+	for _, v := range statements {
+		if _, err := cmd.ExecContext(ctx, v.query, v.args...); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while merging artist's prorperties")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package artist_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+)
+
+// openTestDatabase connects to the throwaway database of POSTGRESQL_TEST_DSN and applies the migrations to it, the
+// test is skipped when there is none.
+func openTestDatabase(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRESQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRESQL_TEST_DSN is not set")
+	}
+
+	db, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	require.NoError(t, err)
+
+	_, err = migration.NewMigrator(db, ms).Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestArtistRepositoryMerge(t *testing.T) {
+	db := openTestDatabase(t)
+	ctx := context.Background()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := artist.NewArtistRepository(logger, db)
+
+	now := time.Now().Truncate(time.Second)
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+	kept := artist.NewArtist("ARTISTTEST1"+suffix, "Kept "+suffix, "", "", now)
+	duplicate := artist.NewArtist("ARTISTTEST2"+suffix, "Duplicate "+suffix, "", "", now)
+	require.NoError(t, repo.Save(ctx, kept))
+	require.NoError(t, repo.Save(ctx, duplicate))
+
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM follow WHERE target_id = ANY($1)`, []string{kept.ID, duplicate.ID})
+		db.Exec(ctx, `DELETE FROM media WHERE owner_id = ANY($1)`, []string{kept.ID, duplicate.ID})
+		db.Exec(ctx, `DELETE FROM artist WHERE id = ANY($1)`, []string{kept.ID, duplicate.ID})
+	})
+
+	// customer 1 follows both of them, customer 2 only the duplicate
+	follows := []struct {
+		customerID int64
+		artistID   string
+	}{
+		{customerID: 1, artistID: kept.ID},
+		{customerID: 1, artistID: duplicate.ID},
+		{customerID: 2, artistID: duplicate.ID},
+	}
+	for _, v := range follows {
+		_, err := db.Exec(ctx, `INSERT INTO follow (customer_id, target_type, target_id, created_at) VALUES ($1, 'ARTIST', $2, $3)`, v.customerID, v.artistID, now)
+		require.NoError(t, err)
+	}
+
+	_, err := db.Exec(ctx, `
+		INSERT INTO media (id, owner_type, owner_id, kind, filename, content_type, width, height, size, created_at)
+		VALUES ($1, 'ARTIST', $2, 'PROFILE', 'a.png', 'image/png', 1, 1, 1, $3)
+	`, "MEDIATEST"+suffix, duplicate.ID, now)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Merge(ctx, kept.ID, []string{duplicate.ID}))
+
+	var followers []int64
+	rows, err := db.Query(ctx, `SELECT customer_id FROM follow WHERE target_type = 'ARTIST' AND target_id = $1 ORDER BY customer_id`, kept.ID)
+	require.NoError(t, err)
+	for rows.Next() {
+		var customerID int64
+		require.NoError(t, rows.Scan(&customerID))
+		followers = append(followers, customerID)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []int64{1, 2}, followers)
+
+	var owner string
+	require.NoError(t, db.QueryRow(ctx, `SELECT owner_id FROM media WHERE id = $1`, "MEDIATEST"+suffix).Scan(&owner))
+	assert.Equal(t, kept.ID, owner)
+
+	var left int
+	require.NoError(t, db.QueryRow(ctx, `SELECT COUNT(*) FROM follow WHERE target_id = $1`, duplicate.ID).Scan(&left))
+	assert.Zero(t, left)
+}
@@ -0,0 +1,30 @@
+package artist
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+type Artist struct {
+	ID        string
+	Slug      string
+	Name      string
+	Bio       string
+	ImageURL  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewArtist returns a new artist which slug is derived from its name.
+func NewArtist(ID, name, bio, imageURL string, now time.Time) Artist {
+	return Artist{
+		ID:        ID,
+		Slug:      util.Slugify(name),
+		Name:      name,
+		Bio:       bio,
+		ImageURL:  imageURL,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
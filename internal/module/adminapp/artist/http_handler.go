@@ -0,0 +1,263 @@
+package artist
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	ArtistUseCase     ArtistUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, artistUseCase ArtistUseCase) {
+	handler := &HTTPHandler{
+		Validate:      validate,
+		ArtistUseCase: artistUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/artists", publicMiddleware.SetRouteChain(handler.CreateArtist, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/artists", publicMiddleware.SetRouteChain(handler.GetManyArtist, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/artists/{artistID}", publicMiddleware.SetRouteChain(handler.GetArtist, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/artists/{artistID}", publicMiddleware.SetRouteChain(handler.UpdateArtist, adminSession.Verify)).Methods(http.MethodPut)
+	router.HandleFunc("/tm-event/v1/adminapp/artists/{artistID}", publicMiddleware.SetRouteChain(handler.DeleteArtist, adminSession.Verify)).Methods(http.MethodDelete)
+	router.HandleFunc("/tm-event/v1/adminapp/artists/{artistID}/merge", publicMiddleware.SetRouteChain(handler.MergeArtist, adminSession.Verify)).Methods(http.MethodPost)
+}
+
+func (handler HTTPHandler) CreateArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := CreateArtistRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.ArtistUseCase.CreateArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("artist"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := GetManyArtistRequest{}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.ArtistUseCase.GetManyArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("artist")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetArtistRequest{
+		ID: vars["artistID"],
+	}
+
+	resp, err := handler.ArtistUseCase.GetArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("artist")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) UpdateArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := UpdateArtistRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req.CreateArtistRequest); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.ID = vars["artistID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.ArtistUseCase.UpdateArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("artist"), i18n.Noun("updated")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) DeleteArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := DeleteArtistRequest{
+		ID: vars["artistID"],
+	}
+
+	if err := handler.ArtistUseCase.DeleteArtist(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("artist"), i18n.Noun("deleted")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) MergeArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := MergeArtistRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.ID = vars["artistID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.ArtistUseCase.MergeArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.DonePlural, i18n.Noun("artists"), i18n.Noun("merged")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,55 @@
+package artist
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreateArtistRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Slug     string `json:"slug" validate:"omitempty,max=128"`
+	Bio      string `json:"bio" validate:"-"`
+	ImageURL string `json:"image_url" validate:"omitempty,url"`
+}
+
+func (r CreateArtistRequest) ToEntityArtist(ctx context.Context, now time.Time) (Artist, error) {
+	a := NewArtist(util.GenerateTimestampWithPrefix("ARTIST"), r.Name, r.Bio, r.ImageURL, now)
+	if r.Slug != "" {
+		a.Slug = util.Slugify(r.Slug)
+	}
+
+	if a.Slug == "" {
+		return Artist{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.NameOrSlugRequired, i18n.Noun("artist")))
+	}
+
+	return a, nil
+}
+
+type UpdateArtistRequest struct {
+	ID string `json:"-"`
+	CreateArtistRequest
+}
+
+type GetManyArtistRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
+
+type GetArtistRequest struct {
+	ID string
+}
+
+type DeleteArtistRequest struct {
+	ID string
+}
+
+type MergeArtistRequest struct {
+	ID           string   `json:"-"`
+	DuplicateIDs []string `json:"duplicate_ids" validate:"required,min=1,unique,dive,required"`
+}
@@ -0,0 +1,28 @@
+package artist
+
+import "time"
+
+type ArtistResponse struct {
+	ID        string    `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	Bio       string    `json:"bio"`
+	ImageURL  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r *ArtistResponse) PopulateFromEntity(a Artist) {
+	r.ID = a.ID
+	r.Slug = a.Slug
+	r.Name = a.Name
+	r.Bio = a.Bio
+	r.ImageURL = a.ImageURL
+	r.CreatedAt = a.CreatedAt
+	r.UpdatedAt = a.UpdatedAt
+}
+
+type GetManyArtistResponse struct {
+	Total   int64            `json:"total"`
+	Artists []ArtistResponse `json:"artists"`
+}
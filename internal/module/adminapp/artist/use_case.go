@@ -0,0 +1,239 @@
+package artist
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type ArtistUseCase interface {
+	CreateArtist(ctx context.Context, req CreateArtistRequest) (ArtistResponse, error)
+	GetManyArtist(ctx context.Context, req GetManyArtistRequest) (GetManyArtistResponse, error)
+	GetArtist(ctx context.Context, req GetArtistRequest) (ArtistResponse, error)
+	UpdateArtist(ctx context.Context, req UpdateArtistRequest) (ArtistResponse, error)
+	DeleteArtist(ctx context.Context, req DeleteArtistRequest) error
+	MergeArtist(ctx context.Context, req MergeArtistRequest) (ArtistResponse, error)
+}
+
+type artistUseCase struct {
+	logger           *logrus.Logger
+	location         *time.Location
+	timeout          time.Duration
+	artistRepository ArtistRepository
+	txManager        postgresql.TxManager
+}
+
+type ArtistUseCaseProperty struct {
+	Logger           *logrus.Logger
+	Location         *time.Location
+	Timeout          time.Duration
+	ArtistRepository ArtistRepository
+	TxManager        postgresql.TxManager
+}
+
+func NewArtistUseCase(props ArtistUseCaseProperty) ArtistUseCase {
+	return &artistUseCase{
+		logger:           props.Logger,
+		location:         props.Location,
+		timeout:          props.Timeout,
+		artistRepository: props.ArtistRepository,
+		txManager:        props.TxManager,
+	}
+}
+
+// ensureUniqueSlug returns conflict if the slug is already taken by another artist.
+func (u *artistUseCase) ensureUniqueSlug(ctx context.Context, a Artist) error {
+	existing, err := u.artistRepository.FindBySlug(ctx, a.Slug)
+	if err != nil {
+		if errors.MatchStatus(err, status.NOT_FOUND) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != a.ID {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.SlugUsed, i18n.Noun("artist"), a.Slug, i18n.Noun("artist"), existing.ID))
+	}
+
+	return nil
+}
+
+// CreateArtist implements ArtistUseCase.
+func (u *artistUseCase) CreateArtist(ctx context.Context, req CreateArtistRequest) (ArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := req.ToEntityArtist(ctx, time.Now())
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	if err := u.ensureUniqueSlug(ctx, a); err != nil {
+		return ArtistResponse{}, err
+	}
+
+	if err := u.artistRepository.Save(ctx, a); err != nil {
+		return ArtistResponse{}, err
+	}
+
+	resp := ArtistResponse{}
+	resp.PopulateFromEntity(a)
+
+	return resp, nil
+}
+
+// GetManyArtist implements ArtistUseCase.
+func (u *artistUseCase) GetManyArtist(ctx context.Context, req GetManyArtistRequest) (GetManyArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var artists []Artist
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.artistRepository.Count(gctx)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.artistRepository.FindMany(gctx, offset, limit)
+		if err != nil {
+			return err
+		}
+		artists = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyArtistResponse{}, err
+	}
+
+	resp := GetManyArtistResponse{
+		Total:   total,
+		Artists: make([]ArtistResponse, len(artists)),
+	}
+
+	for k, v := range artists {
+		resp.Artists[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+// GetArtist implements ArtistUseCase.
+func (u *artistUseCase) GetArtist(ctx context.Context, req GetArtistRequest) (ArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := u.artistRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	resp := ArtistResponse{}
+	resp.PopulateFromEntity(a)
+
+	return resp, nil
+}
+
+// UpdateArtist implements ArtistUseCase.
+func (u *artistUseCase) UpdateArtist(ctx context.Context, req UpdateArtistRequest) (ArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	current, err := u.artistRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	a, err := req.ToEntityArtist(ctx, time.Now())
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+	a.ID = current.ID
+	a.CreatedAt = current.CreatedAt
+
+	if err := u.ensureUniqueSlug(ctx, a); err != nil {
+		return ArtistResponse{}, err
+	}
+
+	if err := u.artistRepository.Update(ctx, a.ID, a); err != nil {
+		return ArtistResponse{}, err
+	}
+
+	resp := ArtistResponse{}
+	resp.PopulateFromEntity(a)
+
+	return resp, nil
+}
+
+// DeleteArtist implements ArtistUseCase. An artist which is still linked to an event can not be deleted.
+func (u *artistUseCase) DeleteArtist(ctx context.Context, req DeleteArtistRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := u.artistRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	count, err := u.artistRepository.CountEvents(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.StillLinked, i18n.Noun("artist"), a.ID, count))
+	}
+
+	return u.artistRepository.Delete(ctx, a.ID)
+}
+
+// MergeArtist implements ArtistUseCase. The duplicates are folded into the artist of the request.
+func (u *artistUseCase) MergeArtist(ctx context.Context, req MergeArtistRequest) (ArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var a Artist
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		a, err = u.artistRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, duplicateID := range req.DuplicateIDs {
+			if duplicateID == a.ID {
+				return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MergeIntoItself, i18n.Noun("artist"), a.ID))
+			}
+
+			if _, err := u.artistRepository.FindByID(ctx, duplicateID); err != nil {
+				return err
+			}
+		}
+
+		return u.artistRepository.Merge(ctx, a.ID, req.DuplicateIDs)
+	})
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	resp := ArtistResponse{}
+	resp.PopulateFromEntity(a)
+
+	return resp, nil
+}
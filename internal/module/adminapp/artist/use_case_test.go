@@ -0,0 +1,176 @@
+package artist_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the use case is not expected to call panics.
+
+// artistStore is an in-memory stand-in of the artist table along with the number of events each artist is linked to,
+// Merge records the duplicates which are folded into an artist.
+type artistStore struct {
+	artist.ArtistRepository
+	artists map[string]artist.Artist
+	events  map[string]int64
+	merged  map[string][]string
+}
+
+func (s artistStore) Save(ctx context.Context, a artist.Artist) error {
+	s.artists[a.ID] = a
+	return nil
+}
+
+func (s artistStore) FindByID(ctx context.Context, ID string) (artist.Artist, error) {
+	a, ok := s.artists[ID]
+	if !ok {
+		return artist.Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return a, nil
+}
+
+func (s artistStore) FindBySlug(ctx context.Context, slug string) (artist.Artist, error) {
+	for _, a := range s.artists {
+		if a.Slug == slug {
+			return a, nil
+		}
+	}
+
+	return artist.Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+func (s artistStore) CountEvents(ctx context.Context, ID string) (int64, error) {
+	return s.events[ID], nil
+}
+
+func (s artistStore) Update(ctx context.Context, ID string, a artist.Artist) error {
+	s.artists[ID] = a
+	return nil
+}
+
+func (s artistStore) Delete(ctx context.Context, ID string) error {
+	delete(s.artists, ID)
+	return nil
+}
+
+func (s artistStore) Merge(ctx context.Context, ID string, duplicateIDs []string) error {
+	s.merged[ID] = append(s.merged[ID], duplicateIDs...)
+	for _, duplicateID := range duplicateIDs {
+		delete(s.artists, duplicateID)
+	}
+
+	return nil
+}
+
+// txManager runs fn right away, the use case of a test is not concurrent.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestArtistUseCase(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	now := time.Now()
+
+	newStore := func() artistStore {
+		return artistStore{
+			artists: map[string]artist.Artist{
+				"ARTIST1": artist.NewArtist("ARTIST1", "Artist A", "", "", now),
+				"ARTIST2": artist.NewArtist("ARTIST2", "Artist A Live", "", "", now),
+				"ARTIST3": artist.NewArtist("ARTIST3", "Artist B", "", "", now),
+			},
+			events: map[string]int64{"ARTIST1": 3},
+			merged: make(map[string][]string),
+		}
+	}
+
+	newUseCase := func(store artistStore) artist.ArtistUseCase {
+		return artist.NewArtistUseCase(artist.ArtistUseCaseProperty{
+			Logger:           logger,
+			Location:         time.UTC,
+			Timeout:          10 * time.Second,
+			ArtistRepository: store,
+			TxManager:        txManager{},
+		})
+	}
+
+	t.Run("an artist is created with the slug of its name", func(t *testing.T) {
+		store := newStore()
+
+		resp, err := newUseCase(store).CreateArtist(context.Background(), artist.CreateArtistRequest{Name: "Artist C"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "artist-c", store.artists[resp.ID].Slug)
+	})
+
+	t.Run("an artist whose slug is taken by another is rejected", func(t *testing.T) {
+		store := newStore()
+		uc := newUseCase(store)
+
+		_, err := uc.CreateArtist(context.Background(), artist.CreateArtistRequest{Name: "Someone", Slug: "Artist B"})
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+		assert.Len(t, store.artists, 3)
+
+		_, err = uc.UpdateArtist(context.Background(), artist.UpdateArtistRequest{ID: "ARTIST1", CreateArtistRequest: artist.CreateArtistRequest{Name: "Artist B"}})
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+
+		// an artist keeps its own slug
+		_, err = uc.UpdateArtist(context.Background(), artist.UpdateArtistRequest{ID: "ARTIST3", CreateArtistRequest: artist.CreateArtistRequest{Name: "Artist B", Bio: "Bio"}})
+		require.NoError(t, err)
+		assert.Equal(t, "Bio", store.artists["ARTIST3"].Bio)
+	})
+
+	t.Run("the duplicates are merged into the artist", func(t *testing.T) {
+		store := newStore()
+
+		resp, err := newUseCase(store).MergeArtist(context.Background(), artist.MergeArtistRequest{ID: "ARTIST1", DuplicateIDs: []string{"ARTIST2"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, "ARTIST1", resp.ID)
+		assert.Equal(t, map[string][]string{"ARTIST1": {"ARTIST2"}}, store.merged)
+		assert.NotContains(t, store.artists, "ARTIST2")
+	})
+
+	t.Run("an artist is not merged into itself nor with an unknown duplicate", func(t *testing.T) {
+		store := newStore()
+		uc := newUseCase(store)
+
+		_, err := uc.MergeArtist(context.Background(), artist.MergeArtistRequest{ID: "ARTIST1", DuplicateIDs: []string{"ARTIST2", "ARTIST1"}})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+
+		_, err = uc.MergeArtist(context.Background(), artist.MergeArtistRequest{ID: "ARTIST1", DuplicateIDs: []string{"ARTIST9"}})
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+
+		assert.Empty(t, store.merged)
+	})
+
+	t.Run("an artist which is still linked to an event is not deleted", func(t *testing.T) {
+		store := newStore()
+		uc := newUseCase(store)
+
+		err := uc.DeleteArtist(context.Background(), artist.DeleteArtistRequest{ID: "ARTIST1"})
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+		assert.Contains(t, store.artists, "ARTIST1")
+
+		err = uc.DeleteArtist(context.Background(), artist.DeleteArtistRequest{ID: "ARTIST3"})
+		require.NoError(t, err)
+		assert.NotContains(t, store.artists, "ARTIST3")
+	})
+}
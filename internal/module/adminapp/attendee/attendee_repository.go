@@ -0,0 +1,157 @@
+package attendee
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// attendeeFetchSize is the number of the attendees which are fetched from the cursor at a time.
+const attendeeFetchSize = 1000
+
+type AttendeeRepository interface {
+	StreamManyByEventID(ctx context.Context, eventID, showID string, fn func(a Attendee) error) error
+	FindByTicketNumber(ctx context.Context, eventID, ticketNumber string) (Attendee, error)
+	CheckIn(ctx context.Context, eventID, ticketNumber string, checkedInAt time.Time) (Attendee, error)
+}
+
+type attendeeRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func scan(row postgresql.Row) (Attendee, error) {
+	var a Attendee
+	var checkedInAt sql.NullTime
+	err := row.Scan(&a.TicketNumber, &a.EventID, &a.ShowID, &a.Tier, &a.Quantity, &a.CustomerName, &a.CustomerEmail, &a.OrderID, &checkedInAt)
+	if err != nil {
+		return Attendee{}, err
+	}
+
+	if checkedInAt.Valid {
+		a.CheckedInAt = &checkedInAt.Time
+	}
+
+	return a, nil
+}
+
+func NewAttendeeRepository(logger *logrus.Logger, db *pgxpool.Pool) AttendeeRepository {
+	return &attendeeRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// StreamManyByEventID implements AttendeeRepository. The attendees of the event, or of its show unless the show id is
+// empty, are read by a server-side cursor in the order their tickets are issued, it requires a transaction. The error
+// of fn is returned as it is.
+func (r *attendeeRepository) StreamManyByEventID(ctx context.Context, eventID, showID string, fn func(a Attendee) error) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT "number", event_id, show_id, tier, quantity, customer_name, customer_email, order_id, checked_in_at
+		FROM acquired_ticket
+		WHERE
+			event_id = $1
+			AND returned_at IS NULL
+		ORDER BY id
+	`
+	args := []any{eventID}
+	if showID != "" {
+		query = `
+			SELECT "number", event_id, show_id, tier, quantity, customer_name, customer_email, order_id, checked_in_at
+			FROM acquired_ticket
+			WHERE
+				show_id = $1
+				AND event_id = $2
+				AND returned_at IS NULL
+			ORDER BY id
+		`
+		args = []any{showID, eventID}
+	}
+
+	var fnErr error
+	err := postgresql.Cursor(ctx, cmd, "attendee_cursor", attendeeFetchSize, query, args, func(rows postgresql.Rows) error {
+		a, err := scan(rows)
+		if err != nil {
+			return err
+		}
+
+		fnErr = fn(a)
+
+		return fnErr
+	})
+	if err != nil {
+		if fnErr != nil {
+			return fnErr
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of attendee's prorperties")
+	}
+
+	return nil
+}
+
+// FindByTicketNumber implements AttendeeRepository.
+func (r *attendeeRepository) FindByTicketNumber(ctx context.Context, eventID, ticketNumber string) (Attendee, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT "number", event_id, show_id, tier, quantity, customer_name, customer_email, order_id, checked_in_at
+		FROM acquired_ticket
+		WHERE
+			event_id = $1
+			AND "number" = $2
+			AND returned_at IS NULL
+		LIMIT 1
+	`
+
+	a, err := scan(cmd.QueryRowContext(ctx, query, eventID, ticketNumber))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Attendee{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByCode, i18n.Noun("ticket"), ticketNumber))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Attendee{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting attendee's prorperties")
+	}
+
+	return a, nil
+}
+
+// CheckIn implements AttendeeRepository. A ticket is checked in once, the update is conditional on the ticket which is
+// not checked in yet so that two scans of the same ticket do not both pass. A ticket which is not found or which is
+// checked in already is not found.
+func (r *attendeeRepository) CheckIn(ctx context.Context, eventID, ticketNumber string, checkedInAt time.Time) (Attendee, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE acquired_ticket
+		SET checked_in_at = $3
+		WHERE
+			event_id = $1
+			AND "number" = $2
+			AND checked_in_at IS NULL
+			AND returned_at IS NULL
+		RETURNING "number", event_id, show_id, tier, quantity, customer_name, customer_email, order_id, checked_in_at
+	`
+
+	a, err := scan(cmd.QueryRowContext(ctx, query, eventID, ticketNumber, checkedInAt))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Attendee{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByCode, i18n.Noun("ticket"), ticketNumber))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Attendee{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating attendee's prorperties")
+	}
+
+	return a, nil
+}
@@ -0,0 +1,59 @@
+package attendee
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/xlsx"
+)
+
+var attendeeColumns = []string{
+	"ticket_number", "event_id", "show_id", "tier", "quantity", "customer_name", "customer_email", "order_id",
+	"check_in_status", "checked_in_at",
+}
+
+func attendeeRecord(a Attendee) []string {
+	checkedInAt := ""
+	if a.CheckedInAt != nil {
+		checkedInAt = a.CheckedInAt.Format(time.RFC3339)
+	}
+
+	return []string{
+		a.TicketNumber, a.EventID, a.ShowID, a.Tier, strconv.FormatInt(a.Quantity, 10), a.CustomerName, a.CustomerEmail,
+		a.OrderID, a.CheckInStatus(), checkedInAt,
+	}
+}
+
+// attendeeEncoder writes the rows of an export in its format.
+type attendeeEncoder interface {
+	Write(record []string) error
+	Flush() error
+	Close() error
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e csvEncoder) Write(record []string) error {
+	return e.w.Write(record)
+}
+
+func (e csvEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e csvEncoder) Close() error {
+	return e.Flush()
+}
+
+func newAttendeeEncoder(format string, w io.Writer) (attendeeEncoder, error) {
+	if format == AttendeeExportFormatXLSX {
+		return xlsx.NewWriter(w, "Attendees")
+	}
+
+	return csvEncoder{w: csv.NewWriter(w)}, nil
+}
@@ -0,0 +1,88 @@
+package attendee
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+)
+
+const (
+	AttendeeExportFormatCSV  string = "CSV"
+	AttendeeExportFormatXLSX string = "XLSX"
+
+	CheckInStatusCheckedIn    string = "CHECKED_IN"
+	CheckInStatusNotCheckedIn string = "NOT_CHECKED_IN"
+)
+
+// Attendee is the holder of an acquired ticket, a ticket without a seat may stand for more than one.
+type Attendee struct {
+	TicketNumber  string
+	EventID       string
+	ShowID        string
+	Tier          string
+	Quantity      int64
+	CustomerName  string
+	CustomerEmail string
+	OrderID       string
+	CheckedInAt   *time.Time
+}
+
+func (a Attendee) CheckInStatus() string {
+	if a.CheckedInAt != nil {
+		return CheckInStatusCheckedIn
+	}
+
+	return CheckInStatusNotCheckedIn
+}
+
+// AttendeeMask tells which of the customer's data are masked.
+type AttendeeMask struct {
+	Name  bool
+	Email bool
+}
+
+// AttendeeMaskOf returns the mask of the role of an admin. The promotors see the names of their attendees, any other
+// role but the admin, including none, sees neither the names nor the emails.
+func AttendeeMaskOf(role string) AttendeeMask {
+	switch role {
+	case session.RoleAdmin:
+		return AttendeeMask{}
+	case session.RolePromotor:
+		return AttendeeMask{Email: true}
+	}
+
+	return AttendeeMask{Name: true, Email: true}
+}
+
+// Masked returns the attendee whose data are masked by the mask. A masked name keeps the initial of every word and a
+// masked email keeps the initial and the domain, e.g. "B*** S***" and "b***@example.com".
+func (a Attendee) Masked(mask AttendeeMask) Attendee {
+	if mask.Name {
+		words := strings.Fields(a.CustomerName)
+		for k, v := range words {
+			words[k] = maskWord(v)
+		}
+		a.CustomerName = strings.Join(words, " ")
+	}
+
+	if mask.Email {
+		local, domain, ok := strings.Cut(a.CustomerEmail, "@")
+		if !ok {
+			a.CustomerEmail = maskWord(a.CustomerEmail)
+		} else {
+			a.CustomerEmail = maskWord(local) + "@" + domain
+		}
+	}
+
+	return a
+}
+
+// maskWord keeps the initial of the word only, the length of the word is not told either.
+func maskWord(word string) string {
+	for _, r := range word {
+		return string(r) + "***"
+	}
+
+	return ""
+}
@@ -0,0 +1,190 @@
+package attendee
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/xlsx"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	AttendeeUseCase   AttendeeUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, attendeeUseCase AttendeeUseCase) {
+	handler := &HTTPHandler{
+		SessionMiddleware: adminSession,
+		Validate:          validate,
+		AttendeeUseCase:   attendeeUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/attendees", publicMiddleware.SetRouteChain(handler.ExportAttendees, handler.SessionMiddleware.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/attendees", publicMiddleware.SetRouteChain(handler.ExportAttendees, handler.SessionMiddleware.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/attendees/{ticketNumber}/check-in", publicMiddleware.SetRouteChain(handler.CheckInAttendee, handler.SessionMiddleware.Verify)).Methods(http.MethodPost)
+}
+
+// exportWriter sends the headers of the export along with its first bytes so that an error which occurs before is
+// still responded as json, every write is flushed to the client. The writer is flushed through the ones of the
+// middlewares which wrap it, e.g. the one of i18n.
+type exportWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	written     bool
+}
+
+func (ew *exportWriter) Write(p []byte) (int, error) {
+	if !ew.written {
+		ew.written = true
+		ew.w.Header().Set("Content-Type", ew.contentType)
+		ew.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", ew.filename))
+		ew.w.WriteHeader(http.StatusOK)
+	}
+
+	n, err := ew.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+
+	// a writer which can not be flushed sends the export once it is buffered
+	http.NewResponseController(ew.w).Flush()
+
+	return n, nil
+}
+
+// ExportAttendees streams the attendees of the event or of its show as a csv, or as a workbook by "format=XLSX".
+func (handler HTTPHandler) ExportAttendees(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := ExportAttendeesRequest{
+		EventID: vars["eventID"],
+		ShowID:  vars["showID"],
+		Format:  strings.ToUpper(r.URL.Query().Get("format")),
+	}
+	if req.Format == "" {
+		req.Format = AttendeeExportFormatCSV
+	}
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+		})
+
+		return
+	}
+	req.Role = acc.Role
+	req.PromotorID = acc.PromotorID
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	filename := "attendees-" + req.EventID
+	if req.ShowID != "" {
+		filename += "-" + req.ShowID
+	}
+
+	ew := &exportWriter{w: w, contentType: "text/csv", filename: filename + ".csv"}
+	if req.Format == AttendeeExportFormatXLSX {
+		ew.contentType = xlsx.ContentType
+		ew.filename = filename + ".xlsx"
+	}
+
+	if err := handler.AttendeeUseCase.ExportAttendees(ctx, req, ew); err != nil {
+		// the export is cut short once it is sent, the client is left with an incomplete file
+		if ew.written {
+			return
+		}
+
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	// an export without a byte is still a file
+	if !ew.written {
+		ew.Write(nil)
+	}
+}
+
+// CheckInAttendee checks in the holder of a ticket of the event.
+func (handler HTTPHandler) CheckInAttendee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+		})
+
+		return
+	}
+
+	req := CheckInAttendeeRequest{
+		EventID:      vars["eventID"],
+		TicketNumber: vars["ticketNumber"],
+		Role:         acc.Role,
+		PromotorID:   acc.PromotorID,
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.AttendeeUseCase.CheckInAttendee(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket"), i18n.Noun("checked in")),
+		Data:    resp,
+	})
+}
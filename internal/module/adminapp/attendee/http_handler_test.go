@@ -0,0 +1,278 @@
+package attendee_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/attendee"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/jwt"
+	internalMiddleware "github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/validator"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the handler is not expected to call panics.
+
+type sessionStore struct {
+	session.Session
+	accounts map[string]session.Account
+}
+
+func (s sessionStore) Get(ctx context.Context, key string) (session.Account, error) {
+	acc, ok := s.accounts[key]
+	if !ok {
+		return session.Account{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return acc, nil
+}
+
+type eventStore struct {
+	event.EventRepository
+}
+
+func (eventStore) FindByID(ctx context.Context, ID string) (event.Event, error) {
+	if ID != "EVENT1" {
+		return event.Event{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return event.Event{ID: ID, Name: "Test"}, nil
+}
+
+type promotorStore struct {
+	event.PromotorRepository
+}
+
+func (promotorStore) FindManyByEventID(ctx context.Context, eventID string) ([]event.Promotor, error) {
+	return []event.Promotor{{EventID: eventID, PromotorID: "PROMOTOR1", Name: "Promotor"}}, nil
+}
+
+type attendeeStore struct {
+	attendee.AttendeeRepository
+
+	mu        sync.Mutex
+	attendees []attendee.Attendee
+	streamed  bool
+}
+
+func (s *attendeeStore) StreamManyByEventID(ctx context.Context, eventID, showID string, fn func(a attendee.Attendee) error) error {
+	s.streamed = true
+	for _, a := range s.attendees {
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *attendeeStore) FindByTicketNumber(ctx context.Context, eventID, ticketNumber string) (attendee.Attendee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.attendees {
+		if a.EventID == eventID && a.TicketNumber == ticketNumber {
+			return a, nil
+		}
+	}
+
+	return attendee.Attendee{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+func (s *attendeeStore) CheckIn(ctx context.Context, eventID, ticketNumber string, checkedInAt time.Time) (attendee.Attendee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, a := range s.attendees {
+		if a.EventID == eventID && a.TicketNumber == ticketNumber && a.CheckedInAt == nil {
+			s.attendees[k].CheckedInAt = &checkedInAt
+			return s.attendees[k], nil
+		}
+	}
+
+	return attendee.Attendee{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// newJSONWebToken returns the signer of the tokens of the test along with a key pair of its own.
+func newJSONWebToken(t *testing.T) *jwt.JSONWebToken {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return jwt.NewJSONWebToken(
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}),
+	)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	jsonWebToken := newJSONWebToken(t)
+
+	// the stored sessions have no role, the one of the token is taken
+	sess := sessionStore{accounts: map[string]session.Account{
+		"1": {ID: 1, Name: "Admin", Type: "ADMIN"},
+		"2": {ID: 2, Name: "Promotor", Type: "ADMIN"},
+		"3": {ID: 3, Name: "Other Promotor", Type: "ADMIN"},
+	}}
+	claims := map[string]jwt.Claim{
+		"1": {Type: "ADMIN", Role: session.RoleAdmin},
+		"2": {Type: "ADMIN", Role: session.RolePromotor, PromotorID: "PROMOTOR1"},
+		"3": {Type: "ADMIN", Role: session.RolePromotor, PromotorID: "PROMOTOR2"},
+	}
+
+	newRouter := func(attendees *attendeeStore) *mux.Router {
+		uc := attendee.NewAttendeeUseCase(attendee.AttendeeUseCaseProperty{
+			Logger:             logger,
+			Location:           time.UTC,
+			Timeout:            10 * time.Second,
+			AttendeeRepository: attendees,
+			EventRepository:    eventStore{},
+			PromotorRepository: promotorStore{},
+			TxManager:          txManager{},
+		})
+
+		// the middlewares of the router of the service, each of them wraps the response writer
+		router := mux.NewRouter()
+		router.Use(
+			middleware.NewHTTPRequestLogger(logger, true).Middleware,
+			i18n.Middleware,
+		)
+		attendee.InitHTTPHandler(router, internalMiddleware.NewAdminSessionMiddleware(jsonWebToken, sess), validator.Get(), uc)
+
+		return router
+	}
+
+	newRequest := func(method, target, subject string) *http.Request {
+		claim := claims[subject]
+		claim.StandardClaims = jwtv4.StandardClaims{Subject: subject, ExpiresAt: time.Now().Add(time.Hour).Unix()}
+
+		token, err := jsonWebToken.Sign(context.Background(), claim)
+		require.NoError(t, err)
+
+		r := httptest.NewRequest(method, target, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		return r
+	}
+
+	newAttendees := func() *attendeeStore {
+		return &attendeeStore{attendees: []attendee.Attendee{
+			{TicketNumber: "T1", EventID: "EVENT1", ShowID: "SHOW1", Tier: "GOLD", Quantity: 2, CustomerName: "Budi Santoso", CustomerEmail: "budi@example.com", OrderID: "ORDER1"},
+			{TicketNumber: "T2", EventID: "EVENT1", ShowID: "SHOW1", Tier: "GOLD", Quantity: 1, CustomerName: "Siti", CustomerEmail: "siti@example.com", OrderID: "ORDER2"},
+		}}
+	}
+
+	t.Run("the export is flushed through the writers of the middlewares", func(t *testing.T) {
+		router := newRouter(newAttendees())
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodGet, "/tm-event/v1/adminapp/events/EVENT1/attendees", "1"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, w.Flushed)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"T1", "EVENT1", "SHOW1", "GOLD", "2", "Budi Santoso", "budi@example.com", "ORDER1", attendee.CheckInStatusNotCheckedIn, ""}, records[1])
+	})
+
+	t.Run("a promotor exports the attendees of its events by their names", func(t *testing.T) {
+		router := newRouter(newAttendees())
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodGet, "/tm-event/v1/adminapp/events/EVENT1/attendees", "2"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, "Budi Santoso", records[1][5])
+		assert.Equal(t, "b***@example.com", records[1][6])
+	})
+
+	t.Run("a promotor does not export the attendees of the events of another promotor", func(t *testing.T) {
+		attendees := newAttendees()
+		router := newRouter(attendees)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodGet, "/tm-event/v1/adminapp/events/EVENT1/attendees", "3"))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.False(t, attendees.streamed)
+	})
+
+	t.Run("a ticket is checked in once", func(t *testing.T) {
+		attendees := newAttendees()
+		router := newRouter(attendees)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodPost, "/tm-event/v1/adminapp/events/EVENT1/attendees/T1/check-in", "1"))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var body struct {
+			Data attendee.AttendeeResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+		assert.Equal(t, "T1", body.Data.TicketNumber)
+		assert.Equal(t, attendee.CheckInStatusCheckedIn, body.Data.CheckInStatus)
+		assert.NotNil(t, body.Data.CheckedInAt)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodPost, "/tm-event/v1/adminapp/events/EVENT1/attendees/T1/check-in", "1"))
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodPost, "/tm-event/v1/adminapp/events/EVENT1/attendees/T9/check-in", "1"))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("a promotor does not check in the tickets of the events of another promotor", func(t *testing.T) {
+		attendees := newAttendees()
+		router := newRouter(attendees)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequest(http.MethodPost, "/tm-event/v1/adminapp/events/EVENT1/attendees/T1/check-in", "3"))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Nil(t, attendees.attendees[0].CheckedInAt)
+	})
+}
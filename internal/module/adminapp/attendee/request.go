@@ -0,0 +1,20 @@
+package attendee
+
+// ExportAttendeesRequest exports the attendees of an event, or of its show when the show id is given. The role and the
+// promotor are the ones of the admin who exports them.
+type ExportAttendeesRequest struct {
+	EventID    string `validate:"required"`
+	ShowID     string `validate:"-"`
+	Format     string `validate:"oneof=CSV XLSX"`
+	Role       string `validate:"-"`
+	PromotorID string `validate:"-"`
+}
+
+// CheckInAttendeeRequest checks in the holder of a ticket of an event, e.g. once it is scanned at the gate. The role and
+// the promotor are the ones of the admin who checks it in.
+type CheckInAttendeeRequest struct {
+	EventID      string `validate:"required"`
+	TicketNumber string `validate:"required"`
+	Role         string `validate:"-"`
+	PromotorID   string `validate:"-"`
+}
@@ -0,0 +1,29 @@
+package attendee
+
+import "time"
+
+type AttendeeResponse struct {
+	TicketNumber  string     `json:"ticket_number"`
+	EventID       string     `json:"event_id"`
+	ShowID        string     `json:"show_id"`
+	Tier          string     `json:"tier"`
+	Quantity      int64      `json:"quantity"`
+	CustomerName  string     `json:"customer_name"`
+	CustomerEmail string     `json:"customer_email"`
+	OrderID       string     `json:"order_id"`
+	CheckInStatus string     `json:"check_in_status"`
+	CheckedInAt   *time.Time `json:"checked_in_at"`
+}
+
+func (r *AttendeeResponse) PopulateFromEntity(a Attendee) {
+	r.TicketNumber = a.TicketNumber
+	r.EventID = a.EventID
+	r.ShowID = a.ShowID
+	r.Tier = a.Tier
+	r.Quantity = a.Quantity
+	r.CustomerName = a.CustomerName
+	r.CustomerEmail = a.CustomerEmail
+	r.OrderID = a.OrderID
+	r.CheckInStatus = a.CheckInStatus()
+	r.CheckedInAt = a.CheckedInAt
+}
@@ -0,0 +1,195 @@
+package attendee
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type AttendeeUseCase interface {
+	ExportAttendees(ctx context.Context, req ExportAttendeesRequest, w io.Writer) error
+	CheckInAttendee(ctx context.Context, req CheckInAttendeeRequest) (AttendeeResponse, error)
+}
+
+type attendeeUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	attendeeRepository AttendeeRepository
+	eventRepository    event.EventRepository
+	showRepository     event.ShowRepository
+	promotorRepository event.PromotorRepository
+	txManager          postgresql.TxManager
+}
+
+type AttendeeUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	AttendeeRepository AttendeeRepository
+	EventRepository    event.EventRepository
+	ShowRepository     event.ShowRepository
+	PromotorRepository event.PromotorRepository
+	TxManager          postgresql.TxManager
+}
+
+func NewAttendeeUseCase(props AttendeeUseCaseProperty) AttendeeUseCase {
+	return &attendeeUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		attendeeRepository: props.AttendeeRepository,
+		eventRepository:    props.EventRepository,
+		showRepository:     props.ShowRepository,
+		promotorRepository: props.PromotorRepository,
+		txManager:          props.TxManager,
+	}
+}
+
+// authorize lets an admin of the PROMOTOR role reach the attendees of the events of its own promotor only.
+func (u *attendeeUseCase) authorize(ctx context.Context, e event.Event, role, promotorID string) error {
+	if role != session.RolePromotor {
+		return nil
+	}
+
+	promotors, err := u.promotorRepository.FindManyByEventID(ctx, e.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range promotors {
+		if promotorID != "" && p.PromotorID == promotorID {
+			return nil
+		}
+	}
+
+	return errors.New(http.StatusForbidden, status.FORBIDDEN, i18n.Message(ctx, i18n.EventNotPromoted, e.ID))
+}
+
+// findEvent returns the event of the attendees which the admin is allowed to reach, the show, if any, must be one of
+// its shows.
+func (u *attendeeUseCase) findEvent(ctx context.Context, eventID, showID, role, promotorID string) (event.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	e, err := u.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return event.Event{}, err
+	}
+
+	if err := u.authorize(ctx, e, role, promotorID); err != nil {
+		return event.Event{}, err
+	}
+
+	if showID != "" {
+		s, err := u.showRepository.FindByID(ctx, showID)
+		if err != nil {
+			return event.Event{}, err
+		}
+
+		if s.EventID != e.ID {
+			return event.Event{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), showID))
+		}
+	}
+
+	return e, nil
+}
+
+// ExportAttendees implements AttendeeUseCase. The attendees are written to w as they are read, so the export is
+// bounded by the request rather than by the timeout, and they are masked by the role of the request. Nothing is
+// written to w unless the event is found and the admin is allowed to reach its attendees.
+func (u *attendeeUseCase) ExportAttendees(ctx context.Context, req ExportAttendeesRequest, w io.Writer) error {
+	e, err := u.findEvent(ctx, req.EventID, req.ShowID, req.Role, req.PromotorID)
+	if err != nil {
+		return err
+	}
+
+	enc, err := newAttendeeEncoder(req.Format, w)
+	if err != nil {
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while exporting attendees")
+	}
+
+	if err := enc.Write(attendeeColumns); err != nil {
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while exporting attendees")
+	}
+
+	mask := AttendeeMaskOf(req.Role)
+
+	// the cursor lives in the transaction, its reads do not conflict with the writes of the others so the rows which
+	// are written already are not written again by a retry
+	written := 0
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		return u.attendeeRepository.StreamManyByEventID(ctx, e.ID, req.ShowID, func(a Attendee) error {
+			if err := enc.Write(attendeeRecord(a.Masked(mask))); err != nil {
+				return err
+			}
+
+			// the rows of every fetch are sent on
+			if written++; written%attendeeFetchSize == 0 {
+				return enc.Flush()
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		if _, ok := err.(*errors.AppError); ok {
+			return err
+		}
+		// e.g. the client which has gone away
+		u.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while exporting attendees")
+	}
+
+	if err := enc.Close(); err != nil {
+		u.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while exporting attendees")
+	}
+
+	return nil
+}
+
+// CheckInAttendee implements AttendeeUseCase. The attendee is masked by the role of the request the same way as in the
+// export.
+func (u *attendeeUseCase) CheckInAttendee(ctx context.Context, req CheckInAttendeeRequest) (AttendeeResponse, error) {
+	e, err := u.findEvent(ctx, req.EventID, "", req.Role, req.PromotorID)
+	if err != nil {
+		return AttendeeResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := u.attendeeRepository.CheckIn(ctx, e.ID, req.TicketNumber, time.Now())
+	if err != nil {
+		if !errors.MatchStatus(err, status.NOT_FOUND) {
+			return AttendeeResponse{}, err
+		}
+
+		// the ticket is either not found or checked in already
+		existing, findErr := u.attendeeRepository.FindByTicketNumber(ctx, e.ID, req.TicketNumber)
+		if findErr != nil {
+			return AttendeeResponse{}, findErr
+		}
+
+		if existing.CheckedInAt != nil {
+			return AttendeeResponse{}, errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.TicketCheckedIn, req.TicketNumber, existing.CheckedInAt.In(u.location).Format(time.DateTime)))
+		}
+
+		return AttendeeResponse{}, err
+	}
+
+	resp := AttendeeResponse{}
+	resp.PopulateFromEntity(a.Masked(AttendeeMaskOf(req.Role)))
+
+	return resp, nil
+}
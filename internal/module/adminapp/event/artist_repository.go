@@ -2,51 +2,44 @@ package event
 
 import (
 	"context"
-	"database/sql"
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type ArtistRepository interface {
-	FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Artist, error)
-	Save(ctx context.Context, a Artist, tx *sql.Tx) error
+	FindManyByEventID(ctx context.Context, eventID string) ([]Artist, error)
+	Save(ctx context.Context, a Artist) error
+	SaveMany(ctx context.Context, artists []Artist) error
 }
 
 type artistRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-// FindByEventID implements ArtistRepository.
-func (r *artistRepository) FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Artist, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+// FindManyByEventID implements ArtistRepository.
+func (r *artistRepository) FindManyByEventID(ctx context.Context, eventID string) ([]Artist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
-		SELECT 
-			event_id, name
-		FROM event_artist
+		SELECT
+			ea.event_id, a.id, a.slug, a.name
+		FROM event_artist ea
+		JOIN artist a ON a.id = ea.artist_id
 		WHERE
-			event_id = $1
+			ea.event_id = $1
+		ORDER BY a.name ASC
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event artist's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, eventID)
+	rows, err := cmd.QueryContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event artist's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event artist's prorperties")
 	}
 
 	defer rows.Close()
@@ -55,10 +48,10 @@ func (r *artistRepository) FindManyByEventID(ctx context.Context, eventID string
 	for rows.Next() {
 		var a Artist
 
-		err := rows.Scan(&a.EventID, &a.Name)
+		err := rows.Scan(&a.EventID, &a.ArtistID, &a.Slug, &a.Name)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event artist's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event artist's prorperties")
 		}
 
 		data = append(data, a)
@@ -68,17 +61,13 @@ func (r *artistRepository) FindManyByEventID(ctx context.Context, eventID string
 }
 
 // Save implements ArtistRepository.
-func (r *artistRepository) Save(ctx context.Context, a Artist, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *artistRepository) Save(ctx context.Context, a Artist) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO event_artist
 		(
-			event_id, name
+			event_id, artist_id
 		)
 		VALUES
 		(
@@ -86,25 +75,50 @@ func (r *artistRepository) Save(ctx context.Context, a Artist, tx *sql.Tx) error
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, a.EventID, a.ArtistID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, a.EventID, a.Name)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
 	}
 
 	return nil
 }
 
-func NewArtistRepository(logger *logrus.Logger, db *sql.DB) ArtistRepository {
+func NewArtistRepository(logger *logrus.Logger, db *pgxpool.Pool) ArtistRepository {
 	return &artistRepository{
 		logger: logger,
 		db:     db,
 	}
 }
+
+// SaveMany implements ArtistRepository. The artists of the events are inserted by one statement.
+func (r *artistRepository) SaveMany(ctx context.Context, artists []Artist) error {
+	if len(artists) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_artist
+		(
+			event_id, artist_id
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[])
+	`
+
+	eventIDs := make([]string, len(artists))
+	artistIDs := make([]string, len(artists))
+	for k, a := range artists {
+		eventIDs[k] = a.EventID
+		artistIDs[k] = a.ArtistID
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, artistIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event artist's prorperties")
+	}
+
+	return nil
+}
@@ -18,6 +18,19 @@ const (
 	TicketTierSilver       string = "SILVER"
 	TicketTierGold         string = "GOLD"
 	TypeOrderRuleRangeDate string = "ORDER_RULE_RANGE_DATE"
+
+	EventImportFormatCSV   string = "CSV"
+	EventImportFormatJSONL string = "JSONL"
+
+	// EventImportModeAllOrNothing commits the events of an import only when every one of them is valid,
+	// EventImportModePerEvent commits every valid event on its own.
+	EventImportModeAllOrNothing string = "ALL_OR_NOTHING"
+	EventImportModePerEvent     string = "PER_EVENT"
+
+	EventImportStatusCreated    string = "CREATED"
+	EventImportStatusValid      string = "VALID"
+	EventImportStatusFailed     string = "FAILED"
+	EventImportStatusRolledBack string = "ROLLED_BACK"
 )
 
 type Location struct {
@@ -30,40 +43,76 @@ type Location struct {
 	Longitude        float64
 }
 
+// ShowTranslation holds the venue of a show in another locale.
+type ShowTranslation struct {
+	EventID string
+	ShowID  string
+	Locale  string
+	Venue   string
+}
+
 type Show struct {
-	EventID     string
-	ID          string
-	Venue       string
-	Type        string
-	TicketStock []ticket.TicketStock
-	Location    *Location
-	Time        time.Time
-	Status      string
+	EventID      string
+	ID           string
+	VenueID      *string
+	Venue        string
+	Type         string
+	TicketStock  []ticket.TicketStock
+	Location     *Location
+	Time         time.Time
+	Status       string
+	Currency     string
+	Translations []ShowTranslation
 }
 
 type Promotor struct {
-	EventID string
-	Name    string
-	Email   string
-	Phone   string
+	EventID    string
+	PromotorID string
+	Slug       string
+	Name       string
+	Email      string
+	Phone      string
 }
 
 type Artist struct {
-	EventID string
-	Name    string
+	EventID  string
+	ArtistID string
+	Slug     string
+	Name     string
 }
 
-type Event struct {
-	ID          string
+// Taxonomy is a category, a genre or a tag which is attached to an event.
+type Taxonomy struct {
+	EventID    string
+	TaxonomyID string
+	Type       string
+	Slug       string
+	Name       string
+}
+
+// EventTranslation holds the name and the description of an event in another locale, an empty field falls back to
+// the one of the event.
+type EventTranslation struct {
+	EventID     string
+	Locale      string
 	Name        string
-	Promotors   []Promotor
-	Artists     []Artist
-	Shows       []Show
 	Description string
-	Status      string
-	OrderRules  OrderRuleAggregation
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+}
+
+type Event struct {
+	ID           string
+	Name         string
+	Promotors    []Promotor
+	Artists      []Artist
+	Shows        []Show
+	Description  string
+	Status       string
+	Currency     string
+	OrderRules   OrderRuleAggregation
+	Translations []EventTranslation
+	Taxonomies   []Taxonomy
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type OrderRuleAggregation struct {
@@ -0,0 +1,415 @@
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// maxEventImportLineSize is far longer than any create event request, a longer line is not one.
+const maxEventImportLineSize = 1 << 20
+
+// eventImportListSeparator separates the items of a list column of the csv, e.g. the artists of an event.
+const eventImportListSeparator = ";"
+
+// The columns of the event import csv. Every row is a tier of a show of an event, the rows of an event are grouped by
+// the "event" column and its shows by the "show" column. The columns of the event and of the show are read from the
+// first row of each, the following rows may leave them empty.
+var (
+	eventImportCSVEventColumns = []string{
+		"name", "description", "currency", "artists", "artist_ids", "promotors", "promotor_ids", "category", "genres",
+		"tags", "show_time", "order_rule_start_date", "order_rule_end_date", "order_rule_day",
+		"order_rule_maximum_ticket", "online_ticket_price", "total_online_ticket_allocation",
+	}
+	eventImportCSVShowColumns = []string{
+		"venue_id", "venue", "show_type", "show_currency", "online", "total_ticket_allocation", "country", "city",
+		"formatted_address", "latitude", "longitude",
+	}
+	eventImportCSVTierColumns = []string{"tier", "allocation_by_percentage", "price"}
+)
+
+var importedShowFieldPattern = regexp.MustCompile(`^shows\[(\d+)\](\.ticket_allocation\[(\d+)\])?`)
+
+// EventImportError is a violation of an import file, it refers to the row of the file which causes it.
+type EventImportError struct {
+	Row     int
+	Ref     string
+	Field   string
+	Rule    string
+	Message string
+}
+
+// ImportedEvent is a create event request which is read from an import file along with the rows it is read from.
+type ImportedEvent struct {
+	Ref     string
+	Row     int
+	Request CreateEventRequest
+	// Errors are the violations which are found while the rows are read, such an event is not validated further.
+	Errors []EventImportError
+
+	showRows []int
+	tierRows [][]int
+}
+
+// RowOf returns the row of the file which the field of the request is read from.
+func (ie ImportedEvent) RowOf(field string) int {
+	m := importedShowFieldPattern.FindStringSubmatch(field)
+	if m == nil {
+		return ie.Row
+	}
+
+	i, _ := strconv.Atoi(m[1])
+	if i >= len(ie.showRows) {
+		return ie.Row
+	}
+
+	if m[3] != "" {
+		j, _ := strconv.Atoi(m[3])
+		if j < len(ie.tierRows[i]) {
+			return ie.tierRows[i][j]
+		}
+	}
+
+	return ie.showRows[i]
+}
+
+func (ie *ImportedEvent) invalid(ctx context.Context, row int, field, rule string, key i18n.Key, args ...any) {
+	ie.Errors = append(ie.Errors, EventImportError{
+		Row:     row,
+		Ref:     ie.Ref,
+		Field:   field,
+		Rule:    rule,
+		Message: i18n.Message(ctx, key, args...),
+	})
+}
+
+// ParseEventImport reads the events of an import file in the given format.
+func ParseEventImport(ctx context.Context, format string, content []byte) ([]ImportedEvent, error) {
+	switch format {
+	case EventImportFormatJSONL:
+		return parseEventImportJSONL(ctx, content)
+	case EventImportFormatCSV:
+		return parseEventImportCSV(ctx, content)
+	}
+
+	return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.UnsupportedImportFormat, format))
+}
+
+// parseEventImportJSONL reads a create event request from every line which is not blank, the row of an event is its
+// line.
+func parseEventImportJSONL(ctx context.Context, content []byte) ([]ImportedEvent, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventImportLineSize)
+
+	events := make([]ImportedEvent, 0)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		ie := ImportedEvent{Ref: strconv.Itoa(line), Row: line}
+		if err := json.Unmarshal(text, &ie.Request); err != nil {
+			ie.invalid(ctx, line, "", "json", i18n.InvalidCreateEventRequest, err.Error())
+		}
+
+		events = append(events, ie)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidImportJSONL, err.Error()))
+	}
+
+	return events, nil
+}
+
+// csvRow is a record of the event import csv which is read by the names of its columns.
+type csvRow struct {
+	line    int
+	record  []string
+	columns map[string]int
+}
+
+func (r csvRow) get(column string) string {
+	k, ok := r.columns[column]
+	if !ok || k >= len(r.record) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.record[k])
+}
+
+func (r csvRow) list(column string) []string {
+	items := make([]string, 0)
+	for _, v := range strings.Split(r.get(column), eventImportListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			items = append(items, v)
+		}
+	}
+
+	return items
+}
+
+// csvGroup is an event or a show of the csv along with the values of its columns on its first row.
+type csvGroup struct {
+	row    int
+	values map[string]string
+}
+
+func newCSVGroup(r csvRow, columns []string) csvGroup {
+	g := csvGroup{row: r.line, values: make(map[string]string)}
+	for _, c := range columns {
+		g.values[c] = r.get(c)
+	}
+
+	return g
+}
+
+// conflicts reports the columns of a following row which differ from the first row of the group.
+func (g csvGroup) conflicts(ctx context.Context, ie *ImportedEvent, r csvRow, columns []string, noun string) {
+	for _, c := range columns {
+		if v := r.get(c); v != "" && v != g.values[c] {
+			ie.invalid(ctx, r.line, c, "eq", i18n.ImportColumnDiffers, c, g.row, i18n.Noun(noun))
+		}
+	}
+}
+
+func parseEventImportCSV(ctx context.Context, content []byte) ([]ImportedEvent, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidImportCSV, err.Error()))
+	}
+
+	known := map[string]bool{"event": true, "show": true}
+	for _, columns := range [][]string{eventImportCSVEventColumns, eventImportCSVShowColumns, eventImportCSVTierColumns} {
+		for _, c := range columns {
+			known[c] = true
+		}
+	}
+
+	columns := make(map[string]int)
+	for k, v := range header {
+		name := strings.ToLower(strings.TrimSpace(v))
+		if !known[name] {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.UnknownImportColumn, v))
+		}
+		columns[name] = k
+	}
+	if _, ok := columns["event"]; !ok {
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.ImportEventColumnRequired))
+	}
+
+	events := make([]*ImportedEvent, 0)
+	eventGroups := make(map[string]csvGroup)
+	byRef := make(map[string]*ImportedEvent)
+	showGroups := make(map[string][]csvGroup)
+	showIndexes := make(map[string]map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidImportCSV, err.Error()))
+		}
+
+		line, _ := reader.FieldPos(0)
+		r := csvRow{line: line, record: record, columns: columns}
+
+		ref := r.get("event")
+		ie, ok := byRef[ref]
+		if !ok {
+			ie = &ImportedEvent{Ref: ref, Row: line}
+			if ref == "" {
+				// a row without a reference can not be grouped, it is reported on its own
+				ie.invalid(ctx, line, "event", "required", i18n.Required, "event")
+				events = append(events, ie)
+				continue
+			}
+
+			byRef[ref] = ie
+			events = append(events, ie)
+			eventGroups[ref] = newCSVGroup(r, eventImportCSVEventColumns)
+			showIndexes[ref] = make(map[string]int)
+			parseEventImportCSVEvent(ctx, ie, r)
+		} else {
+			eventGroups[ref].conflicts(ctx, ie, r, eventImportCSVEventColumns, "event")
+		}
+
+		showRef := r.get("show")
+		i, ok := showIndexes[ref][showRef]
+		if !ok {
+			i = len(ie.Request.Shows)
+			showIndexes[ref][showRef] = i
+			showGroups[ref] = append(showGroups[ref], newCSVGroup(r, eventImportCSVShowColumns))
+			ie.showRows = append(ie.showRows, line)
+			ie.tierRows = append(ie.tierRows, nil)
+			ie.Request.Shows = append(ie.Request.Shows, parseEventImportCSVShow(ctx, ie, r))
+		} else {
+			showGroups[ref][i].conflicts(ctx, ie, r, eventImportCSVShowColumns, "show")
+		}
+
+		show := &ie.Request.Shows[i]
+		currency := ie.Request.Currency
+		if show.Currency != "" {
+			currency = show.Currency
+		}
+
+		ie.tierRows[i] = append(ie.tierRows[i], line)
+		show.TicketAllocation = append(show.TicketAllocation, parseEventImportCSVTier(ctx, ie, r, currency))
+	}
+
+	parsed := make([]ImportedEvent, len(events))
+	for k, v := range events {
+		parsed[k] = *v
+	}
+
+	return parsed, nil
+}
+
+func parseEventImportCSVEvent(ctx context.Context, ie *ImportedEvent, r csvRow) {
+	req := &ie.Request
+	req.Name = r.get("name")
+	req.Description = r.get("description")
+	req.Currency = strings.ToUpper(r.get("currency"))
+	req.Category = r.get("category")
+	req.Genres = r.list("genres")
+	req.Tags = r.list("tags")
+	req.ShowTime = r.get("show_time")
+	req.OrderRuleRangeDate.StartDate = r.get("order_rule_start_date")
+	req.OrderRuleRangeDate.EndDate = r.get("order_rule_end_date")
+
+	for _, v := range r.list("artist_ids") {
+		req.Artists = append(req.Artists, CreateEventArtistRequest{ID: v})
+	}
+	for _, v := range r.list("artists") {
+		req.Artists = append(req.Artists, CreateEventArtistRequest{Name: v})
+	}
+
+	for _, v := range r.list("promotor_ids") {
+		req.Promotors = append(req.Promotors, CreateEventPromotorRequest{ID: v})
+	}
+	// a new promotor is given as "name|email|phone"
+	for _, v := range r.list("promotors") {
+		fields := strings.Split(v, "|")
+		p := CreateEventPromotorRequest{Name: strings.TrimSpace(fields[0])}
+		if len(fields) > 1 {
+			p.Email = strings.TrimSpace(fields[1])
+		}
+		if len(fields) > 2 {
+			p.Phone = strings.TrimSpace(fields[2])
+		}
+		req.Promotors = append(req.Promotors, p)
+	}
+
+	for _, v := range r.list("order_rule_day") {
+		day, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			ie.invalid(ctx, r.line, "order_rule_day", "number", i18n.InvalidValue, "order_rule_day", v)
+			continue
+		}
+		req.OrderRuleDay = append(req.OrderRuleDay, day)
+	}
+
+	req.OrderRuleMaximumTicket = parseEventImportCSVInt(ctx, ie, r, "order_rule_maximum_ticket")
+	req.TotalOnlineTicketAllocation = parseEventImportCSVInt(ctx, ie, r, "total_online_ticket_allocation")
+	req.OnlineTicketPrice = parseEventImportCSVMoney(ctx, ie, r, "online_ticket_price", req.Currency)
+}
+
+func parseEventImportCSVShow(ctx context.Context, ie *ImportedEvent, r csvRow) CreateShowRequest {
+	show := CreateShowRequest{
+		VenueID:               r.get("venue_id"),
+		Venue:                 r.get("venue"),
+		Type:                  strings.ToUpper(r.get("show_type")),
+		Currency:              strings.ToUpper(r.get("show_currency")),
+		TotalTicketAllocation: parseEventImportCSVInt(ctx, ie, r, "total_ticket_allocation"),
+	}
+
+	if v := r.get("online"); v != "" {
+		online, err := strconv.ParseBool(v)
+		if err != nil {
+			ie.invalid(ctx, r.line, "online", "boolean", i18n.InvalidValue, "online", v)
+		}
+		show.Online = online
+	}
+
+	if r.get("country") != "" || r.get("city") != "" || r.get("formatted_address") != "" {
+		show.Location = &CreateLocationRequest{
+			Country:          r.get("country"),
+			City:             r.get("city"),
+			FormattedAddress: r.get("formatted_address"),
+			Latitude:         parseEventImportCSVFloat(ctx, ie, r, "latitude"),
+			Longitude:        parseEventImportCSVFloat(ctx, ie, r, "longitude"),
+		}
+	}
+
+	return show
+}
+
+func parseEventImportCSVTier(ctx context.Context, ie *ImportedEvent, r csvRow, currency string) CreateTicketAllocation {
+	return CreateTicketAllocation{
+		Tier:                   strings.ToUpper(r.get("tier")),
+		AllocationByPercentage: parseEventImportCSVFloat(ctx, ie, r, "allocation_by_percentage"),
+		Price:                  parseEventImportCSVMoney(ctx, ie, r, "price", currency),
+	}
+}
+
+func parseEventImportCSVInt(ctx context.Context, ie *ImportedEvent, r csvRow, column string) int64 {
+	v := r.get(column)
+	if v == "" {
+		return 0
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		ie.invalid(ctx, r.line, column, "number", i18n.InvalidValue, column, v)
+	}
+
+	return n
+}
+
+func parseEventImportCSVFloat(ctx context.Context, ie *ImportedEvent, r csvRow, column string) float64 {
+	v := r.get(column)
+	if v == "" {
+		return 0
+	}
+
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		ie.invalid(ctx, r.line, column, "number", i18n.InvalidValue, column, v)
+	}
+
+	return n
+}
+
+// parseEventImportCSVMoney reads an amount of the column in the given currency, an empty amount is zero and is left to
+// the validation of the request.
+func parseEventImportCSVMoney(ctx context.Context, ie *ImportedEvent, r csvRow, column, currency string) money.Money {
+	v := r.get(column)
+	if v == "" {
+		return money.Money{Currency: currency}
+	}
+
+	m, err := money.Parse(v, currency)
+	if err != nil {
+		ie.invalid(ctx, r.line, column, "money", i18n.InvalidValue, column, v)
+	}
+
+	return m
+}
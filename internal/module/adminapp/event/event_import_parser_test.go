@@ -0,0 +1,155 @@
+package event_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+func TestParseEventImportCSV(t *testing.T) {
+	t.Run("the rows are grouped by event and show", func(t *testing.T) {
+		content := strings.Join([]string{
+			"event,show,name,currency,artists,promotors,show_time,venue,show_type,total_ticket_allocation,tier,allocation_by_percentage,price",
+			"E1,S1,Concert,idr,Artist A;Artist B,Promotor|promotor@mail.com|0812,2030-01-01 19:00:00,Hall,live,100,GOLD,40,500000",
+			"E1,S1,,,,,,,,,SILVER,60,250000",
+			"E1,S2,,,,,,Arena,hologram_live,50,GOLD,100,300000",
+			"E2,S1,Festival,IDR,Artist C,,2030-02-01 19:00:00,Field,LIVE,10,WOOD,100,100000",
+		}, "\n")
+
+		imported, err := event.ParseEventImport(context.Background(), event.EventImportFormatCSV, []byte(content))
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+
+		e1 := imported[0]
+		assert.Equal(t, "E1", e1.Ref)
+		assert.Equal(t, 2, e1.Row)
+		assert.Empty(t, e1.Errors)
+		assert.Equal(t, "Concert", e1.Request.Name)
+		assert.Equal(t, "IDR", e1.Request.Currency)
+		assert.Equal(t, []event.CreateEventArtistRequest{{Name: "Artist A"}, {Name: "Artist B"}}, e1.Request.Artists)
+		assert.Equal(t, []event.CreateEventPromotorRequest{{Name: "Promotor", Email: "promotor@mail.com", Phone: "0812"}}, e1.Request.Promotors)
+
+		require.Len(t, e1.Request.Shows, 2)
+		assert.Equal(t, "Hall", e1.Request.Shows[0].Venue)
+		assert.Equal(t, "LIVE", e1.Request.Shows[0].Type)
+		assert.Equal(t, int64(100), e1.Request.Shows[0].TotalTicketAllocation)
+		assert.Equal(t, []event.CreateTicketAllocation{
+			{Tier: "GOLD", AllocationByPercentage: 40, Price: money.MustParse("500000", "IDR")},
+			{Tier: "SILVER", AllocationByPercentage: 60, Price: money.MustParse("250000", "IDR")},
+		}, e1.Request.Shows[0].TicketAllocation)
+		assert.Equal(t, "Arena", e1.Request.Shows[1].Venue)
+		assert.Equal(t, "HOLOGRAM_LIVE", e1.Request.Shows[1].Type)
+
+		assert.Equal(t, 2, e1.RowOf("name"))
+		assert.Equal(t, 2, e1.RowOf("shows[0].venue"))
+		assert.Equal(t, 3, e1.RowOf("shows[0].ticket_allocation[1].price"))
+		assert.Equal(t, 4, e1.RowOf("shows[1].ticket_allocation[0].tier"))
+
+		e2 := imported[1]
+		assert.Equal(t, "E2", e2.Ref)
+		assert.Equal(t, 5, e2.Row)
+		assert.Empty(t, e2.Errors)
+		require.Len(t, e2.Request.Shows, 1)
+		assert.Len(t, e2.Request.Shows[0].TicketAllocation, 1)
+	})
+
+	t.Run("the bad rows are reported by their line", func(t *testing.T) {
+		content := strings.Join([]string{
+			"event,show,name,currency,total_ticket_allocation,tier,allocation_by_percentage,price",
+			"E1,S1,Concert,IDR,many,GOLD,40,500000",
+			"E1,S1,Other,IDR,,SILVER,sixty,cheap",
+			",S1,Orphan,IDR,10,GOLD,100,100000",
+		}, "\n")
+
+		imported, err := event.ParseEventImport(context.Background(), event.EventImportFormatCSV, []byte(content))
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+
+		type violation struct {
+			Row   int
+			Field string
+			Rule  string
+		}
+		violations := func(ie event.ImportedEvent) []violation {
+			v := make([]violation, len(ie.Errors))
+			for k, e := range ie.Errors {
+				v[k] = violation{Row: e.Row, Field: e.Field, Rule: e.Rule}
+				assert.Equal(t, ie.Ref, e.Ref)
+				assert.NotEmpty(t, e.Message)
+			}
+			return v
+		}
+
+		assert.Equal(t, []violation{
+			{Row: 2, Field: "total_ticket_allocation", Rule: "number"},
+			{Row: 3, Field: "name", Rule: "eq"},
+			{Row: 3, Field: "allocation_by_percentage", Rule: "number"},
+			{Row: 3, Field: "price", Rule: "money"},
+		}, violations(imported[0]))
+		assert.Equal(t, []violation{
+			{Row: 4, Field: "event", Rule: "required"},
+		}, violations(imported[1]))
+	})
+
+	t.Run("an unknown column is rejected", func(t *testing.T) {
+		_, err := event.ParseEventImport(context.Background(), event.EventImportFormatCSV, []byte("event,venue,colour\nE1,Hall,red"))
+
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+	})
+
+	t.Run("the event column is required", func(t *testing.T) {
+		_, err := event.ParseEventImport(context.Background(), event.EventImportFormatCSV, []byte("name,venue\nConcert,Hall"))
+
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+	})
+}
+
+func TestParseEventImportJSONL(t *testing.T) {
+	content := strings.Join([]string{
+		`{"name":"Concert","currency":"IDR","artists":["Artist A",{"id":"ARTIST1"}],"shows":[{"venue":"Hall","type":"LIVE"}]}`,
+		``,
+		`{"name":`,
+		`   `,
+		`{"name":"Festival","currency":"IDR"}`,
+	}, "\n")
+
+	imported, err := event.ParseEventImport(context.Background(), event.EventImportFormatJSONL, []byte(content))
+	require.NoError(t, err)
+	require.Len(t, imported, 3)
+
+	assert.Equal(t, "1", imported[0].Ref)
+	assert.Equal(t, 1, imported[0].Row)
+	assert.Empty(t, imported[0].Errors)
+	assert.Equal(t, "Concert", imported[0].Request.Name)
+	assert.Equal(t, []event.CreateEventArtistRequest{{Name: "Artist A"}, {ID: "ARTIST1"}}, imported[0].Request.Artists)
+	require.Len(t, imported[0].Request.Shows, 1)
+	assert.Equal(t, "Hall", imported[0].Request.Shows[0].Venue)
+	assert.Equal(t, 1, imported[0].RowOf("shows[0].ticket_allocation[0].price"))
+
+	assert.Equal(t, "3", imported[1].Ref)
+	assert.Equal(t, 3, imported[1].Row)
+	require.Len(t, imported[1].Errors, 1)
+	assert.Equal(t, 3, imported[1].Errors[0].Row)
+	assert.Equal(t, "json", imported[1].Errors[0].Rule)
+
+	assert.Equal(t, "5", imported[2].Ref)
+	assert.Equal(t, 5, imported[2].Row)
+	assert.Empty(t, imported[2].Errors)
+	assert.Equal(t, "Festival", imported[2].Request.Name)
+}
+
+func TestParseEventImportUnsupportedFormat(t *testing.T) {
+	_, err := event.ParseEventImport(context.Background(), "XML", []byte("<events/>"))
+
+	ae := errors.Destruct(err)
+	assert.Equal(t, http.StatusBadRequest, ae.HTTPStatusCode)
+	assert.Equal(t, status.BAD_REQUEST, ae.Status)
+}
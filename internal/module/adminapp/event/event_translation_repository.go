@@ -0,0 +1,89 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type EventTranslationRepository interface {
+	Save(ctx context.Context, t EventTranslation) error
+	SaveMany(ctx context.Context, translations []EventTranslation) error
+}
+
+type eventTranslationRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+// Save implements EventTranslationRepository.
+func (r *eventTranslationRepository) Save(ctx context.Context, t EventTranslation) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_translation
+		(
+			event_id, locale, name, description
+		)
+		VALUES
+		(
+			$1, $2, $3, $4
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, t.EventID, t.Locale, t.Name, t.Description)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event translation's prorperties")
+	}
+
+	return nil
+}
+
+func NewEventTranslationRepository(logger *logrus.Logger, db *pgxpool.Pool) EventTranslationRepository {
+	return &eventTranslationRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// SaveMany implements EventTranslationRepository. The translations are inserted by one statement.
+func (r *eventTranslationRepository) SaveMany(ctx context.Context, translations []EventTranslation) error {
+	if len(translations) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_translation
+		(
+			event_id, locale, name, description
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::text[])
+	`
+
+	eventIDs := make([]string, len(translations))
+	locales := make([]string, len(translations))
+	names := make([]string, len(translations))
+	descriptions := make([]string, len(translations))
+	for k, t := range translations {
+		eventIDs[k] = t.EventID
+		locales[k] = t.Locale
+		names[k] = t.Name
+		descriptions[k] = t.Description
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, locales, names, descriptions)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event translation's prorperties")
+	}
+
+	return nil
+}
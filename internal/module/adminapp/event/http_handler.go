@@ -1,21 +1,32 @@
 package event
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
 	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
 	"github.com/tsel-ticketmaster/tm-event/pkg/response"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
+// maxEventImportFileSize is the largest event import file which can be uploaded, the import command reads a file of
+// any size.
+const maxEventImportFileSize int64 = 10 << 20
+
+// maxMultipartMemory is the part of a multipart form which is kept in memory, the rest is buffered on disk.
+const maxMultipartMemory int64 = 1 << 20
+
 type HTTPHandler struct {
 	SessionMiddleware *middleware.AdminSession
 	Validate          *validator.Validate
@@ -29,32 +40,59 @@ func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession,
 	}
 
 	router.HandleFunc("/tm-event/v1/adminapp/events", publicMiddleware.SetRouteChain(handler.CreateEvent, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/import", publicMiddleware.SetRouteChain(handler.ImportEvents, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/taxonomies", publicMiddleware.SetRouteChain(handler.UpdateEventTaxonomy, adminSession.Verify)).Methods(http.MethodPut)
 }
 
-func (handler HTTPHandler) validate(ctx context.Context, payload interface{}) error {
-	err := handler.Validate.StructCtx(ctx, payload)
-	if err == nil {
-		return nil
-	}
+func (handler HTTPHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	errorFields := err.(validator.ValidationErrors)
+	req := CreateEventRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
 
-	errMessages := make([]string, len(errorFields))
+		return
+	}
 
-	for k, errorField := range errorFields {
-		errMessages[k] = fmt.Sprintf("invalid '%s' with value '%v'", errorField.Field(), errorField.Value())
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
 	}
 
-	errorMessage := strings.Join(errMessages, ", ")
+	resp, err := handler.EventUseCase.CreateEvent(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
 
-	return fmt.Errorf(errorMessage)
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("event"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
 
 }
 
-func (handler HTTPHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+func (handler HTTPHandler) UpdateEventTaxonomy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	vars := mux.Vars(r)
 
-	req := CreateEventRequest{}
+	req := UpdateEventTaxonomyRequest{}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
 			Status:  status.UNPROCESSABLE_ENTITY,
@@ -63,8 +101,56 @@ func (handler HTTPHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
+	req.EventID = vars["eventID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.EventUseCase.UpdateEventTaxonomy(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
 
-	if err := handler.validate(ctx, req); err != nil {
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("event taxonomy"), i18n.Noun("updated")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+// ImportEvents creates the events of the uploaded file. The format is taken from the extension of the file unless it
+// is given, the report is returned as a csv of the errors by "report=csv".
+func (handler HTTPHandler) ImportEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// the multipart envelope is allowed on top of the largest import file
+	r.Body = http.MaxBytesReader(w, r.Body, maxEventImportFileSize+maxMultipartMemory)
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
 		response.JSON(w, http.StatusBadRequest, response.RESTEnvelope{
 			Status:  status.BAD_REQUEST,
 			Message: err.Error(),
@@ -72,22 +158,98 @@ func (handler HTTPHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
+	defer file.Close()
 
-	resp, err := handler.EventUseCase.CreateEvent(ctx, req)
+	// one byte more than allowed is read so that an oversized import file is rejected rather than truncated
+	content, err := io.ReadAll(io.LimitReader(file, maxEventImportFileSize+1))
+	if err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	if int64(len(content)) > maxEventImportFileSize {
+		response.JSON(w, http.StatusRequestEntityTooLarge, response.RESTEnvelope{
+			Status:  status.BAD_REQUEST,
+			Message: i18n.Message(ctx, i18n.ImportTooLarge, maxEventImportFileSize),
+		})
+
+		return
+	}
+
+	format := strings.ToUpper(r.FormValue("format"))
+	if format == "" {
+		format = strings.ToUpper(strings.TrimPrefix(filepath.Ext(header.Filename), "."))
+	}
+
+	mode := strings.ToUpper(r.FormValue("mode"))
+	if mode == "" {
+		mode = EventImportModeAllOrNothing
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+
+	req := ImportEventsRequest{
+		Format:  format,
+		Mode:    mode,
+		DryRun:  dryRun,
+		Content: content,
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.EventUseCase.ImportEvents(ctx, req)
 	if err != nil {
 		ae := errors.Destruct(err)
 		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
 			Status:  ae.Status,
 			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
-	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
-		Status:  status.CREATED,
-		Message: "event has been successfully created",
+
+	if strings.EqualFold(r.FormValue("report"), "csv") {
+		report, err := resp.ErrorReportCSV()
+		if err != nil {
+			ae := errors.Destruct(err)
+			response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+				Status:  ae.Status,
+				Message: ae.Message,
+			})
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.errors.csv\"", strings.TrimSuffix(filepath.Base(header.Filename), filepath.Ext(header.Filename))))
+		w.WriteHeader(http.StatusOK)
+		w.Write(report)
+
+		return
+	}
+
+	outcome := i18n.Noun("imported")
+	if resp.DryRun {
+		outcome = i18n.Noun("checked")
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.DonePlural, i18n.Noun("events"), outcome),
 		Data:    resp,
 		Meta:    nil,
 	})
-
 }
@@ -0,0 +1,56 @@
+package event_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+func TestHTTPHandlerImportEvents(t *testing.T) {
+	// the use case is left out, an upload which is rejected never reaches it
+	handler := event.HTTPHandler{}
+
+	upload := func(t *testing.T, size int) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		form := multipart.NewWriter(body)
+
+		part, err := form.CreateFormFile("file", "events.jsonl")
+		require.NoError(t, err)
+		_, err = part.Write(bytes.Repeat([]byte("x"), size))
+		require.NoError(t, err)
+		require.NoError(t, form.Close())
+
+		r := httptest.NewRequest(http.MethodPost, "/tm-event/v1/adminapp/events/import", body)
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+
+		handler.ImportEvents(w, r)
+
+		return w
+	}
+
+	t.Run("an import file which is larger than allowed is rejected rather than truncated", func(t *testing.T) {
+		w := upload(t, 10<<20+1)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		var envelope response.RESTEnvelope
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+		assert.Equal(t, status.BAD_REQUEST, envelope.Status)
+	})
+
+	t.Run("a request body which is larger than allowed is not read", func(t *testing.T) {
+		w := upload(t, 12<<20)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
@@ -3,31 +3,30 @@ package event
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type LocationRepository interface {
-	FindByShowID(ctx context.Context, showID string, tx *sql.Tx) (Location, error)
-	Save(ctx context.Context, l Location, tx *sql.Tx) error
+	FindByShowID(ctx context.Context, showID string) (Location, error)
+	Save(ctx context.Context, l Location) error
+	SaveMany(ctx context.Context, locations []Location) error
 }
 
 type locationRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
 // FindByShowID implements LocationRepository.
-func (r *locationRepository) FindByShowID(ctx context.Context, showID string, tx *sql.Tx) (Location, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *locationRepository) FindByShowID(ctx context.Context, showID string) (Location, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
@@ -38,37 +37,26 @@ func (r *locationRepository) FindByShowID(ctx context.Context, showID string, tx
 		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return Location{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show location's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, showID)
+	row := cmd.QueryRowContext(ctx, query, showID)
 
 	var data Location
-	err = row.Scan(
+	err := row.Scan(
 		&data.EventID, &data.ShowID, &data.Country, &data.City, &data.FormattedAddress, &data.Latitude, &data.Longitude,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return Location{}, errors.New(http.StatusNotFound, status.NOT_FOUND, fmt.Sprintf("event show location's properties with id '%s' is not found", showID))
+			return Location{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show location"), showID))
 		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return Location{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show location's prorperties")
+		return Location{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show location's prorperties")
 	}
 
 	return data, nil
 }
 
 // Save implements LocationRepository.
-func (r *locationRepository) Save(ctx context.Context, l Location, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *locationRepository) Save(ctx context.Context, l Location) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO event_show_location
@@ -81,25 +69,70 @@ func (r *locationRepository) Save(ctx context.Context, l Location, tx *sql.Tx) e
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, l.EventID, l.ShowID, l.Country, l.City, l.FormattedAddress, l.Latitude, l.Longitude)
 	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show location's prorperties")
-	}
-	defer stmt.Close()
+		if code, _, ok := postgresql.ConstraintViolation(err); ok {
+			switch code {
+			case postgresql.UniqueViolation:
+				return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.AlreadyExistsByID, i18n.Noun("event show location"), l.ShowID))
+			case postgresql.ForeignKeyViolation:
+				return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), l.ShowID))
+			}
+		}
 
-	_, err = stmt.ExecContext(ctx, l.EventID, l.ShowID, l.Country, l.City, l.FormattedAddress, l.Latitude, l.Longitude)
-	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show location's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show location's prorperties")
 	}
 
 	return nil
 }
 
-func NewLocationRepository(logger *logrus.Logger, db *sql.DB) LocationRepository {
+func NewLocationRepository(logger *logrus.Logger, db *pgxpool.Pool) LocationRepository {
 	return &locationRepository{
 		logger: logger,
 		db:     db,
 	}
 }
+
+// SaveMany implements LocationRepository. The locations are inserted by one statement which is queued in the batch of
+// the event, its constraint violations are told apart once the batch is sent.
+func (r *locationRepository) SaveMany(ctx context.Context, locations []Location) error {
+	if len(locations) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_show_location
+		(
+			event_id, show_id, country, city, formatted_address, latitude, longitude
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::varchar[], $5::text[], $6::float8[], $7::float8[])
+	`
+
+	eventIDs := make([]string, len(locations))
+	showIDs := make([]string, len(locations))
+	countries := make([]string, len(locations))
+	cities := make([]string, len(locations))
+	formattedAddresses := make([]string, len(locations))
+	latitudes := make([]float64, len(locations))
+	longitudes := make([]float64, len(locations))
+	for k, l := range locations {
+		eventIDs[k] = l.EventID
+		showIDs[k] = l.ShowID
+		countries[k] = l.Country
+		cities[k] = l.City
+		formattedAddresses[k] = l.FormattedAddress
+		latitudes[k] = l.Latitude
+		longitudes[k] = l.Longitude
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, showIDs, countries, cities, formattedAddresses, latitudes, longitudes)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event show location's prorperties")
+	}
+
+	return nil
+}
@@ -2,25 +2,27 @@ package event
 
 import (
 	"context"
-	"database/sql"
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type PromotorRepository interface {
-	FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Promotor, error)
-	Save(ctx context.Context, p Promotor, tx *sql.Tx) error
+	FindManyByEventID(ctx context.Context, eventID string) ([]Promotor, error)
+	Save(ctx context.Context, p Promotor) error
+	SaveMany(ctx context.Context, promotors []Promotor) error
 }
 
 type promotorRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewPromotorRepository(logger *logrus.Logger, db *sql.DB) PromotorRepository {
+func NewPromotorRepository(logger *logrus.Logger, db *pgxpool.Pool) PromotorRepository {
 	return &promotorRepository{
 		logger: logger,
 		db:     db,
@@ -28,32 +30,23 @@ func NewPromotorRepository(logger *logrus.Logger, db *sql.DB) PromotorRepository
 }
 
 // FindManyByEventID implements PromotorRepository.
-func (r *promotorRepository) FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Promotor, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *promotorRepository) FindManyByEventID(ctx context.Context, eventID string) ([]Promotor, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
-		SELECT 
-			event_id, name, email, phone
-		FROM event_promotor
+		SELECT
+			ep.event_id, p.id, p.slug, p.name, p.email, p.phone
+		FROM event_promotor ep
+		JOIN promotor p ON p.id = ep.promotor_id
 		WHERE
-			event_id = $1
+			ep.event_id = $1
+		ORDER BY p.name ASC
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event promotor's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, eventID)
+	rows, err := cmd.QueryContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event promotor's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event promotor's prorperties")
 	}
 
 	defer rows.Close()
@@ -62,10 +55,10 @@ func (r *promotorRepository) FindManyByEventID(ctx context.Context, eventID stri
 	for rows.Next() {
 		var p Promotor
 
-		err := rows.Scan(&p.EventID, &p.Name, &p.Email, &p.Phone)
+		err := rows.Scan(&p.EventID, &p.PromotorID, &p.Slug, &p.Name, &p.Email, &p.Phone)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event promotor's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event promotor's prorperties")
 		}
 
 		data = append(data, p)
@@ -75,35 +68,56 @@ func (r *promotorRepository) FindManyByEventID(ctx context.Context, eventID stri
 }
 
 // Save implements PromotorRepository.
-func (r *promotorRepository) Save(ctx context.Context, p Promotor, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *promotorRepository) Save(ctx context.Context, p Promotor) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO event_promotor
 		(
-			event_id, name, email, phone
+			event_id, promotor_id
 		)
 		VALUES
 		(
-			$1, $2, $3, $4
+			$1, $2
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, p.EventID, p.PromotorID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
+	}
+
+	return nil
+}
+
+// SaveMany implements PromotorRepository. The promotors of the events are inserted by one statement.
+func (r *promotorRepository) SaveMany(ctx context.Context, promotors []Promotor) error {
+	if len(promotors) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_promotor
+		(
+			event_id, promotor_id
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[])
+	`
+
+	eventIDs := make([]string, len(promotors))
+	promotorIDs := make([]string, len(promotors))
+	for k, p := range promotors {
+		eventIDs[k] = p.EventID
+		promotorIDs[k] = p.PromotorID
 	}
-	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, p.EventID, p.Name, p.Email, p.Phone)
+	_, err := cmd.ExecContext(ctx, query, eventIDs, promotorIDs)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event artist's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event promotor's prorperties")
 	}
 
 	return nil
@@ -1,12 +1,16 @@
 package event
 
 import (
-	"math"
+	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
 	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/allocation"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
 )
 
 type CreateLocationRequest struct {
@@ -18,42 +22,105 @@ type CreateLocationRequest struct {
 }
 
 type CreateTicketAllocation struct {
-	Tier                   string  `json:"tier" validate:"oneof=WOOD BRONZE SILVER GOLD"`
-	AllocationByPercentage float64 `json:"allocation_by_percentage" validate:"required"`
-	Price                  float64 `json:"price" validate:"required"`
+	Tier                   string      `json:"tier" validate:"oneof=WOOD BRONZE SILVER GOLD"`
+	AllocationByPercentage float64     `json:"allocation_by_percentage" validate:"required"`
+	Price                  money.Money `json:"price" validate:"positive_money"`
+}
+
+// CreateShowTranslationRequest describes the venue of a show in another locale.
+type CreateShowTranslationRequest struct {
+	Locale string `json:"locale" validate:"oneof=en id"`
+	Venue  string `json:"venue" validate:"required"`
 }
 
 type CreateShowRequest struct {
-	Venue                 string                   `json:"venue" validate:"required"`
-	Type                  string                   `json:"type" validate:"oneof=LIVE HOLOGRAM_LIVE"`
-	Online                bool                     `json:"online" validate:"-"`
-	Location              *CreateLocationRequest   `json:"location" validate:"-"`
-	TotalTicketAllocation int64                    `json:"total_ticket_allocation"`
-	TicketAllocation      []CreateTicketAllocation `json:"ticket_allocation" validate:"required,dive"`
+	VenueID               string                         `json:"venue_id" validate:"required_without=Venue"`
+	Venue                 string                         `json:"venue" validate:"required_without=VenueID"`
+	Type                  string                         `json:"type" validate:"oneof=LIVE HOLOGRAM_LIVE"`
+	Currency              string                         `json:"currency" validate:"omitempty,iso4217"`
+	Online                bool                           `json:"online" validate:"-"`
+	Location              *CreateLocationRequest         `json:"location" validate:"-"`
+	TotalTicketAllocation int64                          `json:"total_ticket_allocation"`
+	TicketAllocation      []CreateTicketAllocation       `json:"ticket_allocation" validate:"required,dive"`
+	Translations          []CreateShowTranslationRequest `json:"translations" validate:"omitempty,dive"`
+}
+
+// CreateEventArtistRequest refers to an existing artist by its id or to a new one by its name.
+type CreateEventArtistRequest struct {
+	ID   string `json:"id" validate:"required_without=Name"`
+	Name string `json:"name" validate:"required_without=ID"`
+}
+
+// UnmarshalJSON accepts either an object or a plain string, the latter is taken as the name of the artist.
+func (r *CreateEventArtistRequest) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		r.Name = name
+		return nil
+	}
+
+	type plain CreateEventArtistRequest
+	return json.Unmarshal(b, (*plain)(r))
+}
+
+// CreateEventPromotorRequest refers to an existing promotor by its id or describes a new one.
+type CreateEventPromotorRequest struct {
+	ID    string `json:"id" validate:"required_without=Name"`
+	Name  string `json:"name" validate:"required_without=ID"`
+	Email string `json:"email" validate:"omitempty,email"`
+	Phone string `json:"phone" validate:"-"`
+}
+
+// CreateEventTranslationRequest describes the event in another locale, a field which is left empty falls back to the
+// one of the event.
+type CreateEventTranslationRequest struct {
+	Locale      string `json:"locale" validate:"oneof=en id"`
+	Name        string `json:"name" validate:"required_without=Description"`
+	Description string `json:"description" validate:"required_without=Name"`
 }
 
 type CreateEventRequest struct {
-	Name        string   `json:"name" validate:"required"`
-	Description string   `json:"description" validate:"required"`
-	Artists     []string `json:"artists" validate:"required,dive,required"`
-	Promotors   []struct {
-		Name  string `json:"name" validate:"required"`
-		Email string `json:"email" validate:"email"`
-		Phone string `json:"phone" validate:"required"`
-	} `json:"promotors" validate:"required,dive"`
-	OnlineTicketPrice           float64             `json:"online_ticket_price" validate:"required"`
-	TotalOnlineTicketAllocation int64               `json:"total_online_ticket_allocation" validate:"required"`
-	Shows                       []CreateShowRequest `json:"shows" validate:"required,dive,required"`
-	ShowTime                    string              `json:"show_time" validate:"datetime=2006-01-02 15:04:05"`
-	OrderRuleDay                []int64             `json:"order_rule_day" validate:"omitempty,dive,min=0,max=6"`
+	Name                        string                       `json:"name" validate:"required"`
+	Description                 string                       `json:"description" validate:"required"`
+	Artists                     []CreateEventArtistRequest   `json:"artists" validate:"required,dive"`
+	Promotors                   []CreateEventPromotorRequest `json:"promotors" validate:"required,dive"`
+	Currency                    string                       `json:"currency" validate:"iso4217"`
+	OnlineTicketPrice           money.Money                  `json:"online_ticket_price" validate:"positive_money"`
+	TotalOnlineTicketAllocation int64                        `json:"total_online_ticket_allocation" validate:"required"`
+	Shows                       []CreateShowRequest          `json:"shows" validate:"required,dive,required"`
+	ShowTime                    string                       `json:"show_time" validate:"datetime=2006-01-02 15:04:05"`
+	OrderRuleDay                []int64                      `json:"order_rule_day" validate:"omitempty,dive,min=0,max=6"`
 	OrderRuleRangeDate          struct {
 		StartDate string `json:"start_date" validate:"datetime=2006-01-02 15:04:05"`
 		EndDate   string `json:"end_date" validate:"datetime=2006-01-02 15:04:05"`
 	} `json:"order_rule_range_date" validate:"required"`
-	OrderRuleMaximumTicket int64 `json:"order_rule_maximum_ticket" validate:"-"`
+	OrderRuleMaximumTicket int64                           `json:"order_rule_maximum_ticket" validate:"-"`
+	Translations           []CreateEventTranslationRequest `json:"translations" validate:"omitempty,dive"`
+	Category               string                          `json:"category" validate:"-"`
+	Genres                 []string                        `json:"genres" validate:"omitempty,unique,dive,required"`
+	Tags                   []string                        `json:"tags" validate:"omitempty,unique,dive,required"`
+}
+
+// VenueIDs returns the distinct venue ids which are referenced by the shows.
+func (r CreateEventRequest) VenueIDs() []string {
+	ids := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, v := range r.Shows {
+		if v.VenueID == "" || seen[v.VenueID] {
+			continue
+		}
+		seen[v.VenueID] = true
+		ids = append(ids, v.VenueID)
+	}
+
+	return ids
 }
 
-func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time) (Event, error) {
+func (r CreateEventRequest) ToEntityEvent(ctx context.Context, location *time.Location, now time.Time, venues map[string]venue.Venue) (Event, error) {
+	if err := r.Validate(ctx, location, venues); err != nil {
+		return Event{}, err
+	}
+
 	event := Event{
 		ID:          util.GenerateTimestampWithPrefix("EVENT"),
 		Name:        r.Name,
@@ -62,6 +129,7 @@ func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time
 		Shows:       nil,
 		Description: r.Description,
 		Status:      "ACTIVE",
+		Currency:    r.Currency,
 		OrderRules:  OrderRuleAggregation{},
 		CreatedAt:   now,
 		UpdatedAt:   now,
@@ -70,10 +138,11 @@ func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time
 	promotors := make([]Promotor, len(r.Promotors))
 	for k, v := range r.Promotors {
 		promotors[k] = Promotor{
-			EventID: event.ID,
-			Name:    v.Name,
-			Email:   v.Email,
-			Phone:   v.Phone,
+			EventID:    event.ID,
+			PromotorID: v.ID,
+			Name:       v.Name,
+			Email:      v.Email,
+			Phone:      v.Phone,
 		}
 	}
 	event.Promotors = promotors
@@ -81,46 +150,95 @@ func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time
 	artists := make([]Artist, len(r.Artists))
 	for k, v := range r.Artists {
 		artists[k] = Artist{
-			EventID: event.ID,
-			Name:    v,
+			EventID:  event.ID,
+			ArtistID: v.ID,
+			Name:     v.Name,
 		}
 	}
 	event.Artists = artists
 
-	showTime, _ := time.ParseInLocation(time.DateTime, r.ShowTime, location)
+	translations := make([]EventTranslation, len(r.Translations))
+	for k, v := range r.Translations {
+		translations[k] = EventTranslation{
+			EventID:     event.ID,
+			Locale:      v.Locale,
+			Name:        v.Name,
+			Description: v.Description,
+		}
+	}
+	event.Translations = translations
+
+	onlineShows := 0
+	for _, v := range r.Shows {
+		if v.Online {
+			onlineShows++
+		}
+	}
+	onlineAllocations := allocation.Even(r.TotalOnlineTicketAllocation, onlineShows)
 
 	shows := make([]Show, 0)
 	for _, v := range r.Shows {
+		showLocation := location
 		liveShow := Show{
-			EventID: event.ID,
-			ID:      util.GenerateTimestampWithPrefix("SHOW"),
-			Venue:   v.Venue,
-			Type:    v.Type,
-			Time:    showTime,
-			Status:  "ACTIVE",
+			EventID:  event.ID,
+			ID:       util.GenerateTimestampWithPrefix("SHOW"),
+			Venue:    v.Venue,
+			Type:     v.Type,
+			Status:   "ACTIVE",
+			Currency: event.Currency,
 		}
-		liveShow.Location = &Location{
-			EventID:          event.ID,
-			ShowID:           liveShow.ID,
-			Country:          v.Location.Country,
-			City:             v.Location.City,
-			FormattedAddress: v.Location.FormattedAddress,
-			Latitude:         v.Location.Latitude,
-			Longitude:        v.Location.Longitude,
+		if v.Currency != "" {
+			liveShow.Currency = v.Currency
 		}
 
-		liveShowTicketStock := make([]ticket.TicketStock, len(v.TicketAllocation))
-		for tark, tarv := range v.TicketAllocation {
+		for _, t := range v.Translations {
+			liveShow.Translations = append(liveShow.Translations, ShowTranslation{
+				EventID: event.ID,
+				ShowID:  liveShow.ID,
+				Locale:  t.Locale,
+				Venue:   t.Venue,
+			})
+		}
+
+		if v.VenueID != "" {
+			ven := venues[v.VenueID]
+
+			if venueLocation, err := time.LoadLocation(ven.Timezone); err == nil {
+				showLocation = venueLocation
+			}
+
+			liveShow.VenueID = &ven.ID
+			liveShow.Venue = ven.Name
+		} else {
+			liveShow.Location = &Location{
+				EventID:          event.ID,
+				ShowID:           liveShow.ID,
+				Country:          v.Location.Country,
+				City:             v.Location.City,
+				FormattedAddress: v.Location.FormattedAddress,
+				Latitude:         v.Location.Latitude,
+				Longitude:        v.Location.Longitude,
+			}
+		}
+
+		showTime, _ := time.ParseInLocation(time.DateTime, r.ShowTime, showLocation)
+		liveShow.Time = showTime
 
-			allocation := int64(math.Round(tarv.AllocationByPercentage / 100 * float64(v.TotalTicketAllocation)))
+		percentages := make([]float64, len(v.TicketAllocation))
+		for tark, tarv := range v.TicketAllocation {
+			percentages[tark] = tarv.AllocationByPercentage
+		}
+		tierAllocations := allocation.LargestRemainder(v.TotalTicketAllocation, percentages)
 
+		liveShowTicketStock := make([]ticket.TicketStock, len(v.TicketAllocation))
+		for tark, tarv := range v.TicketAllocation {
 			liveShowTicketStock[tark] = ticket.TicketStock{
 				EventID:         event.ID,
 				ShowID:          liveShow.ID,
 				ID:              util.GenerateTimestampWithPrefix("TSTK"),
 				OnlineFor:       nil,
 				Tier:            tarv.Tier,
-				Allocation:      allocation,
+				Allocation:      tierAllocations[tark],
 				Price:           tarv.Price,
 				Acquired:        0,
 				LastStockUpdate: now,
@@ -132,38 +250,29 @@ func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time
 
 		if v.Online {
 			onlineShow := Show{
-				EventID: event.ID,
-				ID:      util.GenerateTimestampWithPrefix("SHOW"),
-				Venue:   VenueOnline,
-				Type:    ShowTypeOnline,
-				Time:    showTime,
-				Status:  "ACTIVE",
-			}
-			onlineShow.Location = &Location{
-				EventID:          event.ID,
-				ShowID:           onlineShow.ID,
-				Country:          v.Location.Country,
-				City:             v.Location.City,
-				FormattedAddress: v.Location.FormattedAddress,
-				Latitude:         v.Location.Latitude,
-				Longitude:        v.Location.Longitude,
+				EventID:  event.ID,
+				ID:       util.GenerateTimestampWithPrefix("SHOW"),
+				Venue:    VenueOnline,
+				Type:     ShowTypeOnline,
+				Time:     showTime,
+				Status:   "ACTIVE",
+				Currency: event.Currency,
 			}
 
-			defaultOnlineAllocationPercentage := float64(100) / float64(len(r.Shows))
-			allocation := int64(math.Round(defaultOnlineAllocationPercentage / 100 * float64(r.TotalOnlineTicketAllocation)))
 			onlineShow.TicketStock = append(onlineShow.TicketStock, ticket.TicketStock{
 				EventID:         event.ID,
 				ShowID:          onlineShow.ID,
 				ID:              util.GenerateTimestampWithPrefix("TSTK"),
 				OnlineFor:       &liveShow.ID,
 				Tier:            TicketTierOnline,
-				Allocation:      allocation,
+				Allocation:      onlineAllocations[0],
 				Price:           r.OnlineTicketPrice,
 				Acquired:        0,
 				LastStockUpdate: now,
 			})
 
 			shows = append(shows, onlineShow)
+			onlineAllocations = onlineAllocations[1:]
 		}
 	}
 
@@ -190,3 +299,21 @@ func (r CreateEventRequest) ToEntityEvent(location *time.Location, now time.Time
 
 	return event, nil
 }
+
+// UpdateEventTaxonomyRequest replaces the category, the genres and the tags of an event. The category and the genres
+// refer to registered ones by their id or slug, a tag which is not registered yet is registered by its name.
+type UpdateEventTaxonomyRequest struct {
+	EventID  string   `json:"-"`
+	Category string   `json:"category" validate:"-"`
+	Genres   []string `json:"genres" validate:"omitempty,unique,dive,required"`
+	Tags     []string `json:"tags" validate:"omitempty,unique,dive,required"`
+}
+
+// ImportEventsRequest creates the events of an import file, every event is validated as the one of a create event
+// request. A dry run only reports what would be created.
+type ImportEventsRequest struct {
+	Format  string `validate:"oneof=CSV JSONL"`
+	Mode    string `validate:"oneof=ALL_OR_NOTHING PER_EVENT"`
+	DryRun  bool   `validate:"-"`
+	Content []byte `validate:"required"`
+}
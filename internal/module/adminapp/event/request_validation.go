@@ -0,0 +1,148 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+)
+
+// allocationPercentageTolerance absorbs the floating point error of percentages which sum up to 100.
+const allocationPercentageTolerance = 1e-6
+
+// createEventValidation collects the field errors of a create event request.
+type createEventValidation struct {
+	ctx         context.Context
+	fieldErrors []errors.FieldError
+}
+
+func (v *createEventValidation) invalid(field, rule string, key i18n.Key, args ...any) {
+	v.fieldErrors = append(v.fieldErrors, errors.FieldError{
+		Field:   field,
+		Rule:    rule,
+		Message: i18n.Message(v.ctx, key, args...),
+	})
+}
+
+func (v *createEventValidation) err() error {
+	if len(v.fieldErrors) == 0 {
+		return nil
+	}
+
+	return errors.NewValidation(v.fieldErrors)
+}
+
+// Validate checks the rules of the event which can not be expressed by the validation tags, the venues are the ones
+// which are referenced by the shows. Every violation is reported as a field error rather than stopping at the first.
+func (r CreateEventRequest) Validate(ctx context.Context, location *time.Location, venues map[string]venue.Venue) error {
+	v := &createEventValidation{ctx: ctx}
+
+	for k, p := range r.Promotors {
+		if p.ID == "" && (p.Email == "" || p.Phone == "") {
+			v.invalid(fmt.Sprintf("promotors[%d]", k), "required", i18n.NewPromotorContact, p.Name)
+		}
+	}
+
+	locales := make(map[string]int)
+	for k, t := range r.Translations {
+		if first, ok := locales[t.Locale]; ok {
+			v.invalid(fmt.Sprintf("translations[%d].locale", k), "unique", i18n.LocaleTranslated, t.Locale, fmt.Sprintf("translations[%d]", first))
+		} else {
+			locales[t.Locale] = k
+		}
+	}
+
+	if r.OnlineTicketPrice.Currency != r.Currency {
+		v.invalid("online_ticket_price.currency", "eq", i18n.OnlinePriceCurrency, r.Currency)
+	}
+
+	startDate, startDateErr := time.ParseInLocation(time.DateTime, r.OrderRuleRangeDate.StartDate, location)
+	if startDateErr != nil {
+		v.invalid("order_rule_range_date.start_date", "datetime", i18n.InvalidValue, "start_date", r.OrderRuleRangeDate.StartDate)
+	}
+	endDate, endDateErr := time.ParseInLocation(time.DateTime, r.OrderRuleRangeDate.EndDate, location)
+	if endDateErr != nil {
+		v.invalid("order_rule_range_date.end_date", "datetime", i18n.InvalidValue, "end_date", r.OrderRuleRangeDate.EndDate)
+	}
+	if startDateErr == nil && endDateErr == nil && !startDate.Before(endDate) {
+		v.invalid("order_rule_range_date.end_date", "gtfield", i18n.MustBeAfter, "end_date", "start_date")
+	}
+
+	showTimeReported := false
+	for k, s := range r.Shows {
+		field := fmt.Sprintf("shows[%d]", k)
+		showLocation := location
+
+		currency := r.Currency
+		if s.Currency != "" {
+			currency = s.Currency
+		}
+
+		if s.VenueID != "" {
+			ven, ok := venues[s.VenueID]
+			if !ok {
+				v.invalid(field+".venue_id", "exists", i18n.NotFoundByID, i18n.Noun("venue"), s.VenueID)
+			} else {
+				if s.TotalTicketAllocation > ven.Capacity {
+					v.invalid(field+".total_ticket_allocation", "lte", i18n.VenueCapacityExceeded, s.TotalTicketAllocation, ven.Capacity, ven.Name)
+				}
+
+				if venueLocation, err := time.LoadLocation(ven.Timezone); err == nil {
+					showLocation = venueLocation
+				}
+			}
+		} else if s.Location == nil {
+			v.invalid(field+".location", "required_without", i18n.ShowVenueOrLocation, s.Venue)
+		}
+
+		showLocales := make(map[string]int)
+		for j, t := range s.Translations {
+			if first, ok := showLocales[t.Locale]; ok {
+				v.invalid(fmt.Sprintf("%s.translations[%d].locale", field, j), "unique", i18n.LocaleTranslated, t.Locale, fmt.Sprintf("%s.translations[%d]", field, first))
+			} else {
+				showLocales[t.Locale] = j
+			}
+		}
+
+		if s.TotalTicketAllocation <= 0 {
+			v.invalid(field+".total_ticket_allocation", "gt", i18n.ShowAllocationPositive, s.Venue)
+		}
+
+		showTime, err := time.ParseInLocation(time.DateTime, r.ShowTime, showLocation)
+		if err == nil && endDateErr == nil && !endDate.Before(showTime) && !showTimeReported {
+			v.invalid("show_time", "gtfield", i18n.ShowTimeAfterOrderRule)
+			showTimeReported = true
+		}
+
+		tiers := make(map[string]int)
+		var percentage float64
+		for j, t := range s.TicketAllocation {
+			tierField := fmt.Sprintf("%s.ticket_allocation[%d]", field, j)
+
+			if first, ok := tiers[t.Tier]; ok {
+				v.invalid(tierField+".tier", "unique", i18n.TierAllocated, t.Tier, fmt.Sprintf("%s.ticket_allocation[%d]", field, first))
+			} else {
+				tiers[t.Tier] = j
+			}
+
+			if t.AllocationByPercentage <= 0 || t.AllocationByPercentage > 100 {
+				v.invalid(tierField+".allocation_by_percentage", "range", i18n.TierAllocationRange, t.Tier)
+			}
+			percentage += t.AllocationByPercentage
+
+			if t.Price.Currency != currency {
+				v.invalid(tierField+".price.currency", "eq", i18n.TierPriceCurrency, t.Tier, currency)
+			}
+		}
+
+		if len(s.TicketAllocation) > 0 && math.Abs(percentage-100) > allocationPercentageTolerance {
+			v.invalid(field+".ticket_allocation", "sum", i18n.AllocationSum, percentage)
+		}
+	}
+
+	return v.err()
+}
@@ -0,0 +1,59 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+)
+
+func TestCreateEventRequestValidateVenue(t *testing.T) {
+	// the shows of the request are at the venue VENUE1 rather than at a location of their own
+	payload := `{"name":"Concert","description":"A concert","artists":[{"name":"Artist A"}],` +
+		`"promotors":[{"name":"Promotor","email":"promotor@mail.com","phone":"0812"}],` +
+		`"currency":"IDR","online_ticket_price":{"amount":"50000","currency":"IDR"},"total_online_ticket_allocation":10,` +
+		`"shows":[{"venue_id":"VENUE1","type":"LIVE","total_ticket_allocation":100,` +
+		`"ticket_allocation":[{"tier":"GOLD","allocation_by_percentage":40,"price":{"amount":"500000","currency":"IDR"}},` +
+		`{"tier":"SILVER","allocation_by_percentage":60,"price":{"amount":"250000","currency":"IDR"}}]}],` +
+		`"show_time":"2030-01-01 19:00:00","order_rule_range_date":{"start_date":"2029-12-01 00:00:00","end_date":"2029-12-31 00:00:00"}}`
+
+	var req event.CreateEventRequest
+	require.NoError(t, json.Unmarshal([]byte(payload), &req))
+
+	// violations returns the fields and the rules which are reported by the validation
+	violations := func(venues map[string]venue.Venue) map[string]string {
+		err := req.Validate(context.Background(), time.UTC, venues)
+		if err == nil {
+			return nil
+		}
+
+		data := make(map[string]string)
+		for _, fe := range errors.Destruct(err).Errors {
+			data[fe.Field] = fe.Rule
+		}
+
+		return data
+	}
+
+	t.Run("a show which fits in its venue is valid", func(t *testing.T) {
+		assert.Empty(t, violations(map[string]venue.Venue{
+			"VENUE1": {ID: "VENUE1", Name: "Hall", Capacity: 100, Timezone: "Asia/Jakarta"},
+		}))
+	})
+
+	t.Run("a show whose allocation exceeds the capacity of its venue is reported", func(t *testing.T) {
+		assert.Equal(t, map[string]string{"shows[0].total_ticket_allocation": "lte"}, violations(map[string]venue.Venue{
+			"VENUE1": {ID: "VENUE1", Name: "Hall", Capacity: 99, Timezone: "Asia/Jakarta"},
+		}))
+	})
+
+	t.Run("a show of an unknown venue is reported", func(t *testing.T) {
+		assert.Equal(t, map[string]string{"shows[0].venue_id": "exists"}, violations(map[string]venue.Venue{}))
+	})
+}
@@ -1,13 +1,28 @@
 package event
 
-import "time"
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/taxonomy"
+)
 
 type PromotorResponse struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
 	Phone string `json:"phone"`
 }
 
+type ArtistResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
 type LocationResponse struct {
 	Country          string  `json:"country"`
 	City             string  `json:"city"`
@@ -16,25 +31,77 @@ type LocationResponse struct {
 	Longitude        float64 `json:"longitude"`
 }
 
+type ShowTranslationResponse struct {
+	Locale string `json:"locale"`
+	Venue  string `json:"venue"`
+}
+
 type ShowResponse struct {
-	ID       string            `json:"id"`
-	Venue    string            `json:"venue"`
-	Type     string            `json:"type"`
-	Location *LocationResponse `json:"location"`
-	Time     time.Time         `json:"time"`
-	Status   string            `json:"status"`
+	ID           string                    `json:"id"`
+	VenueID      *string                   `json:"venue_id"`
+	Venue        string                    `json:"venue"`
+	Type         string                    `json:"type"`
+	Location     *LocationResponse         `json:"location"`
+	Time         time.Time                 `json:"time"`
+	Status       string                    `json:"status"`
+	Currency     string                    `json:"currency"`
+	Translations []ShowTranslationResponse `json:"translations"`
 }
 
-type CreateEventResponse struct {
-	ID          string `json:"id"`
+type EventTranslationResponse struct {
+	Locale      string `json:"locale"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
-	Status      string `json:"status"`
-	Promotors   []PromotorResponse
-	Artists     []string `json:"artists"`
-	Shows       []ShowResponse
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type TaxonomyResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// EventTaxonomyResponse holds the category, the genres and the tags of an event.
+type EventTaxonomyResponse struct {
+	Category *TaxonomyResponse  `json:"category"`
+	Genres   []TaxonomyResponse `json:"genres"`
+	Tags     []TaxonomyResponse `json:"tags"`
+}
+
+func (r *EventTaxonomyResponse) PopulateFromEntity(taxonomies []Taxonomy) {
+	r.Genres = make([]TaxonomyResponse, 0)
+	r.Tags = make([]TaxonomyResponse, 0)
+
+	for _, v := range taxonomies {
+		t := TaxonomyResponse{
+			ID:   v.TaxonomyID,
+			Slug: v.Slug,
+			Name: v.Name,
+		}
+
+		switch v.Type {
+		case taxonomy.TypeCategory:
+			r.Category = &t
+		case taxonomy.TypeGenre:
+			r.Genres = append(r.Genres, t)
+		case taxonomy.TypeTag:
+			r.Tags = append(r.Tags, t)
+		}
+	}
+}
+
+type CreateEventResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Status       string `json:"status"`
+	Currency     string `json:"currency"`
+	Promotors    []PromotorResponse
+	Artists      []ArtistResponse `json:"artists"`
+	Shows        []ShowResponse
+	Translations []EventTranslationResponse `json:"translations"`
+	EventTaxonomyResponse
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 func (r *CreateEventResponse) PopulateFromEntity(e Event) {
@@ -42,9 +109,12 @@ func (r *CreateEventResponse) PopulateFromEntity(e Event) {
 	r.Name = e.Name
 	r.Description = e.Description
 	r.Status = e.Status
+	r.Currency = e.Currency
 
 	for _, v := range e.Promotors {
 		r.Promotors = append(r.Promotors, PromotorResponse{
+			ID:    v.PromotorID,
+			Slug:  v.Slug,
 			Name:  v.Name,
 			Email: v.Email,
 			Phone: v.Phone,
@@ -52,7 +122,11 @@ func (r *CreateEventResponse) PopulateFromEntity(e Event) {
 	}
 
 	for _, v := range e.Artists {
-		r.Artists = append(r.Artists, v.Name)
+		r.Artists = append(r.Artists, ArtistResponse{
+			ID:   v.ArtistID,
+			Slug: v.Slug,
+			Name: v.Name,
+		})
 	}
 
 	for _, v := range e.Shows {
@@ -66,16 +140,81 @@ func (r *CreateEventResponse) PopulateFromEntity(e Event) {
 				Longitude:        v.Location.Longitude,
 			}
 		}
+		translations := make([]ShowTranslationResponse, len(v.Translations))
+		for k, t := range v.Translations {
+			translations[k] = ShowTranslationResponse{
+				Locale: t.Locale,
+				Venue:  t.Venue,
+			}
+		}
 		r.Shows = append(r.Shows, ShowResponse{
-			ID:       v.ID,
-			Venue:    v.Venue,
-			Type:     v.Type,
-			Time:     v.Time,
-			Status:   v.Status,
-			Location: location,
+			ID:           v.ID,
+			VenueID:      v.VenueID,
+			Venue:        v.Venue,
+			Type:         v.Type,
+			Time:         v.Time,
+			Status:       v.Status,
+			Currency:     v.Currency,
+			Location:     location,
+			Translations: translations,
 		})
 	}
 
+	r.Translations = make([]EventTranslationResponse, len(e.Translations))
+	for k, v := range e.Translations {
+		r.Translations[k] = EventTranslationResponse{
+			Locale:      v.Locale,
+			Name:        v.Name,
+			Description: v.Description,
+		}
+	}
+
+	r.EventTaxonomyResponse.PopulateFromEntity(e.Taxonomies)
+
 	r.CreatedAt = e.CreatedAt
 	r.UpdatedAt = e.UpdatedAt
 }
+
+// ImportedEventResponse is the outcome of an event of an import file, the event id is given once it is created.
+type ImportedEventResponse struct {
+	Ref     string `json:"ref"`
+	Row     int    `json:"row"`
+	EventID string `json:"event_id,omitempty"`
+	Status  string `json:"status"`
+}
+
+type EventImportErrorResponse struct {
+	Row     int    `json:"row"`
+	Ref     string `json:"ref"`
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type ImportEventsResponse struct {
+	DryRun  bool                       `json:"dry_run"`
+	Mode    string                     `json:"mode"`
+	Total   int                        `json:"total"`
+	Created int                        `json:"created"`
+	Failed  int                        `json:"failed"`
+	Events  []ImportedEventResponse    `json:"events"`
+	Errors  []EventImportErrorResponse `json:"errors"`
+}
+
+// ErrorReportCSV returns the errors of the import as a csv whose rows refer to the rows of the import file.
+func (r ImportEventsResponse) ErrorReportCSV() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+
+	if err := writer.Write([]string{"row", "event", "field", "rule", "message"}); err != nil {
+		return nil, err
+	}
+	for _, v := range r.Errors {
+		if err := writer.Write([]string{strconv.Itoa(v.Row), v.Ref, v.Field, v.Rule, v.Message}); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
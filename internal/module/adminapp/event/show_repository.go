@@ -3,27 +3,31 @@ package event
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type ShowRepository interface {
-	Save(ctx context.Context, s Show, tx *sql.Tx) error
-	FindByID(ctx context.Context, ID string, tx *sql.Tx) (Show, error)
-	FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Show, error)
-	Update(ctx context.Context, ID string, s Show, tx *sql.Tx) error
+	Save(ctx context.Context, s Show) error
+	SaveMany(ctx context.Context, shows []Show) error
+	FindByID(ctx context.Context, ID string) (Show, error)
+	FindManyByEventID(ctx context.Context, eventID string) ([]Show, error)
+	Update(ctx context.Context, ID string, s Show) error
 }
 
 type showRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewShowRepository(logger *logrus.Logger, db *sql.DB) ShowRepository {
+func NewShowRepository(logger *logrus.Logger, db *pgxpool.Pool) ShowRepository {
 	return &showRepository{
 		logger: logger,
 		db:     db,
@@ -31,73 +35,56 @@ func NewShowRepository(logger *logrus.Logger, db *sql.DB) ShowRepository {
 }
 
 // FindByID implements ShowRepository.
-func (r *showRepository) FindByID(ctx context.Context, ID string, tx *sql.Tx) (Show, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) FindByID(ctx context.Context, ID string) (Show, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		FROM event_show
 		WHERE
 			id = $1
 		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return Show{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, ID)
+	row := cmd.QueryRowContext(ctx, query, ID)
 
 	var data Show
-	err = row.Scan(
-		&data.EventID, &data.ID, &data.Venue, &data.Type, &data.Time, &data.Status,
+	var venueID sql.NullString
+	err := row.Scan(
+		&data.EventID, &data.ID, &venueID, &data.Venue, &data.Type, &data.Time, &data.Status, &data.Currency,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return Show{}, errors.New(http.StatusNotFound, status.NOT_FOUND, fmt.Sprintf("event show's properties with id '%s' is not found", ID))
+			return Show{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), ID))
 		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return Show{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
+		return Show{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
+	}
+
+	if venueID.Valid {
+		data.VenueID = &venueID.String
 	}
 
 	return data, nil
 }
 
 // FindManyByEventID implements ShowRepository.
-func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Show, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string) ([]Show, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		FROM event_show
 		WHERE
 			event_id = $1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	rows, err := cmd.QueryContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, eventID)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
 	}
 
 	defer rows.Close()
@@ -105,11 +92,16 @@ func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string,
 	var data = make([]Show, 0)
 	for rows.Next() {
 		var s Show
+		var venueID sql.NullString
 
-		err := rows.Scan(&s.EventID, &s.ID, &s.Venue, &s.Type, &s.Time, &s.Status)
+		err := rows.Scan(&s.EventID, &s.ID, &venueID, &s.Venue, &s.Type, &s.Time, &s.Status, &s.Currency)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+		}
+
+		if venueID.Valid {
+			s.VenueID = &venueID.String
 		}
 
 		data = append(data, s)
@@ -119,69 +111,104 @@ func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string,
 }
 
 // Save implements ShowRepository.
-func (r *showRepository) Save(ctx context.Context, s Show, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) Save(ctx context.Context, s Show) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO event_show
 		(
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		)
 		VALUES
 		(
-			$1, $2, $3, $4, $5, $6
+			$1, $2, $3, $4, $5, $6, $7, $8
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, s.EventID, s.ID, s.VenueID, s.Venue, s.Type, s.Time, s.Status, s.Currency)
 	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
-	}
-	defer stmt.Close()
+		if code, constraint, ok := postgresql.ConstraintViolation(err); ok {
+			switch {
+			case code == postgresql.UniqueViolation:
+				return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.AlreadyExistsByID, i18n.Noun("event show"), s.ID))
+			case code == postgresql.ForeignKeyViolation && constraint == "event_show_venue_id_fkey" && s.VenueID != nil:
+				return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("venue"), *s.VenueID))
+			case code == postgresql.ForeignKeyViolation:
+				return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event"), s.EventID))
+			}
+		}
 
-	_, err = stmt.ExecContext(ctx, s.EventID, s.ID, s.Venue, s.Type, s.Time, s.Status)
-	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
 	}
 
 	return nil
 }
 
 // Update implements ShowRepository.
-func (r *showRepository) Update(ctx context.Context, ID string, s Show, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) Update(ctx context.Context, ID string, s Show) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		UPDATE event_show
 		SET
-			venue = $1,
-			type = $2,
-			time = $3,
-			status = $4
-		WHERE id = $5
+			venue_id = $1,
+			venue = $2,
+			type = $3,
+			time = $4,
+			status = $5
+		WHERE id = $6
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, s.VenueID, s.Venue, s.Type, s.Time, s.Status, ID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event shows's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event show's prorperties")
+	}
+
+	return nil
+}
+
+// SaveMany implements ShowRepository. The shows are inserted by one statement which is queued in the batch of the
+// event, its constraint violations are told apart once the batch is sent.
+func (r *showRepository) SaveMany(ctx context.Context, shows []Show) error {
+	if len(shows) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_show
+		(
+			event_id, id, venue_id, venue, type, time, status, currency
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::varchar[], $5::varchar[], $6::timestamptz[], $7::varchar[], $8::varchar[])
+	`
+
+	eventIDs := make([]string, len(shows))
+	IDs := make([]string, len(shows))
+	venueIDs := make([]*string, len(shows))
+	venues := make([]string, len(shows))
+	types := make([]string, len(shows))
+	times := make([]time.Time, len(shows))
+	statuses := make([]string, len(shows))
+	currencies := make([]string, len(shows))
+	for k, s := range shows {
+		eventIDs[k] = s.EventID
+		IDs[k] = s.ID
+		venueIDs[k] = s.VenueID
+		venues[k] = s.Venue
+		types[k] = s.Type
+		times[k] = s.Time
+		statuses[k] = s.Status
+		currencies[k] = s.Currency
 	}
-	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, s.Venue, s.Type, s.Time, s.Status)
+	_, err := cmd.ExecContext(ctx, query, eventIDs, IDs, venueIDs, venues, types, times, statuses, currencies)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event show's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event show's prorperties")
 	}
 
 	return nil
@@ -0,0 +1,116 @@
+package event_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+// openTestDatabase connects to the database of POSTGRESQL_TEST_DSN and migrates it, the test is skipped without one.
+func openTestDatabase(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRESQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRESQL_TEST_DSN is not set")
+	}
+
+	db, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	require.NoError(t, err)
+
+	_, err = migration.NewMigrator(db, ms).Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestShowRepositorySaveManyBatch(t *testing.T) {
+	db := openTestDatabase(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	shows := event.NewShowRepository(logger, db)
+	locations := event.NewLocationRepository(logger, db)
+	tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Microsecond)
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+	eventID := "EVTTEST" + suffix
+
+	_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2)`, eventID, now)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM event_show_location WHERE event_id = $1`, eventID)
+		db.Exec(ctx, `DELETE FROM event_show WHERE event_id = $1`, eventID)
+		db.Exec(ctx, `DELETE FROM event WHERE id = $1`, eventID)
+	})
+
+	// show returns a show of the event at the hall along with its location
+	show := func(ID string) (event.Show, event.Location) {
+		return event.Show{EventID: eventID, ID: ID, Venue: "Hall", Type: event.ShowTypeLive, Time: now.Add(24 * time.Hour), Status: "ACTIVE", Currency: "IDR"},
+			event.Location{EventID: eventID, ShowID: ID, Country: "Indonesia", City: "Jakarta", FormattedAddress: "Jl. Sudirman 1, Jakarta", Latitude: -6.2, Longitude: 106.8}
+	}
+
+	// save writes the shows and their locations in one batch of one transaction
+	save := func(IDs ...string) error {
+		ss := make([]event.Show, len(IDs))
+		ls := make([]event.Location, len(IDs))
+		for k, ID := range IDs {
+			ss[k], ls[k] = show(ID)
+		}
+
+		return tm.WithinTx(ctx, func(ctx context.Context) error {
+			return tm.WithinBatch(ctx, func(ctx context.Context) error {
+				if err := shows.SaveMany(ctx, ss); err != nil {
+					return err
+				}
+
+				return locations.SaveMany(ctx, ls)
+			})
+		})
+	}
+
+	t.Run("every row of a multi-row insert is written", func(t *testing.T) {
+		require.NoError(t, save("SHOWTEST1"+suffix, "SHOWTEST2"+suffix, "SHOWTEST3"+suffix))
+
+		saved, err := shows.FindManyByEventID(ctx, eventID)
+		require.NoError(t, err)
+		require.Len(t, saved, 3)
+
+		for _, s := range saved {
+			l, err := locations.FindByShowID(ctx, s.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Jakarta", l.City)
+			assert.Equal(t, -6.2, l.Latitude)
+		}
+	})
+
+	t.Run("a batch whose rows violate a constraint is rolled back as a whole", func(t *testing.T) {
+		err := save("SHOWTEST4"+suffix, "SHOWTEST1"+suffix)
+
+		code, constraint, ok := postgresql.ConstraintViolation(err)
+		require.True(t, ok, err)
+		assert.Equal(t, postgresql.UniqueViolation, code)
+		assert.Equal(t, "event_show_pkey", constraint)
+
+		_, err = shows.FindByID(ctx, "SHOWTEST4"+suffix)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ShowTranslationRepository interface {
+	Save(ctx context.Context, t ShowTranslation) error
+	SaveMany(ctx context.Context, translations []ShowTranslation) error
+}
+
+type showTranslationRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+// Save implements ShowTranslationRepository.
+func (r *showTranslationRepository) Save(ctx context.Context, t ShowTranslation) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_show_translation
+		(
+			event_id, show_id, locale, venue
+		)
+		VALUES
+		(
+			$1, $2, $3, $4
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, t.EventID, t.ShowID, t.Locale, t.Venue)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show translation's prorperties")
+	}
+
+	return nil
+}
+
+func NewShowTranslationRepository(logger *logrus.Logger, db *pgxpool.Pool) ShowTranslationRepository {
+	return &showTranslationRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// SaveMany implements ShowTranslationRepository. The translations are inserted by one statement.
+func (r *showTranslationRepository) SaveMany(ctx context.Context, translations []ShowTranslation) error {
+	if len(translations) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_show_translation
+		(
+			event_id, show_id, locale, venue
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::varchar[])
+	`
+
+	eventIDs := make([]string, len(translations))
+	showIDs := make([]string, len(translations))
+	locales := make([]string, len(translations))
+	venues := make([]string, len(translations))
+	for k, t := range translations {
+		eventIDs[k] = t.EventID
+		showIDs[k] = t.ShowID
+		locales[k] = t.Locale
+		venues[k] = t.Venue
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, showIDs, locales, venues)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event show translation's prorperties")
+	}
+
+	return nil
+}
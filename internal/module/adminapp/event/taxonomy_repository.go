@@ -0,0 +1,142 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type TaxonomyRepository interface {
+	FindManyByEventID(ctx context.Context, eventID string) ([]Taxonomy, error)
+	Save(ctx context.Context, t Taxonomy) error
+	SaveMany(ctx context.Context, taxonomies []Taxonomy) error
+	DeleteByEventID(ctx context.Context, eventID string) error
+}
+
+type taxonomyRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewTaxonomyRepository(logger *logrus.Logger, db *pgxpool.Pool) TaxonomyRepository {
+	return &taxonomyRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindManyByEventID implements TaxonomyRepository.
+func (r *taxonomyRepository) FindManyByEventID(ctx context.Context, eventID string) ([]Taxonomy, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			et.event_id, t.id, t.type, t.slug, t.name
+		FROM event_taxonomy et
+		JOIN taxonomy t ON t.id = et.taxonomy_id
+		WHERE
+			et.event_id = $1
+		ORDER BY t.type ASC, t.name ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, eventID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event taxonomy's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Taxonomy, 0)
+	for rows.Next() {
+		var t Taxonomy
+
+		err := rows.Scan(&t.EventID, &t.TaxonomyID, &t.Type, &t.Slug, &t.Name)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event taxonomy's prorperties")
+		}
+
+		data = append(data, t)
+	}
+
+	return data, nil
+}
+
+// Save implements TaxonomyRepository.
+func (r *taxonomyRepository) Save(ctx context.Context, t Taxonomy) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_taxonomy
+		(
+			event_id, taxonomy_id
+		)
+		VALUES
+		(
+			$1, $2
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, t.EventID, t.TaxonomyID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event taxonomy's prorperties")
+	}
+
+	return nil
+}
+
+// DeleteByEventID implements TaxonomyRepository.
+func (r *taxonomyRepository) DeleteByEventID(ctx context.Context, eventID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM event_taxonomy WHERE event_id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, eventID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting event taxonomy's prorperties")
+	}
+
+	return nil
+}
+
+// SaveMany implements TaxonomyRepository. The taxonomies of the events are inserted by one statement.
+func (r *taxonomyRepository) SaveMany(ctx context.Context, taxonomies []Taxonomy) error {
+	if len(taxonomies) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO event_taxonomy
+		(
+			event_id, taxonomy_id
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[])
+	`
+
+	eventIDs := make([]string, len(taxonomies))
+	taxonomyIDs := make([]string, len(taxonomies))
+	for k, t := range taxonomies {
+		eventIDs[k] = t.EventID
+		taxonomyIDs[k] = t.TaxonomyID
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, taxonomyIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of event taxonomy's prorperties")
+	}
+
+	return nil
+}
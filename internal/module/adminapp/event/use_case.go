@@ -2,44 +2,84 @@ package event
 
 import (
 	"context"
-	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
 	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/promotor"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/taxonomy"
 	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type EventUseCase interface {
 	CreateEvent(ctx context.Context, req CreateEventRequest) (interface{}, error)
+	UpdateEventTaxonomy(ctx context.Context, req UpdateEventTaxonomyRequest) (EventTaxonomyResponse, error)
+	ImportEvents(ctx context.Context, req ImportEventsRequest) (ImportEventsResponse, error)
+}
+
+// FollowerNotifier alerts the customers who follow an event or its artists.
+type FollowerNotifier interface {
+	NotifyShowsAdded(ctx context.Context, eventID string, showIDs []string) error
 }
 
 type eventUseCase struct {
 	logger                       *logrus.Logger
 	location                     *time.Location
 	timeout                      time.Duration
+	validate                     *validator.Validate
 	eventRepository              EventRepository
 	artistRepository             ArtistRepository
 	promotorRepository           PromotorRepository
 	showRepository               ShowRepository
 	locationRepository           LocationRepository
+	eventTranslationRepository   EventTranslationRepository
+	showTranslationRepository    ShowTranslationRepository
+	taxonomyRepository           TaxonomyRepository
 	orderRuleDayRepository       order.OrderRuleDayRepository
 	orderRuleRangeDateRepository order.OrderRuleRangeDateRepository
 	ticketStockRepository        ticket.TicketStockRepository
+	venueRepository              venue.VenueRepository
+	artistRegistryRepository     artist.ArtistRepository
+	promotorRegistryRepository   promotor.PromotorRepository
+	taxonomyRegistryRepository   taxonomy.TaxonomyRepository
+	followerNotifier             FollowerNotifier
+	txManager                    postgresql.TxManager
 }
 
 type EventUseCaseProperty struct {
 	Logger                       *logrus.Logger
 	Location                     *time.Location
 	Timeout                      time.Duration
+	Validate                     *validator.Validate
 	EventRepository              EventRepository
 	ArtistRepository             ArtistRepository
 	PromotorRepository           PromotorRepository
 	ShowRepository               ShowRepository
 	LocationRepository           LocationRepository
+	EventTranslationRepository   EventTranslationRepository
+	ShowTranslationRepository    ShowTranslationRepository
+	TaxonomyRepository           TaxonomyRepository
 	OrderRuleDayRepository       order.OrderRuleDayRepository
 	OrderRuleRangeDateRepository order.OrderRuleRangeDateRepository
 	TicketStockRepository        ticket.TicketStockRepository
+	VenueRepository              venue.VenueRepository
+	ArtistRegistryRepository     artist.ArtistRepository
+	PromotorRegistryRepository   promotor.PromotorRepository
+	TaxonomyRegistryRepository   taxonomy.TaxonomyRepository
+	FollowerNotifier             FollowerNotifier
+	TxManager                    postgresql.TxManager
 }
 
 func NewEventUseCase(props EventUseCaseProperty) EventUseCase {
@@ -47,69 +87,401 @@ func NewEventUseCase(props EventUseCaseProperty) EventUseCase {
 		logger:                       props.Logger,
 		location:                     props.Location,
 		timeout:                      props.Timeout,
+		validate:                     props.Validate,
 		eventRepository:              props.EventRepository,
 		artistRepository:             props.ArtistRepository,
 		promotorRepository:           props.PromotorRepository,
 		showRepository:               props.ShowRepository,
 		locationRepository:           props.LocationRepository,
+		eventTranslationRepository:   props.EventTranslationRepository,
+		showTranslationRepository:    props.ShowTranslationRepository,
+		taxonomyRepository:           props.TaxonomyRepository,
 		orderRuleDayRepository:       props.OrderRuleDayRepository,
 		orderRuleRangeDateRepository: props.OrderRuleRangeDateRepository,
 		ticketStockRepository:        props.TicketStockRepository,
+		venueRepository:              props.VenueRepository,
+		artistRegistryRepository:     props.ArtistRegistryRepository,
+		promotorRegistryRepository:   props.PromotorRegistryRepository,
+		taxonomyRegistryRepository:   props.TaxonomyRegistryRepository,
+		followerNotifier:             props.FollowerNotifier,
+		txManager:                    props.TxManager,
+	}
+}
+
+// resolveArtist returns the registered artist of the event's artist. An artist without id is matched by the slug
+// of its name and is registered when there is no such artist yet.
+func (u *eventUseCase) resolveArtist(ctx context.Context, a Artist, now time.Time) (artist.Artist, error) {
+	if a.ArtistID != "" {
+		return u.artistRegistryRepository.FindByID(ctx, a.ArtistID)
+	}
+
+	slug := util.Slugify(a.Name)
+	if slug == "" {
+		return artist.Artist{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidName, i18n.Noun("artist"), a.Name))
 	}
+
+	existing, err := u.artistRegistryRepository.FindBySlug(ctx, slug)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.MatchStatus(err, status.NOT_FOUND) {
+		return artist.Artist{}, err
+	}
+
+	registered := artist.NewArtist(util.GenerateTimestampWithPrefix("ARTIST"), a.Name, "", "", now)
+	if err := u.artistRegistryRepository.Save(ctx, registered); err != nil {
+		return artist.Artist{}, err
+	}
+
+	return registered, nil
 }
 
-func (u *eventUseCase) createArtists(ctx context.Context, e Event, tx *sql.Tx) error {
+// resolvePromotor returns the registered promotor of the event's promotor. A promotor without id is matched by the
+// slug of its name and is registered when there is no such promotor yet.
+func (u *eventUseCase) resolvePromotor(ctx context.Context, p Promotor, now time.Time) (promotor.Promotor, error) {
+	if p.PromotorID != "" {
+		return u.promotorRegistryRepository.FindByID(ctx, p.PromotorID)
+	}
+
+	slug := util.Slugify(p.Name)
+	if slug == "" {
+		return promotor.Promotor{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidName, i18n.Noun("promotor"), p.Name))
+	}
+
+	existing, err := u.promotorRegistryRepository.FindBySlug(ctx, slug)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.MatchStatus(err, status.NOT_FOUND) {
+		return promotor.Promotor{}, err
+	}
+
+	registered := promotor.NewPromotor(util.GenerateTimestampWithPrefix("PROMOTOR"), p.Name, p.Email, p.Phone, "", "", now)
+	if err := u.promotorRegistryRepository.Save(ctx, registered); err != nil {
+		return promotor.Promotor{}, err
+	}
+
+	return registered, nil
+}
+
+// resolveArtists replaces the artists of the event by the registered ones, an artist which is given twice is linked
+// once.
+func (u *eventUseCase) resolveArtists(ctx context.Context, e *Event) error {
+	artists := make([]Artist, 0, len(e.Artists))
+	linked := make(map[string]bool)
 	for _, a := range e.Artists {
-		if err := u.artistRepository.Save(ctx, a, tx); err != nil {
+		registered, err := u.resolveArtist(ctx, a, e.CreatedAt)
+		if err != nil {
 			return err
 		}
+
+		if linked[registered.ID] {
+			continue
+		}
+		linked[registered.ID] = true
+
+		a.ArtistID = registered.ID
+		a.Slug = registered.Slug
+		a.Name = registered.Name
+		artists = append(artists, a)
 	}
+	e.Artists = artists
 
 	return nil
 }
 
-func (u *eventUseCase) createPromotors(ctx context.Context, e Event, tx *sql.Tx) error {
+// resolvePromotors replaces the promotors of the event by the registered ones, a promotor which is given twice is
+// linked once.
+func (u *eventUseCase) resolvePromotors(ctx context.Context, e *Event) error {
+	promotors := make([]Promotor, 0, len(e.Promotors))
+	linked := make(map[string]bool)
 	for _, p := range e.Promotors {
-		if err := u.promotorRepository.Save(ctx, p, tx); err != nil {
+		registered, err := u.resolvePromotor(ctx, p, e.CreatedAt)
+		if err != nil {
 			return err
 		}
+
+		if linked[registered.ID] {
+			continue
+		}
+		linked[registered.ID] = true
+
+		p.PromotorID = registered.ID
+		p.Slug = registered.Slug
+		p.Name = registered.Name
+		p.Email = registered.Email
+		p.Phone = registered.Phone
+		promotors = append(promotors, p)
 	}
+	e.Promotors = promotors
 
 	return nil
 }
 
-func (u *eventUseCase) createShows(ctx context.Context, e Event, tx *sql.Tx) error {
-	for _, s := range e.Shows {
-		if err := u.showRepository.Save(ctx, s, tx); err != nil {
-			return err
-		}
+type taxonomyRef struct {
+	field        string
+	taxonomyType string
+	ref          string
+}
 
-		if err := u.locationRepository.Save(ctx, *s.Location, tx); err != nil {
-			return err
+// taxonomyRefs lists the references to the category, the genres and the tags along with the field of the request which
+// holds them.
+func taxonomyRefs(category string, genres, tags []string) []taxonomyRef {
+	refs := make([]taxonomyRef, 0, 1+len(genres)+len(tags))
+	if category != "" {
+		refs = append(refs, taxonomyRef{field: "category", taxonomyType: taxonomy.TypeCategory, ref: category})
+	}
+	for k, v := range genres {
+		refs = append(refs, taxonomyRef{field: fmt.Sprintf("genres[%d]", k), taxonomyType: taxonomy.TypeGenre, ref: v})
+	}
+	for k, v := range tags {
+		refs = append(refs, taxonomyRef{field: fmt.Sprintf("tags[%d]", k), taxonomyType: taxonomy.TypeTag, ref: v})
+	}
+
+	return refs
+}
+
+// resolveTaxonomy returns the registered taxonomy which is referred either by its id or by its slug. A tag which is
+// not registered yet is registered by the name of the reference, an unknown category or genre is not found.
+func (u *eventUseCase) resolveTaxonomy(ctx context.Context, ref taxonomyRef, now time.Time) (taxonomy.Taxonomy, error) {
+	existing, err := u.taxonomyRegistryRepository.FindByID(ctx, ref.taxonomyType, ref.ref)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.MatchStatus(err, status.NOT_FOUND) {
+		return taxonomy.Taxonomy{}, err
+	}
+
+	existing, err = u.taxonomyRegistryRepository.FindBySlug(ctx, ref.taxonomyType, util.Slugify(ref.ref))
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.MatchStatus(err, status.NOT_FOUND) || ref.taxonomyType != taxonomy.TypeTag {
+		return taxonomy.Taxonomy{}, err
+	}
+
+	registered := taxonomy.NewTaxonomy(taxonomy.TypeTag, ref.ref, now)
+	if err := u.taxonomyRegistryRepository.Save(ctx, registered); err != nil {
+		return taxonomy.Taxonomy{}, err
+	}
+
+	return registered, nil
+}
+
+// resolveTaxonomies returns the links of the event to its category, genres and tags. Every reference which can not be
+// resolved is reported as a field error rather than stopping at the first.
+func (u *eventUseCase) resolveTaxonomies(ctx context.Context, eventID string, refs []taxonomyRef, now time.Time) ([]Taxonomy, error) {
+	v := &createEventValidation{ctx: ctx}
+
+	taxonomies := make([]Taxonomy, 0, len(refs))
+	linked := make(map[string]bool)
+	for _, ref := range refs {
+		noun := i18n.Noun(taxonomy.Noun(ref.taxonomyType))
+		if util.Slugify(ref.ref) == "" {
+			v.invalid(ref.field, "slug", i18n.InvalidName, noun, ref.ref)
+			continue
 		}
 
-		for _, ts := range s.TicketStock {
-			if err := u.ticketStockRepository.Save(ctx, ts, tx); err != nil {
-				return err
+		registered, err := u.resolveTaxonomy(ctx, ref, now)
+		if err != nil {
+			if errors.MatchStatus(err, status.NOT_FOUND) {
+				v.invalid(ref.field, "exists", i18n.NotFoundByName, noun, ref.ref)
+				continue
 			}
+			return nil, err
+		}
+
+		if linked[registered.ID] {
+			continue
 		}
+		linked[registered.ID] = true
+
+		taxonomies = append(taxonomies, Taxonomy{
+			EventID:    eventID,
+			TaxonomyID: registered.ID,
+			Type:       registered.Type,
+			Slug:       registered.Slug,
+			Name:       registered.Name,
+		})
 	}
 
-	return nil
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return taxonomies, nil
+}
+
+// attachTaxonomies links the event to its category, genres and tags.
+func (u *eventUseCase) attachTaxonomies(ctx context.Context, eventID string, refs []taxonomyRef, now time.Time) ([]Taxonomy, error) {
+	taxonomies, err := u.resolveTaxonomies(ctx, eventID, refs, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.taxonomyRepository.SaveMany(ctx, taxonomies); err != nil {
+		return nil, err
+	}
+
+	return taxonomies, nil
+}
+
+// batchError reports the integrity constraint violation of the batched writes of the event. The batch is sent after
+// the repositories returned so they can not tell it apart themselves, it is told apart here by its constraint instead.
+func batchError(ctx context.Context, e Event, err error) error {
+	if _, ok := err.(*errors.AppError); err == nil || ok {
+		return err
+	}
+
+	code, constraint, ok := postgresql.ConstraintViolation(err)
+	switch {
+	case ok && constraint == "event_pkey":
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.AlreadyExistsByID, i18n.Noun("event"), e.ID))
+	case ok && constraint == "event_show_pkey":
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.SomeAlreadyExist, i18n.Noun("event shows")))
+	case ok && constraint == "event_show_location_pkey":
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.SomeAlreadyExist, i18n.Noun("event show locations")))
+	case ok && constraint == "ticket_stock_pkey":
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.SomeAlreadyExist, i18n.Noun("ticket stocks")))
+	case ok && constraint == "event_show_venue_id_fkey":
+		return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.SomeNotFound, i18n.Noun("venues"), i18n.Noun("event shows")))
+	case ok && code == postgresql.CheckViolation && strings.HasPrefix(constraint, "ticket_stock_"):
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.AllocationsTooLow))
+	case ok && code == postgresql.UniqueViolation:
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.UniqueViolation, i18n.Noun("event"), constraint))
+	case ok && code == postgresql.ForeignKeyViolation:
+		return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.ForeignKeyViolation, i18n.Noun("event"), constraint))
+	case ok:
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.CheckViolation, i18n.Noun("event"), constraint))
+	}
+
+	return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event's prorperties")
 }
 
-func (u *eventUseCase) createRules(ctx context.Context, e Event, tx *sql.Tx) error {
-	if err := u.orderRuleRangeDateRepository.Save(ctx, e.OrderRules.OrderRuleRangeDate, tx); err != nil {
+func (u *eventUseCase) createShows(ctx context.Context, e Event) error {
+	locations := make([]Location, 0, len(e.Shows))
+	stocks := make([]ticket.TicketStock, 0, len(e.Shows))
+	translations := make([]ShowTranslation, 0, len(e.Shows))
+	for _, s := range e.Shows {
+		if s.Location != nil {
+			locations = append(locations, *s.Location)
+		}
+		stocks = append(stocks, s.TicketStock...)
+		translations = append(translations, s.Translations...)
+	}
+
+	if err := u.showRepository.SaveMany(ctx, e.Shows); err != nil {
+		return err
+	}
+
+	if err := u.locationRepository.SaveMany(ctx, locations); err != nil {
+		return err
+	}
+
+	if err := u.ticketStockRepository.SaveMany(ctx, stocks); err != nil {
+		return err
+	}
+
+	return u.showTranslationRepository.SaveMany(ctx, translations)
+}
+
+func (u *eventUseCase) createRules(ctx context.Context, e Event) error {
+	if err := u.orderRuleRangeDateRepository.Save(ctx, e.OrderRules.OrderRuleRangeDate); err != nil {
+		return err
+	}
+
+	return u.orderRuleDayRepository.SaveMany(ctx, e.OrderRules.OrderRuleDay)
+}
+
+// createEvent writes the event along with its links, its shows and its rules by one statement per table.
+func (u *eventUseCase) createEvent(ctx context.Context, e Event) error {
+	if err := u.eventRepository.Save(ctx, e); err != nil {
+		return err
+	}
+
+	if err := u.eventTranslationRepository.SaveMany(ctx, e.Translations); err != nil {
+		return err
+	}
+
+	if err := u.artistRepository.SaveMany(ctx, e.Artists); err != nil {
+		return err
+	}
+
+	if err := u.promotorRepository.SaveMany(ctx, e.Promotors); err != nil {
+		return err
+	}
+
+	if err := u.taxonomyRepository.SaveMany(ctx, e.Taxonomies); err != nil {
 		return err
 	}
 
-	for _, v := range e.OrderRules.OrderRuleDay {
-		if err := u.orderRuleDayRepository.Save(ctx, v, tx); err != nil {
+	if err := u.createShows(ctx, e); err != nil {
+		return err
+	}
+
+	return u.createRules(ctx, e)
+}
+
+// create creates the event of the request, its followers are alerted by the caller once the event is committed.
+func (u *eventUseCase) create(ctx context.Context, req CreateEventRequest, now time.Time) (Event, error) {
+	venues := make(map[string]venue.Venue)
+	for _, venueID := range req.VenueIDs() {
+		v, err := u.venueRepository.FindByID(ctx, venueID)
+		if err != nil {
+			// an unknown venue is reported along with the other field errors of the request
+			if errors.MatchStatus(err, status.NOT_FOUND) {
+				continue
+			}
+			return Event{}, err
+		}
+		venues[v.ID] = v
+	}
+
+	e, err := req.ToEntityEvent(ctx, u.location, now, venues)
+	if err != nil {
+		return Event{}, err
+	}
+
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.resolveArtists(ctx, &e); err != nil {
+			return err
+		}
+
+		if err := u.resolvePromotors(ctx, &e); err != nil {
+			return err
+		}
+
+		taxonomies, err := u.resolveTaxonomies(ctx, e.ID, taxonomyRefs(req.Category, req.Genres, req.Tags), now)
+		if err != nil {
 			return err
 		}
+		e.Taxonomies = taxonomies
+
+		// the writes are sent in one round trip once everything the event refers to is resolved
+		err = u.txManager.WithinBatch(ctx, func(ctx context.Context) error {
+			return u.createEvent(ctx, e)
+		})
+
+		return batchError(ctx, e, err)
+	})
+	if err != nil {
+		return Event{}, err
 	}
 
-	return nil
+	return e, nil
+}
+
+// notifyShowsAdded alerts the followers of the artists of the event of its new shows, the event is created regardless
+// of the notification.
+func (u *eventUseCase) notifyShowsAdded(ctx context.Context, e Event) {
+	showIDs := make([]string, len(e.Shows))
+	for k, v := range e.Shows {
+		showIDs[k] = v.ID
+	}
+
+	if err := u.followerNotifier.NotifyShowsAdded(ctx, e.ID, showIDs); err != nil {
+		u.logger.WithContext(ctx).WithError(err).Error()
+	}
 }
 
 // CreateEvent implements EventUseCase.
@@ -117,50 +489,195 @@ func (u *eventUseCase) CreateEvent(ctx context.Context, req CreateEventRequest)
 	ctx, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	now := time.Now()
-	e, err := req.ToEntityEvent(u.location, now)
+	e, err := u.create(ctx, req, time.Now())
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := u.eventRepository.BeginTx(ctx)
-	if err != nil {
-		tx.Rollback()
-		return nil, err
+	u.notifyShowsAdded(ctx, e)
+
+	resp := CreateEventResponse{}
+	resp.PopulateFromEntity(e)
+
+	return resp, nil
+}
+
+// errEventImportRolledBack rolls back the transaction of the events which are not to be committed, e.g. the ones of a
+// dry run.
+var errEventImportRolledBack = errors.New(http.StatusConflict, status.CONFLICT, "event import is rolled back")
+
+type importedEventResult struct {
+	event  Event
+	errors []EventImportError
+}
+
+// importEvent creates an imported event. The violations of the event are returned as the errors of the rows they are
+// read from, any other error aborts the import.
+func (u *eventUseCase) importEvent(ctx context.Context, ie ImportedEvent, now time.Time) (importedEventResult, error) {
+	if len(ie.Errors) > 0 {
+		return importedEventResult{errors: ie.Errors}, nil
 	}
 
-	if err := u.eventRepository.Save(ctx, e, tx); err != nil {
-		tx.Rollback()
-		return nil, err
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err := validation.Struct(ctx, u.validate, ie.Request)
+	if err == nil {
+		var e Event
+		if e, err = u.create(ctx, ie.Request, now); err == nil {
+			return importedEventResult{event: e}, nil
+		}
 	}
 
-	if err := u.createArtists(ctx, e, tx); err != nil {
-		tx.Rollback()
-		return nil, err
+	ae := errors.Destruct(err)
+	if ae.HTTPStatusCode >= http.StatusInternalServerError {
+		return importedEventResult{}, err
 	}
 
-	if err := u.createPromotors(ctx, e, tx); err != nil {
-		tx.Rollback()
-		return nil, err
+	if len(ae.Errors) < 1 {
+		return importedEventResult{errors: []EventImportError{{Row: ie.Row, Ref: ie.Ref, Rule: ae.Status, Message: ae.Message}}}, nil
 	}
 
-	if err := u.createShows(ctx, e, tx); err != nil {
-		tx.Rollback()
-		return nil, err
+	result := importedEventResult{errors: make([]EventImportError, len(ae.Errors))}
+	for k, v := range ae.Errors {
+		result.errors[k] = EventImportError{Row: ie.RowOf(v.Field), Ref: ie.Ref, Field: v.Field, Rule: v.Rule, Message: v.Message}
 	}
 
-	if err := u.createRules(ctx, e, tx); err != nil {
-		tx.Rollback()
-		return nil, err
+	return result, nil
+}
+
+// ImportEvents implements EventUseCase. The timeout applies to every event rather than to the whole import. The events
+// of an all or nothing import are created in one transaction which is committed only when every one of them is valid,
+// the ones of a per event import are committed on their own.
+func (u *eventUseCase) ImportEvents(ctx context.Context, req ImportEventsRequest) (ImportEventsResponse, error) {
+	imported, err := ParseEventImport(ctx, req.Format, req.Content)
+	if err != nil {
+		return ImportEventsResponse{}, err
+	}
+	if len(imported) < 1 {
+		return ImportEventsResponse{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.EmptyImport))
 	}
 
-	if err := tx.Commit(); err != nil {
-		tx.Rollback()
-		return nil, err
+	now := time.Now()
+	results := make([]importedEventResult, len(imported))
+	if req.Mode == EventImportModePerEvent {
+		for k, ie := range imported {
+			err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+				result, err := u.importEvent(ctx, ie, now)
+				if err != nil {
+					return err
+				}
+				results[k] = result
+
+				if len(result.errors) > 0 || req.DryRun {
+					return errEventImportRolledBack
+				}
+
+				return nil
+			})
+			if err != nil && err != errEventImportRolledBack {
+				return ImportEventsResponse{}, err
+			}
+		}
+	} else {
+		// every event is created in a savepoint so that an invalid one does not abort the transaction of the others
+		err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			failed := false
+			for k, ie := range imported {
+				result, err := u.importEvent(ctx, ie, now)
+				if err != nil {
+					return err
+				}
+				results[k] = result
+				failed = failed || len(result.errors) > 0
+			}
+
+			if failed || req.DryRun {
+				return errEventImportRolledBack
+			}
+
+			return nil
+		})
+		if err != nil && err != errEventImportRolledBack {
+			return ImportEventsResponse{}, err
+		}
 	}
 
-	resp := CreateEventResponse{}
-	resp.PopulateFromEntity(e)
+	resp := ImportEventsResponse{
+		DryRun: req.DryRun,
+		Mode:   req.Mode,
+		Total:  len(imported),
+		Events: make([]ImportedEventResponse, len(imported)),
+		Errors: make([]EventImportErrorResponse, 0),
+	}
+	for _, v := range results {
+		if len(v.errors) > 0 {
+			resp.Failed++
+		}
+	}
+
+	created := make([]Event, 0, len(imported))
+	for k, v := range results {
+		item := ImportedEventResponse{Ref: imported[k].Ref, Row: imported[k].Row}
+		switch {
+		case len(v.errors) > 0:
+			item.Status = EventImportStatusFailed
+			for _, e := range v.errors {
+				resp.Errors = append(resp.Errors, EventImportErrorResponse{
+					Row:     e.Row,
+					Ref:     e.Ref,
+					Field:   e.Field,
+					Rule:    e.Rule,
+					Message: e.Message,
+				})
+			}
+		case req.DryRun:
+			item.Status = EventImportStatusValid
+		case req.Mode == EventImportModeAllOrNothing && resp.Failed > 0:
+			item.Status = EventImportStatusRolledBack
+		default:
+			item.Status = EventImportStatusCreated
+			item.EventID = v.event.ID
+			created = append(created, v.event)
+		}
+		resp.Events[k] = item
+	}
+	resp.Created = len(created)
+
+	for _, e := range created {
+		u.notifyShowsAdded(ctx, e)
+	}
+
+	return resp, nil
+}
+
+// UpdateEventTaxonomy implements EventUseCase. The category, the genres and the tags of the request replace the ones
+// of the event.
+func (u *eventUseCase) UpdateEventTaxonomy(ctx context.Context, req UpdateEventTaxonomyRequest) (EventTaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var taxonomies []Taxonomy
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		e, err := u.eventRepository.FindByID(ctx, req.EventID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.taxonomyRepository.DeleteByEventID(ctx, e.ID); err != nil {
+			return err
+		}
+
+		taxonomies, err = u.attachTaxonomies(ctx, e.ID, taxonomyRefs(req.Category, req.Genres, req.Tags), time.Now())
+
+		return err
+	})
+	if err != nil {
+		return EventTaxonomyResponse{}, err
+	}
+
+	resp := EventTaxonomyResponse{}
+	resp.PopulateFromEntity(taxonomies)
 
 	return resp, nil
 }
@@ -0,0 +1,547 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/promotor"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/validator"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the import is not expected to call panics. The
+// writes are recorded by the name of the table they are made to.
+
+type writes map[string]int
+
+type eventStore struct {
+	event.EventRepository
+	w writes
+}
+
+func (s eventStore) Save(ctx context.Context, e event.Event) error {
+	s.w["event"]++
+	return nil
+}
+
+type eventTranslationStore struct {
+	event.EventTranslationRepository
+	w writes
+}
+
+func (s eventTranslationStore) SaveMany(ctx context.Context, translations []event.EventTranslation) error {
+	s.w["event_translation"] += len(translations)
+	return nil
+}
+
+type artistStore struct {
+	event.ArtistRepository
+	w writes
+}
+
+func (s artistStore) SaveMany(ctx context.Context, artists []event.Artist) error {
+	s.w["event_artist"] += len(artists)
+	return nil
+}
+
+type promotorStore struct {
+	event.PromotorRepository
+	w writes
+}
+
+func (s promotorStore) SaveMany(ctx context.Context, promotors []event.Promotor) error {
+	s.w["event_promotor"] += len(promotors)
+	return nil
+}
+
+type taxonomyStore struct {
+	event.TaxonomyRepository
+	w writes
+}
+
+func (s taxonomyStore) SaveMany(ctx context.Context, taxonomies []event.Taxonomy) error {
+	s.w["event_taxonomy"] += len(taxonomies)
+	return nil
+}
+
+type showStore struct {
+	event.ShowRepository
+	w writes
+}
+
+func (s showStore) SaveMany(ctx context.Context, shows []event.Show) error {
+	s.w["event_show"] += len(shows)
+	return nil
+}
+
+type locationStore struct {
+	event.LocationRepository
+	w writes
+}
+
+func (s locationStore) SaveMany(ctx context.Context, locations []event.Location) error {
+	s.w["event_show_location"] += len(locations)
+	return nil
+}
+
+type showTranslationStore struct {
+	event.ShowTranslationRepository
+	w writes
+}
+
+func (s showTranslationStore) SaveMany(ctx context.Context, translations []event.ShowTranslation) error {
+	s.w["event_show_translation"] += len(translations)
+	return nil
+}
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	w writes
+}
+
+func (s ticketStockStore) SaveMany(ctx context.Context, stocks []ticket.TicketStock) error {
+	s.w["ticket_stock"] += len(stocks)
+	return nil
+}
+
+type orderRuleDayStore struct {
+	order.OrderRuleDayRepository
+	w writes
+}
+
+func (s orderRuleDayStore) SaveMany(ctx context.Context, rules []order.OrderRuleDay) error {
+	s.w["order_rule_day"] += len(rules)
+	return nil
+}
+
+type orderRuleRangeDateStore struct {
+	order.OrderRuleRangeDateRepository
+	w writes
+}
+
+func (s orderRuleRangeDateStore) Save(ctx context.Context, rule order.OrderRuleRangeDate) error {
+	s.w["order_rule_range_date"]++
+	return nil
+}
+
+// artistRegistry keeps the registered artists by their id.
+type artistRegistry struct {
+	artist.ArtistRepository
+	w       writes
+	artists map[string]artist.Artist
+}
+
+func (s artistRegistry) FindByID(ctx context.Context, ID string) (artist.Artist, error) {
+	a, ok := s.artists[ID]
+	if !ok {
+		return artist.Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return a, nil
+}
+
+func (s artistRegistry) FindBySlug(ctx context.Context, slug string) (artist.Artist, error) {
+	for _, a := range s.artists {
+		if a.Slug == slug {
+			return a, nil
+		}
+	}
+
+	return artist.Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+func (s artistRegistry) Save(ctx context.Context, a artist.Artist) error {
+	s.w["artist"]++
+	s.artists[a.ID] = a
+	return nil
+}
+
+// promotorRegistry keeps the registered promotors by their id.
+type promotorRegistry struct {
+	promotor.PromotorRepository
+	w         writes
+	promotors map[string]promotor.Promotor
+}
+
+func (s promotorRegistry) FindByID(ctx context.Context, ID string) (promotor.Promotor, error) {
+	p, ok := s.promotors[ID]
+	if !ok {
+		return promotor.Promotor{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return p, nil
+}
+
+func (s promotorRegistry) FindBySlug(ctx context.Context, slug string) (promotor.Promotor, error) {
+	for _, p := range s.promotors {
+		if p.Slug == slug {
+			return p, nil
+		}
+	}
+
+	return promotor.Promotor{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+func (s promotorRegistry) Save(ctx context.Context, p promotor.Promotor) error {
+	s.w["promotor"]++
+	s.promotors[p.ID] = p
+	return nil
+}
+
+type followerNotifier struct {
+	notified []string
+}
+
+func (n *followerNotifier) NotifyShowsAdded(ctx context.Context, eventID string, showIDs []string) error {
+	n.notified = append(n.notified, eventID)
+	return nil
+}
+
+// txManager runs fn right away and counts the outermost transactions which would be committed and the ones which would
+// be rolled back, a nested one is a savepoint and a batch is sent along with its transaction. A batch fails with
+// batchErr once its writes are queued, the way a batch whose writes violate a constraint fails when it is sent.
+type txManager struct {
+	postgresql.TxManager
+
+	depth      int
+	committed  int
+	rolledBack int
+	batches    int
+	batchErr   error
+}
+
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.depth++
+	err := fn(ctx)
+	m.depth--
+	if m.depth > 0 {
+		return err
+	}
+
+	if err != nil {
+		m.rolledBack++
+		return err
+	}
+
+	m.committed++
+	return nil
+}
+
+func (m *txManager) WithinBatch(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.batches++
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return m.batchErr
+}
+
+func TestEventUseCaseImportEvents(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	newUseCase := func(w writes, tm *txManager, n *followerNotifier) event.EventUseCase {
+		return event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:                       logger,
+			Location:                     time.UTC,
+			Timeout:                      10 * time.Second,
+			Validate:                     validator.Get(),
+			EventRepository:              eventStore{w: w},
+			ArtistRepository:             artistStore{w: w},
+			PromotorRepository:           promotorStore{w: w},
+			ShowRepository:               showStore{w: w},
+			LocationRepository:           locationStore{w: w},
+			EventTranslationRepository:   eventTranslationStore{w: w},
+			ShowTranslationRepository:    showTranslationStore{w: w},
+			TaxonomyRepository:           taxonomyStore{w: w},
+			OrderRuleDayRepository:       orderRuleDayStore{w: w},
+			OrderRuleRangeDateRepository: orderRuleRangeDateStore{w: w},
+			TicketStockRepository:        ticketStockStore{w: w},
+			ArtistRegistryRepository:     artistRegistry{w: w, artists: make(map[string]artist.Artist)},
+			PromotorRegistryRepository:   promotorRegistry{w: w, promotors: make(map[string]promotor.Promotor)},
+			FollowerNotifier:             n,
+			TxManager:                    tm,
+		})
+	}
+
+	valid := `{"name":"Concert","description":"A concert","artists":["Artist A"],` +
+		`"promotors":[{"name":"Promotor","email":"promotor@mail.com","phone":"0812"}],` +
+		`"currency":"IDR","online_ticket_price":{"amount":"50000","currency":"IDR"},"total_online_ticket_allocation":10,` +
+		`"shows":[{"venue":"Hall","type":"LIVE","total_ticket_allocation":100,` +
+		`"location":{"country":"Indonesia","city":"Jakarta","formatted_address":"Jl. Sudirman 1, Jakarta","latitude":-6.2,"longitude":106.8},` +
+		`"ticket_allocation":[{"tier":"GOLD","allocation_by_percentage":40,"price":{"amount":"500000","currency":"IDR"}},` +
+		`{"tier":"SILVER","allocation_by_percentage":60,"price":{"amount":"250000","currency":"IDR"}}]}],` +
+		`"show_time":"2030-01-01 19:00:00","order_rule_range_date":{"start_date":"2029-12-01 00:00:00","end_date":"2029-12-31 00:00:00"}}`
+	invalid := `{"name":"Festival"}`
+
+	for _, mode := range []string{event.EventImportModeAllOrNothing, event.EventImportModePerEvent} {
+		t.Run("a dry run of "+mode+" reports the valid events and commits nothing", func(t *testing.T) {
+			w := writes{}
+			tm := &txManager{}
+			n := &followerNotifier{}
+			uc := newUseCase(w, tm, n)
+
+			resp, err := uc.ImportEvents(context.Background(), event.ImportEventsRequest{
+				Format:  event.EventImportFormatJSONL,
+				Mode:    mode,
+				DryRun:  true,
+				Content: []byte(strings.Join([]string{valid, invalid}, "\n")),
+			})
+			require.NoError(t, err)
+
+			assert.True(t, resp.DryRun)
+			assert.Equal(t, 2, resp.Total)
+			assert.Equal(t, 0, resp.Created)
+			assert.Equal(t, 1, resp.Failed)
+			require.Len(t, resp.Events, 2)
+			assert.Equal(t, event.EventImportStatusValid, resp.Events[0].Status)
+			assert.Empty(t, resp.Events[0].EventID)
+			assert.Equal(t, event.EventImportStatusFailed, resp.Events[1].Status)
+			assert.NotEmpty(t, resp.Errors)
+			for _, e := range resp.Errors {
+				assert.Equal(t, 2, e.Row)
+			}
+
+			// the valid event is written within the transaction which is rolled back
+			assert.Equal(t, 1, w["event"])
+			assert.Equal(t, 1, w["event_show"])
+			assert.Equal(t, 2, w["ticket_stock"])
+			assert.Equal(t, 0, tm.committed)
+			assert.NotZero(t, tm.rolledBack)
+			assert.Empty(t, n.notified)
+		})
+	}
+
+	t.Run("an import which is not a dry run commits and notifies the valid events", func(t *testing.T) {
+		w := writes{}
+		tm := &txManager{}
+		n := &followerNotifier{}
+		uc := newUseCase(w, tm, n)
+
+		resp, err := uc.ImportEvents(context.Background(), event.ImportEventsRequest{
+			Format:  event.EventImportFormatJSONL,
+			Mode:    event.EventImportModePerEvent,
+			Content: []byte(strings.Join([]string{valid, invalid}, "\n")),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, resp.Created)
+		assert.Equal(t, 1, resp.Failed)
+		assert.Equal(t, event.EventImportStatusCreated, resp.Events[0].Status)
+		assert.NotEmpty(t, resp.Events[0].EventID)
+		assert.Equal(t, event.EventImportStatusFailed, resp.Events[1].Status)
+		assert.Equal(t, 1, tm.committed)
+		assert.Equal(t, []string{resp.Events[0].EventID}, n.notified)
+	})
+}
+
+// createEventRequest returns a create event request of the given artists and promotors, which are given as json.
+func createEventRequest(t *testing.T, artists, promotors string) event.CreateEventRequest {
+	payload := `{"name":"Concert","description":"A concert","artists":` + artists + `,"promotors":` + promotors + `,` +
+		`"currency":"IDR","online_ticket_price":{"amount":"50000","currency":"IDR"},"total_online_ticket_allocation":10,` +
+		`"shows":[{"venue":"Hall","type":"LIVE","total_ticket_allocation":100,` +
+		`"location":{"country":"Indonesia","city":"Jakarta","formatted_address":"Jl. Sudirman 1, Jakarta","latitude":-6.2,"longitude":106.8},` +
+		`"ticket_allocation":[{"tier":"GOLD","allocation_by_percentage":100,"price":{"amount":"500000","currency":"IDR"}}]}],` +
+		`"show_time":"2030-01-01 19:00:00","order_rule_range_date":{"start_date":"2029-12-01 00:00:00","end_date":"2029-12-31 00:00:00"}}`
+
+	var req event.CreateEventRequest
+	require.NoError(t, json.Unmarshal([]byte(payload), &req))
+
+	return req
+}
+
+func TestEventUseCaseCreateEventRegistry(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	now := time.Now()
+
+	newUseCase := func(w writes, tm *txManager) event.EventUseCase {
+		return event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:                       logger,
+			Location:                     time.UTC,
+			Timeout:                      10 * time.Second,
+			Validate:                     validator.Get(),
+			EventRepository:              eventStore{w: w},
+			ArtistRepository:             artistStore{w: w},
+			PromotorRepository:           promotorStore{w: w},
+			ShowRepository:               showStore{w: w},
+			LocationRepository:           locationStore{w: w},
+			EventTranslationRepository:   eventTranslationStore{w: w},
+			ShowTranslationRepository:    showTranslationStore{w: w},
+			TaxonomyRepository:           taxonomyStore{w: w},
+			OrderRuleDayRepository:       orderRuleDayStore{w: w},
+			OrderRuleRangeDateRepository: orderRuleRangeDateStore{w: w},
+			TicketStockRepository:        ticketStockStore{w: w},
+			ArtistRegistryRepository: artistRegistry{w: w, artists: map[string]artist.Artist{
+				"ARTIST1": artist.NewArtist("ARTIST1", "Artist A", "", "", now),
+				"ARTIST2": artist.NewArtist("ARTIST2", "Artist B", "", "", now),
+			}},
+			PromotorRegistryRepository: promotorRegistry{w: w, promotors: map[string]promotor.Promotor{
+				"PROMOTOR1": promotor.NewPromotor("PROMOTOR1", "Promotor A", "a@mail.com", "0811", "", "", now),
+			}},
+			FollowerNotifier: &followerNotifier{},
+			TxManager:        tm,
+		})
+	}
+
+	t.Run("the artists and promotors are linked by id or by the slug of their name and registered when they are new", func(t *testing.T) {
+		w := writes{}
+		tm := &txManager{}
+
+		req := createEventRequest(t,
+			`[{"id":"ARTIST1"},"Artist B","New Artist","artist b"]`,
+			`[{"id":"PROMOTOR1"},{"name":"New Promotor","email":"new@mail.com","phone":"0812"}]`,
+		)
+
+		resp, err := newUseCase(w, tm).CreateEvent(context.Background(), req)
+		require.NoError(t, err)
+
+		created, ok := resp.(event.CreateEventResponse)
+		require.True(t, ok)
+
+		require.Len(t, created.Artists, 3)
+		assert.Equal(t, event.ArtistResponse{ID: "ARTIST1", Slug: "artist-a", Name: "Artist A"}, created.Artists[0])
+		assert.Equal(t, event.ArtistResponse{ID: "ARTIST2", Slug: "artist-b", Name: "Artist B"}, created.Artists[1])
+		assert.Equal(t, "new-artist", created.Artists[2].Slug)
+		assert.NotEmpty(t, created.Artists[2].ID)
+
+		require.Len(t, created.Promotors, 2)
+		assert.Equal(t, event.PromotorResponse{ID: "PROMOTOR1", Slug: "promotor-a", Name: "Promotor A", Email: "a@mail.com", Phone: "0811"}, created.Promotors[0])
+		assert.Equal(t, "new@mail.com", created.Promotors[1].Email)
+
+		assert.Equal(t, 1, w["artist"])
+		assert.Equal(t, 3, w["event_artist"])
+		assert.Equal(t, 1, w["promotor"])
+		assert.Equal(t, 2, w["event_promotor"])
+		assert.Equal(t, 1, tm.committed)
+	})
+
+	t.Run("an event of an unknown artist is not created", func(t *testing.T) {
+		w := writes{}
+		tm := &txManager{}
+
+		_, err := newUseCase(w, tm).CreateEvent(context.Background(), createEventRequest(t, `[{"id":"ARTIST9"}]`, `[{"id":"PROMOTOR1"}]`))
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+
+		assert.Zero(t, tm.committed)
+	})
+}
+
+func TestEventUseCaseCreateEventBatch(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	now := time.Now()
+
+	newUseCase := func(w writes, tm *txManager) event.EventUseCase {
+		return event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:                       logger,
+			Location:                     time.UTC,
+			Timeout:                      10 * time.Second,
+			Validate:                     validator.Get(),
+			EventRepository:              eventStore{w: w},
+			ArtistRepository:             artistStore{w: w},
+			PromotorRepository:           promotorStore{w: w},
+			ShowRepository:               showStore{w: w},
+			LocationRepository:           locationStore{w: w},
+			EventTranslationRepository:   eventTranslationStore{w: w},
+			ShowTranslationRepository:    showTranslationStore{w: w},
+			TaxonomyRepository:           taxonomyStore{w: w},
+			OrderRuleDayRepository:       orderRuleDayStore{w: w},
+			OrderRuleRangeDateRepository: orderRuleRangeDateStore{w: w},
+			TicketStockRepository:        ticketStockStore{w: w},
+			ArtistRegistryRepository: artistRegistry{w: w, artists: map[string]artist.Artist{
+				"ARTIST1": artist.NewArtist("ARTIST1", "Artist A", "", "", now),
+			}},
+			PromotorRegistryRepository: promotorRegistry{w: w, promotors: map[string]promotor.Promotor{
+				"PROMOTOR1": promotor.NewPromotor("PROMOTOR1", "Promotor A", "a@mail.com", "0811", "", "", now),
+			}},
+			FollowerNotifier: &followerNotifier{},
+			TxManager:        tm,
+		})
+	}
+
+	t.Run("the rows of an event are written in one batch", func(t *testing.T) {
+		w := writes{}
+		tm := &txManager{}
+
+		_, err := newUseCase(w, tm).CreateEvent(context.Background(), createEventRequest(t, `[{"id":"ARTIST1"}]`, `[{"id":"PROMOTOR1"}]`))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, tm.batches)
+		assert.Equal(t, 1, tm.committed)
+		assert.Equal(t, 1, w["event"])
+		assert.Equal(t, 1, w["event_show"])
+		assert.Equal(t, 1, w["event_show_location"])
+		assert.Equal(t, 1, w["event_artist"])
+		assert.Equal(t, 1, w["event_promotor"])
+		assert.NotZero(t, w["ticket_stock"])
+	})
+
+	// the batch fails once it is sent, the violated constraint tells which of its rows is at fault
+	testCases := []struct {
+		name     string
+		batchErr error
+		status   string
+	}{
+		{
+			name:     "a show which already exists",
+			batchErr: &pgconn.PgError{Code: postgresql.UniqueViolation, ConstraintName: "event_show_pkey"},
+			status:   status.ALREADY_EXIST,
+		},
+		{
+			name:     "an event which already exists",
+			batchErr: &pgconn.PgError{Code: postgresql.UniqueViolation, ConstraintName: "event_pkey"},
+			status:   status.ALREADY_EXIST,
+		},
+		{
+			name:     "a show of an unknown venue",
+			batchErr: &pgconn.PgError{Code: postgresql.ForeignKeyViolation, ConstraintName: "event_show_venue_id_fkey"},
+			status:   status.NOT_FOUND,
+		},
+		{
+			name:     "a ticket stock whose allocation is too low",
+			batchErr: &pgconn.PgError{Code: postgresql.CheckViolation, ConstraintName: "ticket_stock_allocation_check"},
+			status:   status.BAD_REQUEST,
+		},
+		{
+			name:     "another unique constraint",
+			batchErr: &pgconn.PgError{Code: postgresql.UniqueViolation, ConstraintName: "event_slug_key"},
+			status:   status.ALREADY_EXIST,
+		},
+		{
+			name:     "an error which is not a constraint violation",
+			batchErr: fmt.Errorf("connection reset"),
+			status:   status.INTERNAL_SERVER_ERROR,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tm := &txManager{batchErr: tc.batchErr}
+
+			_, err := newUseCase(writes{}, tm).CreateEvent(context.Background(), createEventRequest(t, `[{"id":"ARTIST1"}]`, `[{"id":"PROMOTOR1"}]`))
+			assert.True(t, errors.MatchStatus(err, tc.status), err)
+
+			assert.Zero(t, tm.committed)
+			assert.Equal(t, 1, tm.rolledBack)
+		})
+	}
+}
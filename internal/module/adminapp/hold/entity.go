@@ -0,0 +1,37 @@
+package hold
+
+import "time"
+
+const (
+	TicketHoldTypePromotorComp    string = "PROMOTOR_COMP"
+	TicketHoldTypeSponsor         string = "SPONSOR"
+	TicketHoldTypeArtistGuestList string = "ARTIST_GUEST_LIST"
+	TicketHoldTypeBoxOffice       string = "BOX_OFFICE"
+)
+
+// TicketHold is a named sub-allocation which is carved out of a ticket stock. Its tickets are not available for the
+// general sale, they are either issued directly or released back to the ticket stock.
+type TicketHold struct {
+	ID            string
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	Type          string
+	Name          string
+	Allocation    int64
+	Issued        int64
+	Released      int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Remaining returns the number of tickets of the hold which have been neither issued nor released.
+func (h TicketHold) Remaining() int64 {
+	return h.Allocation - h.Issued - h.Released
+}
+
+// IsComplimentary reports whether the tickets of the hold are issued free of charge. Box office tickets are sold at
+// the price of their ticket stock.
+func (h TicketHold) IsComplimentary() bool {
+	return h.Type != TicketHoldTypeBoxOffice
+}
@@ -0,0 +1,203 @@
+package hold
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	HoldUseCase       HoldUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, holdUseCase HoldUseCase) {
+	handler := &HTTPHandler{
+		Validate:    validate,
+		HoldUseCase: holdUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/ticket-holds", publicMiddleware.SetRouteChain(handler.CreateTicketHold, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/ticket-holds", publicMiddleware.SetRouteChain(handler.GetManyTicketHold, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/ticket-holds/{ticketHoldID}/release", publicMiddleware.SetRouteChain(handler.ReleaseTicketHold, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/ticket-holds/{ticketHoldID}/comps", publicMiddleware.SetRouteChain(handler.IssueCompTicket, adminSession.Verify)).Methods(http.MethodPost)
+}
+
+func (handler HTTPHandler) CreateTicketHold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := CreateTicketHoldRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.HoldUseCase.CreateTicketHold(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket hold"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyTicketHold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyTicketHoldRequest{
+		EventID: vars["eventID"],
+		ShowID:  vars["showID"],
+	}
+
+	resp, err := handler.HoldUseCase.GetManyTicketHold(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("ticket hold")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) ReleaseTicketHold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := ReleaseTicketHoldRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.ID = vars["ticketHoldID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.HoldUseCase.ReleaseTicketHold(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket hold"), i18n.Noun("released")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) IssueCompTicket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := IssueCompTicketRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.TicketHoldID = vars["ticketHoldID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.HoldUseCase.IssueCompTicket(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.DonePlural, i18n.Noun("comp tickets"), i18n.Noun("issued")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
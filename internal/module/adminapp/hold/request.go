@@ -0,0 +1,54 @@
+package hold
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+type CreateTicketHoldRequest struct {
+	EventID       string `json:"-"`
+	ShowID        string `json:"-"`
+	TicketStockID string `json:"ticket_stock_id" validate:"required"`
+	Type          string `json:"type" validate:"oneof=PROMOTOR_COMP SPONSOR ARTIST_GUEST_LIST BOX_OFFICE"`
+	Name          string `json:"name" validate:"required"`
+	Quantity      int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+func (r CreateTicketHoldRequest) ToEntityTicketHold(now time.Time) TicketHold {
+	return TicketHold{
+		ID:            util.GenerateTimestampWithPrefix("HOLD"),
+		EventID:       r.EventID,
+		ShowID:        r.ShowID,
+		TicketStockID: r.TicketStockID,
+		Type:          r.Type,
+		Name:          r.Name,
+		Allocation:    r.Quantity,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+type GetManyTicketHoldRequest struct {
+	EventID string
+	ShowID  string
+}
+
+// ReleaseTicketHoldRequest releases the given quantity of the hold back to the general sale, every remaining ticket
+// of the hold is released when the quantity is omitted.
+type ReleaseTicketHoldRequest struct {
+	EventID  string `json:"-"`
+	ShowID   string `json:"-"`
+	ID       string `json:"-"`
+	Quantity *int64 `json:"quantity" validate:"omitempty,gt=0"`
+}
+
+type IssueCompTicketRequest struct {
+	EventID       string `json:"-"`
+	ShowID        string `json:"-"`
+	TicketHoldID  string `json:"-"`
+	CustomerID    int64  `json:"customer_id" validate:"omitempty,gt=0"`
+	CustomerName  string `json:"customer_name" validate:"required"`
+	CustomerEmail string `json:"customer_email" validate:"required,email"`
+	Quantity      int64  `json:"quantity" validate:"required,gt=0,lte=100"`
+}
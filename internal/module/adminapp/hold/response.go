@@ -0,0 +1,71 @@
+package hold
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type TicketHoldResponse struct {
+	ID            string    `json:"id"`
+	EventID       string    `json:"event_id"`
+	ShowID        string    `json:"show_id"`
+	TicketStockID string    `json:"ticket_stock_id"`
+	Type          string    `json:"type"`
+	Name          string    `json:"name"`
+	Allocation    int64     `json:"allocation"`
+	Issued        int64     `json:"issued"`
+	Released      int64     `json:"released"`
+	Remaining     int64     `json:"remaining"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (r *TicketHoldResponse) PopulateFromEntity(h TicketHold) {
+	r.ID = h.ID
+	r.EventID = h.EventID
+	r.ShowID = h.ShowID
+	r.TicketStockID = h.TicketStockID
+	r.Type = h.Type
+	r.Name = h.Name
+	r.Allocation = h.Allocation
+	r.Issued = h.Issued
+	r.Released = h.Released
+	r.Remaining = h.Remaining()
+	r.CreatedAt = h.CreatedAt
+	r.UpdatedAt = h.UpdatedAt
+}
+
+type GetManyTicketHoldResponse struct {
+	TicketHolds []TicketHoldResponse `json:"ticket_holds"`
+}
+
+type CompTicketResponse struct {
+	ID            int64       `json:"id"`
+	Number        string      `json:"number"`
+	TicketStockID string      `json:"ticket_stock_id"`
+	Tier          string      `json:"tier"`
+	CustomerID    int64       `json:"customer_id"`
+	CustomerName  string      `json:"customer_name"`
+	CustomerEmail string      `json:"customer_email"`
+	Price         money.Money `json:"price"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+func (r *CompTicketResponse) PopulateFromEntity(aq ticket.AcquiredTicket) {
+	r.ID = aq.ID
+	r.Number = aq.Number
+	r.TicketStockID = aq.TicketStockID
+	r.Tier = aq.Tier
+	r.CustomerID = aq.CustomerID
+	r.CustomerName = aq.CustomerName
+	r.CustomerEmail = aq.CustomerEmail
+	r.Price = aq.Price
+	r.CreatedAt = aq.CreatedAt
+}
+
+type IssueCompTicketResponse struct {
+	TicketHold TicketHoldResponse   `json:"ticket_hold"`
+	Tickets    []CompTicketResponse `json:"tickets"`
+}
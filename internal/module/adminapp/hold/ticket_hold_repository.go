@@ -0,0 +1,147 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type TicketHoldRepository interface {
+	Save(ctx context.Context, h TicketHold) error
+	FindByIDForUpdate(ctx context.Context, ID string) (TicketHold, error)
+	FindManyByShowID(ctx context.Context, showID string) ([]TicketHold, error)
+	Update(ctx context.Context, ID string, h TicketHold) error
+}
+
+type ticketHoldRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewTicketHoldRepository(logger *logrus.Logger, db *pgxpool.Pool) TicketHoldRepository {
+	return &ticketHoldRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements TicketHoldRepository.
+func (r *ticketHoldRepository) Save(ctx context.Context, h TicketHold) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_hold
+		(
+			id, event_id, show_id, ticket_stock_id, type, name, allocation, issued, released, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, h.ID, h.EventID, h.ShowID, h.TicketStockID, h.Type, h.Name, h.Allocation, h.Issued, h.Released, h.CreatedAt, h.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket hold's prorperties")
+	}
+
+	return nil
+}
+
+// FindByIDForUpdate implements TicketHoldRepository.
+func (r *ticketHoldRepository) FindByIDForUpdate(ctx context.Context, ID string) (TicketHold, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, allocation, issued, released, created_at, updated_at
+		FROM ticket_hold
+		WHERE
+			id = $1
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	var h TicketHold
+	err := cmd.QueryRowContext(ctx, query, ID).Scan(
+		&h.ID, &h.EventID, &h.ShowID, &h.TicketStockID, &h.Type, &h.Name, &h.Allocation, &h.Issued, &h.Released, &h.CreatedAt, &h.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TicketHold{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket hold"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return TicketHold{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket hold's prorperties for update")
+	}
+
+	return h, nil
+}
+
+// FindManyByShowID implements TicketHoldRepository.
+func (r *ticketHoldRepository) FindManyByShowID(ctx context.Context, showID string) ([]TicketHold, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, allocation, issued, released, created_at, updated_at
+		FROM ticket_hold
+		WHERE
+			show_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, showID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket hold's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]TicketHold, 0)
+	for rows.Next() {
+		var h TicketHold
+		err := rows.Scan(
+			&h.ID, &h.EventID, &h.ShowID, &h.TicketStockID, &h.Type, &h.Name, &h.Allocation, &h.Issued, &h.Released, &h.CreatedAt, &h.UpdatedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket hold's prorperties")
+		}
+
+		data = append(data, h)
+	}
+
+	return data, nil
+}
+
+// Update implements TicketHoldRepository.
+func (r *ticketHoldRepository) Update(ctx context.Context, ID string, h TicketHold) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE ticket_hold
+		SET
+			issued = $1,
+			released = $2,
+			updated_at = $3
+		WHERE
+			id = $4
+	`
+
+	_, err := cmd.ExecContext(ctx, query, h.Issued, h.Released, h.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket hold's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,367 @@
+package hold
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HoldUseCase interface {
+	CreateTicketHold(ctx context.Context, req CreateTicketHoldRequest) (TicketHoldResponse, error)
+	GetManyTicketHold(ctx context.Context, req GetManyTicketHoldRequest) (GetManyTicketHoldResponse, error)
+	ReleaseTicketHold(ctx context.Context, req ReleaseTicketHoldRequest) (TicketHoldResponse, error)
+	IssueCompTicket(ctx context.Context, req IssueCompTicketRequest) (IssueCompTicketResponse, error)
+}
+
+type holdUseCase struct {
+	logger                   *logrus.Logger
+	location                 *time.Location
+	timeout                  time.Duration
+	ticketHoldRepository     TicketHoldRepository
+	ticketStockRepository    ticket.TicketStockRepository
+	acquiredTicketRepository ticket.AcquiredTicketRepository
+	eventRepository          event.EventRepository
+	showRepository           event.ShowRepository
+	locationRepository       event.LocationRepository
+	venueRepository          venue.VenueRepository
+	publisher                pubsub.Publisher
+	waitlistOfferer          ticket.WaitlistOfferer
+	txManager                postgresql.TxManager
+}
+
+type HoldUseCaseProperty struct {
+	Logger                   *logrus.Logger
+	Location                 *time.Location
+	Timeout                  time.Duration
+	TicketHoldRepository     TicketHoldRepository
+	TicketStockRepository    ticket.TicketStockRepository
+	AcquiredTicketRepository ticket.AcquiredTicketRepository
+	EventRepository          event.EventRepository
+	ShowRepository           event.ShowRepository
+	LocationRepository       event.LocationRepository
+	VenueRepository          venue.VenueRepository
+	Publisher                pubsub.Publisher
+	WaitlistOfferer          ticket.WaitlistOfferer
+	TxManager                postgresql.TxManager
+}
+
+func NewHoldUseCase(props HoldUseCaseProperty) HoldUseCase {
+	return &holdUseCase{
+		logger:                   props.Logger,
+		location:                 props.Location,
+		timeout:                  props.Timeout,
+		ticketHoldRepository:     props.TicketHoldRepository,
+		ticketStockRepository:    props.TicketStockRepository,
+		acquiredTicketRepository: props.AcquiredTicketRepository,
+		eventRepository:          props.EventRepository,
+		showRepository:           props.ShowRepository,
+		locationRepository:       props.LocationRepository,
+		venueRepository:          props.VenueRepository,
+		publisher:                props.Publisher,
+		waitlistOfferer:          props.WaitlistOfferer,
+		txManager:                props.TxManager,
+	}
+}
+
+func (u *holdUseCase) findTicketHoldForUpdate(ctx context.Context, eventID, showID, ID string) (TicketHold, error) {
+	h, err := u.ticketHoldRepository.FindByIDForUpdate(ctx, ID)
+	if err != nil {
+		return TicketHold{}, err
+	}
+
+	if h.EventID != eventID || h.ShowID != showID {
+		return TicketHold{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket hold"), ID))
+	}
+
+	return h, nil
+}
+
+// findShowLocation resolves the location of a show from its venue, falling back to its own location. Online shows
+// have none.
+func (u *holdUseCase) findShowLocation(ctx context.Context, s event.Show) (event.Location, error) {
+	if s.VenueID != nil {
+		v, err := u.venueRepository.FindByID(ctx, *s.VenueID)
+		if err != nil {
+			return event.Location{}, err
+		}
+
+		return event.Location{
+			EventID:          s.EventID,
+			ShowID:           s.ID,
+			Country:          v.Country,
+			City:             v.City,
+			FormattedAddress: v.FormattedAddress,
+			Latitude:         v.Latitude,
+			Longitude:        v.Longitude,
+		}, nil
+	}
+
+	location, err := u.locationRepository.FindByShowID(ctx, s.ID)
+	if err != nil {
+		if s.Type == event.ShowTypeOnline && errors.MatchStatus(err, status.NOT_FOUND) {
+			return event.Location{}, nil
+		}
+		return event.Location{}, err
+	}
+
+	return location, nil
+}
+
+// CreateTicketHold implements HoldUseCase. The tickets of the hold are taken from the tickets which are still
+// available for the general sale.
+func (u *holdUseCase) CreateTicketHold(ctx context.Context, req CreateTicketHoldRequest) (TicketHoldResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	now := time.Now()
+	h := req.ToEntityTicketHold(now)
+
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ts, err := u.ticketStockRepository.FindByIDForUpdate(ctx, h.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		if ts.EventID != h.EventID || ts.ShowID != h.ShowID {
+			return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), h.TicketStockID))
+		}
+
+		if ts.Available() < h.Allocation {
+			return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.StockHoldAvailable, ts.Available(), ts.ID))
+		}
+
+		ts.Held = ts.Held + h.Allocation
+		ts.LastStockUpdate = now
+
+		if err := u.ticketStockRepository.Update(ctx, ts.ID, ts); err != nil {
+			return err
+		}
+
+		return u.ticketHoldRepository.Save(ctx, h)
+	})
+	if err != nil {
+		return TicketHoldResponse{}, err
+	}
+
+	resp := TicketHoldResponse{}
+	resp.PopulateFromEntity(h)
+
+	return resp, nil
+}
+
+// GetManyTicketHold implements HoldUseCase.
+func (u *holdUseCase) GetManyTicketHold(ctx context.Context, req GetManyTicketHoldRequest) (GetManyTicketHoldResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	holds, err := u.ticketHoldRepository.FindManyByShowID(ctx, req.ShowID)
+	if err != nil {
+		return GetManyTicketHoldResponse{}, err
+	}
+
+	resp := GetManyTicketHoldResponse{
+		TicketHolds: make([]TicketHoldResponse, 0, len(holds)),
+	}
+
+	for _, v := range holds {
+		if v.EventID != req.EventID {
+			continue
+		}
+
+		hr := TicketHoldResponse{}
+		hr.PopulateFromEntity(v)
+		resp.TicketHolds = append(resp.TicketHolds, hr)
+	}
+
+	return resp, nil
+}
+
+// ReleaseTicketHold implements HoldUseCase. The released tickets are available for the general sale again, they are
+// offered to the waitlist of the ticket stock first.
+func (u *holdUseCase) ReleaseTicketHold(ctx context.Context, req ReleaseTicketHoldRequest) (TicketHoldResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var h TicketHold
+	var ts ticket.TicketStock
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		h, err = u.findTicketHoldForUpdate(ctx, req.EventID, req.ShowID, req.ID)
+		if err != nil {
+			return err
+		}
+
+		quantity := h.Remaining()
+		if req.Quantity != nil {
+			quantity = *req.Quantity
+		}
+
+		if quantity > h.Remaining() {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.HoldReleaseLeft, h.Remaining(), h.ID))
+		}
+
+		ts, err = u.ticketStockRepository.FindByIDForUpdate(ctx, h.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		ts.Held = ts.Held - quantity
+		ts.LastStockUpdate = now
+
+		if err := u.ticketStockRepository.Update(ctx, ts.ID, ts); err != nil {
+			return err
+		}
+
+		h.Released = h.Released + quantity
+		h.UpdatedAt = now
+
+		return u.ticketHoldRepository.Update(ctx, h.ID, h)
+	})
+	if err != nil {
+		return TicketHoldResponse{}, err
+	}
+
+	if err := u.waitlistOfferer.OfferReturnedStock(ctx, ts.ID); err != nil {
+		u.logger.WithContext(ctx).WithError(err).Error()
+	}
+
+	resp := TicketHoldResponse{}
+	resp.PopulateFromEntity(h)
+
+	return resp, nil
+}
+
+// IssueCompTicket implements HoldUseCase. The tickets are issued from the hold as acquired tickets which do not
+// belong to any order.
+func (u *holdUseCase) IssueCompTicket(ctx context.Context, req IssueCompTicketRequest) (IssueCompTicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	e, err := u.eventRepository.FindByID(ctx, req.EventID)
+	if err != nil {
+		return IssueCompTicketResponse{}, err
+	}
+
+	s, err := u.showRepository.FindByID(ctx, req.ShowID)
+	if err != nil {
+		return IssueCompTicketResponse{}, err
+	}
+
+	loc, err := u.findShowLocation(ctx, s)
+	if err != nil {
+		return IssueCompTicketResponse{}, err
+	}
+
+	var h TicketHold
+	var acquiredTickets []ticket.AcquiredTicket
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		h, err = u.findTicketHoldForUpdate(ctx, e.ID, s.ID, req.TicketHoldID)
+		if err != nil {
+			return err
+		}
+
+		if req.Quantity > h.Remaining() {
+			return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.HoldIssueLeft, h.Remaining(), h.ID))
+		}
+
+		ts, err := u.ticketStockRepository.FindByIDForUpdate(ctx, h.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		ts.Held = ts.Held - req.Quantity
+		ts.Acquired = ts.Acquired + req.Quantity
+		ts.LastStockUpdate = now
+
+		if err := u.ticketStockRepository.Update(ctx, ts.ID, ts); err != nil {
+			return err
+		}
+
+		h.Issued = h.Issued + req.Quantity
+		h.UpdatedAt = now
+
+		if err := u.ticketHoldRepository.Update(ctx, h.ID, h); err != nil {
+			return err
+		}
+
+		price := ts.Price
+		if h.IsComplimentary() {
+			price = money.Zero(ts.Price.Currency)
+		}
+
+		acquiredTickets = make([]ticket.AcquiredTicket, req.Quantity)
+		for k := range acquiredTickets {
+			aq := ticket.AcquiredTicket{
+				Number:               util.GenerateUniqueID(util.UppercaseNumeric, 20),
+				EventID:              e.ID,
+				ShowID:               s.ID,
+				Tier:                 ts.Tier,
+				TicketStockID:        ts.ID,
+				TicketHoldID:         &h.ID,
+				EventName:            e.Name,
+				ShowVenue:            s.Venue,
+				ShowType:             s.Type,
+				ShowCountry:          loc.Country,
+				ShowCity:             loc.City,
+				ShowFormattedAddress: loc.FormattedAddress,
+				ShowTime:             s.Time,
+				CustomerName:         req.CustomerName,
+				CustomerEmail:        req.CustomerEmail,
+				CustomerID:           req.CustomerID,
+				CreatedAt:            now,
+				Sequence:             int64(k + 1),
+				Quantity:             1,
+				Price:                price,
+			}
+
+			acquiredTickets[k] = aq
+		}
+
+		aqIDs, err := u.acquiredTicketRepository.SaveMany(ctx, acquiredTickets)
+		if err != nil {
+			return err
+		}
+
+		for k := range acquiredTickets {
+			acquiredTickets[k].ID = aqIDs[k]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return IssueCompTicketResponse{}, err
+	}
+
+	resp := IssueCompTicketResponse{
+		Tickets: make([]CompTicketResponse, len(acquiredTickets)),
+	}
+	resp.TicketHold.PopulateFromEntity(h)
+
+	for k, aq := range acquiredTickets {
+		aqBuff, _ := json.Marshal(aq)
+
+		u.publisher.Publish(ctx, "acquire-ticket", aq.Number, nil, aqBuff)
+
+		resp.Tickets[k].PopulateFromEntity(aq)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,296 @@
+package hold_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/hold"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the holds are not expected to call panics.
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	stocks map[string]ticket.TicketStock
+}
+
+func (s ticketStockStore) FindByIDForUpdate(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	ts, ok := s.stocks[ID]
+	if !ok {
+		return ticket.TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return ts, nil
+}
+
+func (s ticketStockStore) Update(ctx context.Context, ID string, ts ticket.TicketStock) error {
+	s.stocks[ID] = ts
+	return nil
+}
+
+type ticketHoldStore struct {
+	hold.TicketHoldRepository
+	holds map[string]hold.TicketHold
+}
+
+func (s ticketHoldStore) Save(ctx context.Context, h hold.TicketHold) error {
+	s.holds[h.ID] = h
+	return nil
+}
+
+func (s ticketHoldStore) FindByIDForUpdate(ctx context.Context, ID string) (hold.TicketHold, error) {
+	h, ok := s.holds[ID]
+	if !ok {
+		return hold.TicketHold{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return h, nil
+}
+
+func (s ticketHoldStore) Update(ctx context.Context, ID string, h hold.TicketHold) error {
+	s.holds[ID] = h
+	return nil
+}
+
+type acquiredTicketStore struct {
+	ticket.AcquiredTicketRepository
+	tickets []ticket.AcquiredTicket
+}
+
+func (s *acquiredTicketStore) SaveMany(ctx context.Context, aqs []ticket.AcquiredTicket) ([]int64, error) {
+	IDs := make([]int64, len(aqs))
+	for k, aq := range aqs {
+		s.tickets = append(s.tickets, aq)
+		IDs[k] = int64(len(s.tickets))
+	}
+
+	return IDs, nil
+}
+
+type eventStore struct {
+	event.EventRepository
+}
+
+func (eventStore) FindByID(ctx context.Context, ID string) (event.Event, error) {
+	return event.Event{ID: ID, Name: "Test"}, nil
+}
+
+type showStore struct {
+	event.ShowRepository
+}
+
+func (showStore) FindByID(ctx context.Context, ID string) (event.Show, error) {
+	return event.Show{EventID: "EVENT1", ID: ID, Venue: "Hall", Type: event.ShowTypeLive, Time: time.Now()}, nil
+}
+
+type locationStore struct {
+	event.LocationRepository
+}
+
+func (locationStore) FindByShowID(ctx context.Context, showID string) (event.Location, error) {
+	return event.Location{ShowID: showID, Country: "Indonesia", City: "Jakarta"}, nil
+}
+
+type publisher struct {
+	pubsub.Publisher
+	keys []string
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, key string, headers pubsub.MessageHeaders, message []byte) error {
+	p.keys = append(p.keys, key)
+	return nil
+}
+
+type offerer struct {
+	ticketStockIDs []string
+}
+
+func (o *offerer) OfferReturnedStock(ctx context.Context, ticketStockID string) error {
+	o.ticketStockIDs = append(o.ticketStockIDs, ticketStockID)
+	return nil
+}
+
+// txManager runs fn right away, the holds of a test are not concurrent.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestHoldUseCase(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	price := money.MustParse("100000", "IDR")
+
+	type fixture struct {
+		stocks  ticketStockStore
+		holds   ticketHoldStore
+		tickets *acquiredTicketStore
+		pub     *publisher
+		o       *offerer
+		uc      hold.HoldUseCase
+	}
+
+	// newFixture returns a ticket stock of 100 tickets of which 20 are acquired and 18 are held: the 3 which a sponsor
+	// hold of 10 has left after issuing 5 and releasing 2, and the 15 of a box office hold
+	newFixture := func() fixture {
+		f := fixture{
+			stocks: ticketStockStore{stocks: map[string]ticket.TicketStock{
+				"TS1": {EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "GOLD", Allocation: 100, Acquired: 20, Held: 18, Price: price},
+			}},
+			holds: ticketHoldStore{holds: map[string]hold.TicketHold{
+				"HOLD1": {ID: "HOLD1", EventID: "EVENT1", ShowID: "SHOW1", TicketStockID: "TS1", Type: hold.TicketHoldTypeSponsor, Allocation: 10, Issued: 5, Released: 2},
+				"HOLD2": {ID: "HOLD2", EventID: "EVENT1", ShowID: "SHOW1", TicketStockID: "TS1", Type: hold.TicketHoldTypeBoxOffice, Allocation: 15},
+			}},
+			tickets: &acquiredTicketStore{},
+			pub:     &publisher{},
+			o:       &offerer{},
+		}
+
+		f.uc = hold.NewHoldUseCase(hold.HoldUseCaseProperty{
+			Logger:                   logger,
+			Location:                 time.UTC,
+			Timeout:                  10 * time.Second,
+			TicketHoldRepository:     f.holds,
+			TicketStockRepository:    f.stocks,
+			AcquiredTicketRepository: f.tickets,
+			EventRepository:          eventStore{},
+			ShowRepository:           showStore{},
+			LocationRepository:       locationStore{},
+			Publisher:                f.pub,
+			WaitlistOfferer:          f.o,
+			TxManager:                txManager{},
+		})
+
+		return f
+	}
+
+	t.Run("a hold takes its tickets from the ones which are available", func(t *testing.T) {
+		f := newFixture()
+
+		req := hold.CreateTicketHoldRequest{EventID: "EVENT1", ShowID: "SHOW1", TicketStockID: "TS1", Type: hold.TicketHoldTypePromotorComp, Name: "Comps", Quantity: 62}
+
+		resp, err := f.uc.CreateTicketHold(context.Background(), req)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(80), f.stocks.stocks["TS1"].Held)
+		assert.Zero(t, f.stocks.stocks["TS1"].Available())
+		assert.Equal(t, int64(62), f.holds.holds[resp.ID].Remaining())
+
+		// nothing is left for another hold
+		req.Quantity = 1
+		_, err = f.uc.CreateTicketHold(context.Background(), req)
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+		assert.Equal(t, int64(80), f.stocks.stocks["TS1"].Held)
+	})
+
+	t.Run("a hold of a ticket stock of another show is not found", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.uc.CreateTicketHold(context.Background(), hold.CreateTicketHoldRequest{
+			EventID: "EVENT1", ShowID: "SHOW2", TicketStockID: "TS1", Type: hold.TicketHoldTypeSponsor, Name: "Sponsor", Quantity: 1,
+		})
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+		assert.Len(t, f.holds.holds, 2)
+	})
+
+	t.Run("a release returns the tickets of the hold to the general sale and offers them to the waitlist", func(t *testing.T) {
+		f := newFixture()
+		quantity := int64(2)
+
+		resp, err := f.uc.ReleaseTicketHold(context.Background(), hold.ReleaseTicketHoldRequest{EventID: "EVENT1", ShowID: "SHOW1", ID: "HOLD1", Quantity: &quantity})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(4), resp.Released)
+		assert.Equal(t, int64(1), resp.Remaining)
+		assert.Equal(t, int64(16), f.stocks.stocks["TS1"].Held)
+		assert.Equal(t, []string{"TS1"}, f.o.ticketStockIDs)
+
+		// the rest of the hold is released when no quantity is given
+		resp, err = f.uc.ReleaseTicketHold(context.Background(), hold.ReleaseTicketHoldRequest{EventID: "EVENT1", ShowID: "SHOW1", ID: "HOLD1"})
+		require.NoError(t, err)
+
+		assert.Zero(t, resp.Remaining)
+		assert.Equal(t, int64(15), f.stocks.stocks["TS1"].Held)
+	})
+
+	t.Run("a release of more tickets than the hold has left is rejected", func(t *testing.T) {
+		f := newFixture()
+		quantity := int64(4)
+
+		_, err := f.uc.ReleaseTicketHold(context.Background(), hold.ReleaseTicketHoldRequest{EventID: "EVENT1", ShowID: "SHOW1", ID: "HOLD1", Quantity: &quantity})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+
+		assert.Equal(t, int64(18), f.stocks.stocks["TS1"].Held)
+		assert.Equal(t, int64(2), f.holds.holds["HOLD1"].Released)
+		assert.Empty(t, f.o.ticketStockIDs)
+	})
+
+	t.Run("the issued comp tickets move from held to acquired free of charge", func(t *testing.T) {
+		f := newFixture()
+
+		resp, err := f.uc.IssueCompTicket(context.Background(), hold.IssueCompTicketRequest{
+			EventID: "EVENT1", ShowID: "SHOW1", TicketHoldID: "HOLD1", CustomerName: "Guest", CustomerEmail: "guest@mail.com", Quantity: 3,
+		})
+		require.NoError(t, err)
+
+		ts := f.stocks.stocks["TS1"]
+		assert.Equal(t, int64(15), ts.Held)
+		assert.Equal(t, int64(23), ts.Acquired)
+		assert.Equal(t, int64(8), f.holds.holds["HOLD1"].Issued)
+		assert.Zero(t, resp.TicketHold.Remaining)
+
+		require.Len(t, f.tickets.tickets, 3)
+		for _, aq := range f.tickets.tickets {
+			assert.Equal(t, int64(1), aq.Quantity)
+			assert.True(t, aq.Price.IsZero())
+			require.NotNil(t, aq.TicketHoldID)
+			assert.Equal(t, "HOLD1", *aq.TicketHoldID)
+			assert.Empty(t, aq.OrderID)
+		}
+		assert.Len(t, resp.Tickets, 3)
+		assert.Len(t, f.pub.keys, 3)
+	})
+
+	t.Run("the box office tickets are issued at the price of their ticket stock", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.uc.IssueCompTicket(context.Background(), hold.IssueCompTicketRequest{
+			EventID: "EVENT1", ShowID: "SHOW1", TicketHoldID: "HOLD2", CustomerName: "Walk-in", CustomerEmail: "walkin@mail.com", Quantity: 1,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, f.tickets.tickets, 1)
+		assert.True(t, price.Equal(f.tickets.tickets[0].Price))
+	})
+
+	t.Run("more comp tickets than the hold has left are not issued", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.uc.IssueCompTicket(context.Background(), hold.IssueCompTicketRequest{
+			EventID: "EVENT1", ShowID: "SHOW1", TicketHoldID: "HOLD1", CustomerName: "Guest", CustomerEmail: "guest@mail.com", Quantity: 4,
+		})
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+
+		assert.Equal(t, int64(18), f.stocks.stocks["TS1"].Held)
+		assert.Equal(t, int64(20), f.stocks.stocks["TS1"].Acquired)
+		assert.Empty(t, f.tickets.tickets)
+		assert.Empty(t, f.pub.keys)
+	})
+}
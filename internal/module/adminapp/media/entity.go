@@ -0,0 +1,90 @@
+package media
+
+import "time"
+
+const (
+	OwnerTypeEvent  string = "EVENT"
+	OwnerTypeArtist string = "ARTIST"
+
+	KindPoster  string = "POSTER"
+	KindBanner  string = "BANNER"
+	KindGallery string = "GALLERY"
+	KindProfile string = "PROFILE"
+
+	VariantOriginal  string = "original"
+	VariantLarge     string = "large"
+	VariantMedium    string = "medium"
+	VariantThumbnail string = "thumbnail"
+)
+
+// VariantSpec describes a resized variant of an image, the image is scaled down to fit into its bounds.
+type VariantSpec struct {
+	Name      string
+	MaxWidth  int
+	MaxHeight int
+}
+
+// VariantSpecs are the standard variants every uploaded image is resized into.
+var VariantSpecs = []VariantSpec{
+	{Name: VariantLarge, MaxWidth: 1600, MaxHeight: 1600},
+	{Name: VariantMedium, MaxWidth: 800, MaxHeight: 800},
+	{Name: VariantThumbnail, MaxWidth: 320, MaxHeight: 320},
+}
+
+// kinds are the kinds of media each type of owner may have.
+var kinds = map[string][]string{
+	OwnerTypeEvent:  {KindPoster, KindBanner, KindGallery},
+	OwnerTypeArtist: {KindProfile, KindGallery},
+}
+
+// IsKindAllowed tells whether an owner of the given type may have a media of the given kind.
+func IsKindAllowed(ownerType, kind string) bool {
+	for _, v := range kinds[ownerType] {
+		if v == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSingular tells whether an owner has at most one media of the given kind, a new one replaces the previous one.
+func IsSingular(kind string) bool {
+	return kind != KindGallery
+}
+
+type Variant struct {
+	MediaID     string
+	Name        string
+	Key         string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	// Content is only set while the media is being uploaded.
+	Content []byte
+}
+
+type Media struct {
+	ID          string
+	OwnerType   string
+	OwnerID     string
+	Kind        string
+	Filename    string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	Variants    []Variant
+	CreatedAt   time.Time
+}
+
+// Keys returns the storage keys of all the variants of the media.
+func (m Media) Keys() []string {
+	keys := make([]string, len(m.Variants))
+	for k, v := range m.Variants {
+		keys[k] = v.Key
+	}
+
+	return keys
+}
@@ -0,0 +1,176 @@
+package media
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// ownerTypes maps the owners segment of the routes to the type of the owner of the media.
+var ownerTypes = map[string]string{
+	"events":  OwnerTypeEvent,
+	"artists": OwnerTypeArtist,
+}
+
+// maxMultipartMemory is the part of a multipart form which is kept in memory, the rest is buffered on disk.
+const maxMultipartMemory int64 = 1 << 20
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	MediaUseCase      MediaUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, mediaUseCase MediaUseCase) {
+	handler := &HTTPHandler{
+		Validate:     validate,
+		MediaUseCase: mediaUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/{owners:events|artists}/{ownerID}/media", publicMiddleware.SetRouteChain(handler.UploadMedia, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/{owners:events|artists}/{ownerID}/media", publicMiddleware.SetRouteChain(handler.GetManyMedia, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/{owners:events|artists}/{ownerID}/media/{mediaID}", publicMiddleware.SetRouteChain(handler.DeleteMedia, adminSession.Verify)).Methods(http.MethodDelete)
+}
+
+func (handler HTTPHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	// the multipart envelope is allowed on top of the largest image
+	r.Body = http.MaxBytesReader(w, r.Body, MaxFileSize+maxMultipartMemory)
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, response.RESTEnvelope{
+			Status:  status.BAD_REQUEST,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	defer file.Close()
+
+	// one byte more than allowed is read so that an oversized image is rejected rather than truncated
+	content, err := io.ReadAll(io.LimitReader(file, MaxFileSize+1))
+	if err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	req := UploadMediaRequest{
+		OwnerType: ownerTypes[vars["owners"]],
+		OwnerID:   vars["ownerID"],
+		Kind:      r.FormValue("kind"),
+		Filename:  header.Filename,
+		Content:   content,
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.MediaUseCase.UploadMedia(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("media"), i18n.Noun("uploaded")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyMediaRequest{
+		OwnerType: ownerTypes[vars["owners"]],
+		OwnerID:   vars["ownerID"],
+	}
+
+	resp, err := handler.MediaUseCase.GetManyMedia(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("media")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := DeleteMediaRequest{
+		OwnerType: ownerTypes[vars["owners"]],
+		OwnerID:   vars["ownerID"],
+		ID:        vars["mediaID"],
+	}
+
+	if err := handler.MediaUseCase.DeleteMedia(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("media"), i18n.Noun("deleted")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
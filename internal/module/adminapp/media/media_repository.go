@@ -0,0 +1,143 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type MediaRepository interface {
+	Save(ctx context.Context, m Media) error
+	FindByID(ctx context.Context, ID string) (Media, error)
+	FindManyByOwner(ctx context.Context, ownerType, ownerID string) ([]Media, error)
+	Delete(ctx context.Context, ID string) error
+}
+
+type mediaRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewMediaRepository(logger *logrus.Logger, db *pgxpool.Pool) MediaRepository {
+	return &mediaRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements MediaRepository.
+func (r *mediaRepository) Save(ctx context.Context, m Media) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO media
+		(
+			id, owner_type, owner_id, kind, filename, content_type, width, height, size, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, m.ID, m.OwnerType, m.OwnerID, m.Kind, m.Filename, m.ContentType, m.Width, m.Height, m.Size, m.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving media's prorperties")
+	}
+
+	return nil
+}
+
+// FindByID implements MediaRepository.
+func (r *mediaRepository) FindByID(ctx context.Context, ID string) (Media, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, owner_type, owner_id, kind, filename, content_type, width, height, size, created_at
+		FROM media
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data Media
+	err := row.Scan(
+		&data.ID, &data.OwnerType, &data.OwnerID, &data.Kind, &data.Filename, &data.ContentType, &data.Width, &data.Height, &data.Size, &data.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Media{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("media"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Media{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting media's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManyByOwner implements MediaRepository.
+func (r *mediaRepository) FindManyByOwner(ctx context.Context, ownerType, ownerID string) ([]Media, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, owner_type, owner_id, kind, filename, content_type, width, height, size, created_at
+		FROM media
+		WHERE
+			owner_type = $1
+			AND owner_id = $2
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ownerType, ownerID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media's prorperties")
+	}
+	defer rows.Close()
+
+	bunchOfMedia := make([]Media, 0)
+	for rows.Next() {
+		var data Media
+		err := rows.Scan(
+			&data.ID, &data.OwnerType, &data.OwnerID, &data.Kind, &data.Filename, &data.ContentType, &data.Width, &data.Height, &data.Size, &data.CreatedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media's prorperties")
+		}
+		bunchOfMedia = append(bunchOfMedia, data)
+	}
+
+	return bunchOfMedia, nil
+}
+
+// Delete implements MediaRepository. The variants of the media are deleted along with it.
+func (r *mediaRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM media
+		WHERE
+			id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting media's prorperties")
+	}
+
+	return nil
+}
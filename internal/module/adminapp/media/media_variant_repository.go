@@ -0,0 +1,87 @@
+package media
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type MediaVariantRepository interface {
+	Save(ctx context.Context, v Variant) error
+	FindManyByMediaIDs(ctx context.Context, mediaIDs []string) ([]Variant, error)
+}
+
+type mediaVariantRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewMediaVariantRepository(logger *logrus.Logger, db *pgxpool.Pool) MediaVariantRepository {
+	return &mediaVariantRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements MediaVariantRepository.
+func (r *mediaVariantRepository) Save(ctx context.Context, v Variant) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO media_variant
+		(
+			media_id, name, storage_key, content_type, width, height, size
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, v.MediaID, v.Name, v.Key, v.ContentType, v.Width, v.Height, v.Size)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving media variant's prorperties")
+	}
+
+	return nil
+}
+
+// FindManyByMediaIDs implements MediaVariantRepository.
+func (r *mediaVariantRepository) FindManyByMediaIDs(ctx context.Context, mediaIDs []string) ([]Variant, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			media_id, name, storage_key, content_type, width, height, size
+		FROM media_variant
+		WHERE
+			media_id = ANY($1)
+		ORDER BY media_id ASC, width DESC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, mediaIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media variant's prorperties")
+	}
+	defer rows.Close()
+
+	bunchOfVariants := make([]Variant, 0)
+	for rows.Next() {
+		var data Variant
+		err := rows.Scan(&data.MediaID, &data.Name, &data.Key, &data.ContentType, &data.Width, &data.Height, &data.Size)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media variant's prorperties")
+		}
+		bunchOfVariants = append(bunchOfVariants, data)
+	}
+
+	return bunchOfVariants, nil
+}
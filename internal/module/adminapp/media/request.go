@@ -0,0 +1,123 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/imaging"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// MaxFileSize is the largest image which can be uploaded.
+const MaxFileSize int64 = 5 << 20
+
+// variantQuality is the jpeg quality of the resized variants.
+const variantQuality = 85
+
+// contentTypes maps the accepted content types of the images to the extension they are stored with.
+var contentTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+type UploadMediaRequest struct {
+	OwnerType string `validate:"oneof=EVENT ARTIST"`
+	OwnerID   string `validate:"required"`
+	Kind      string `validate:"oneof=POSTER BANNER GALLERY PROFILE"`
+	Filename  string `validate:"max=255"`
+	Content   []byte `validate:"required"`
+}
+
+func (r UploadMediaRequest) key(mediaID, variant, ext string) string {
+	return fmt.Sprintf("%ss/%s/%s/%s.%s", strings.ToLower(r.OwnerType), r.OwnerID, mediaID, variant, ext)
+}
+
+// ToEntityMedia validates the type and the size of the uploaded image and resizes it into the standard variants. The
+// content of every variant, the original one included, is set so that it can be stored.
+func (r UploadMediaRequest) ToEntityMedia(ctx context.Context, now time.Time) (Media, error) {
+	if !IsKindAllowed(r.OwnerType, r.Kind) {
+		return Media{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MediaKindNotAllowed, r.Kind, i18n.Noun(strings.ToLower(r.OwnerType))))
+	}
+
+	if int64(len(r.Content)) > MaxFileSize {
+		return Media{}, errors.New(http.StatusRequestEntityTooLarge, status.BAD_REQUEST, i18n.Message(ctx, i18n.ImageTooLarge, MaxFileSize))
+	}
+
+	contentType := http.DetectContentType(r.Content)
+	ext, ok := contentTypes[contentType]
+	if !ok {
+		return Media{}, errors.New(http.StatusUnsupportedMediaType, status.BAD_REQUEST, i18n.Message(ctx, i18n.UnsupportedImageType, contentType))
+	}
+
+	img, _, err := imaging.Decode(r.Content)
+	if err != nil {
+		return Media{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidImage, err.Error()))
+	}
+
+	bounds := img.Bounds()
+	m := Media{
+		ID:          util.GenerateTimestampWithPrefix("MEDIA"),
+		OwnerType:   r.OwnerType,
+		OwnerID:     r.OwnerID,
+		Kind:        r.Kind,
+		Filename:    r.Filename,
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Size:        int64(len(r.Content)),
+		CreatedAt:   now,
+	}
+
+	m.Variants = append(m.Variants, Variant{
+		MediaID:     m.ID,
+		Name:        VariantOriginal,
+		Key:         r.key(m.ID, VariantOriginal, ext),
+		ContentType: contentType,
+		Width:       m.Width,
+		Height:      m.Height,
+		Size:        m.Size,
+		Content:     r.Content,
+	})
+
+	// the variants are ordered from the largest to the smallest, each one is resized from the previous one
+	source := img
+	for _, spec := range VariantSpecs {
+		resized := imaging.Fit(source, spec.MaxWidth, spec.MaxHeight)
+		source = resized
+
+		content, err := imaging.EncodeJPEG(resized, variantQuality)
+		if err != nil {
+			return Media{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while resizing image into variant '%s'", spec.Name))
+		}
+
+		m.Variants = append(m.Variants, Variant{
+			MediaID:     m.ID,
+			Name:        spec.Name,
+			Key:         r.key(m.ID, spec.Name, "jpg"),
+			ContentType: "image/jpeg",
+			Width:       resized.Bounds().Dx(),
+			Height:      resized.Bounds().Dy(),
+			Size:        int64(len(content)),
+			Content:     content,
+		})
+	}
+
+	return m, nil
+}
+
+type GetManyMediaRequest struct {
+	OwnerType string
+	OwnerID   string
+}
+
+type DeleteMediaRequest struct {
+	OwnerType string
+	OwnerID   string
+	ID        string
+}
@@ -0,0 +1,54 @@
+package media
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
+)
+
+type VariantResponse struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type MediaResponse struct {
+	ID          string                     `json:"id"`
+	OwnerType   string                     `json:"owner_type"`
+	OwnerID     string                     `json:"owner_id"`
+	Kind        string                     `json:"kind"`
+	Filename    string                     `json:"filename"`
+	ContentType string                     `json:"content_type"`
+	Width       int                        `json:"width"`
+	Height      int                        `json:"height"`
+	Size        int64                      `json:"size"`
+	Variants    map[string]VariantResponse `json:"variants"`
+	CreatedAt   time.Time                  `json:"created_at"`
+}
+
+// PopulateFromEntity populates the response from the media, the urls of its variants are resolved by the storage.
+func (r *MediaResponse) PopulateFromEntity(m Media, store storage.Storage) {
+	r.ID = m.ID
+	r.OwnerType = m.OwnerType
+	r.OwnerID = m.OwnerID
+	r.Kind = m.Kind
+	r.Filename = m.Filename
+	r.ContentType = m.ContentType
+	r.Width = m.Width
+	r.Height = m.Height
+	r.Size = m.Size
+	r.CreatedAt = m.CreatedAt
+
+	r.Variants = make(map[string]VariantResponse, len(m.Variants))
+	for _, v := range m.Variants {
+		r.Variants[v.Name] = VariantResponse{
+			URL:    store.URL(v.Key),
+			Width:  v.Width,
+			Height: v.Height,
+		}
+	}
+}
+
+type GetManyMediaResponse struct {
+	Media []MediaResponse `json:"media"`
+}
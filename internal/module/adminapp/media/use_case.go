@@ -0,0 +1,238 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/artist"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
+)
+
+type MediaUseCase interface {
+	UploadMedia(ctx context.Context, req UploadMediaRequest) (MediaResponse, error)
+	GetManyMedia(ctx context.Context, req GetManyMediaRequest) (GetManyMediaResponse, error)
+	DeleteMedia(ctx context.Context, req DeleteMediaRequest) error
+}
+
+type mediaUseCase struct {
+	logger                 *logrus.Logger
+	timeout                time.Duration
+	storage                storage.Storage
+	mediaRepository        MediaRepository
+	mediaVariantRepository MediaVariantRepository
+	eventRepository        event.EventRepository
+	artistRepository       artist.ArtistRepository
+	txManager              postgresql.TxManager
+}
+
+type MediaUseCaseProperty struct {
+	Logger                 *logrus.Logger
+	Timeout                time.Duration
+	Storage                storage.Storage
+	MediaRepository        MediaRepository
+	MediaVariantRepository MediaVariantRepository
+	EventRepository        event.EventRepository
+	ArtistRepository       artist.ArtistRepository
+	TxManager              postgresql.TxManager
+}
+
+func NewMediaUseCase(props MediaUseCaseProperty) MediaUseCase {
+	return &mediaUseCase{
+		logger:                 props.Logger,
+		timeout:                props.Timeout,
+		storage:                props.Storage,
+		mediaRepository:        props.MediaRepository,
+		mediaVariantRepository: props.MediaVariantRepository,
+		eventRepository:        props.EventRepository,
+		artistRepository:       props.ArtistRepository,
+		txManager:              props.TxManager,
+	}
+}
+
+// findOwner makes sure that the owner of the media exists.
+func (u *mediaUseCase) findOwner(ctx context.Context, ownerType, ownerID string) error {
+	switch ownerType {
+	case OwnerTypeEvent:
+		_, err := u.eventRepository.FindByID(ctx, ownerID)
+		return err
+	case OwnerTypeArtist:
+		_, err := u.artistRepository.FindByID(ctx, ownerID)
+		return err
+	}
+
+	return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.UnsupportedMediaOwner, ownerType))
+}
+
+// findManyByOwner returns the media of the owner along with their variants.
+func (u *mediaUseCase) findManyByOwner(ctx context.Context, ownerType, ownerID string) ([]Media, error) {
+	bunchOfMedia, err := u.mediaRepository.FindManyByOwner(ctx, ownerType, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bunchOfMedia) == 0 {
+		return bunchOfMedia, nil
+	}
+
+	mediaIDs := make([]string, len(bunchOfMedia))
+	for k, v := range bunchOfMedia {
+		mediaIDs[k] = v.ID
+	}
+
+	variants, err := u.mediaVariantRepository.FindManyByMediaIDs(ctx, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byMediaID := make(map[string][]Variant)
+	for _, v := range variants {
+		byMediaID[v.MediaID] = append(byMediaID[v.MediaID], v)
+	}
+
+	for k, v := range bunchOfMedia {
+		bunchOfMedia[k].Variants = byMediaID[v.ID]
+	}
+
+	return bunchOfMedia, nil
+}
+
+// deleteFiles removes the stored files of the media. A file which can not be removed is only logged, it is no longer
+// referenced by any media.
+func (u *mediaUseCase) deleteFiles(ctx context.Context, bunchOfMedia ...Media) {
+	for _, m := range bunchOfMedia {
+		for _, key := range m.Keys() {
+			if err := u.storage.Delete(ctx, key); err != nil {
+				u.logger.WithContext(ctx).WithError(err).WithField("key", key).Warn("failed to delete media file")
+			}
+		}
+	}
+}
+
+// UploadMedia implements MediaUseCase. A media of a singular kind, e.g. the poster of an event, replaces the previous
+// one of the owner.
+func (u *mediaUseCase) UploadMedia(ctx context.Context, req UploadMediaRequest) (MediaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if err := u.findOwner(ctx, req.OwnerType, req.OwnerID); err != nil {
+		return MediaResponse{}, err
+	}
+
+	m, err := req.ToEntityMedia(ctx, time.Now())
+	if err != nil {
+		return MediaResponse{}, err
+	}
+
+	for _, v := range m.Variants {
+		if err := u.storage.Put(ctx, v.Key, v.ContentType, v.Content); err != nil {
+			u.logger.WithContext(ctx).WithError(err).Error()
+			u.deleteFiles(ctx, m)
+			return MediaResponse{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while storing media's files")
+		}
+	}
+
+	replaced := make([]Media, 0)
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		replaced = replaced[:0]
+
+		if IsSingular(m.Kind) {
+			existing, err := u.findManyByOwner(ctx, m.OwnerType, m.OwnerID)
+			if err != nil {
+				return err
+			}
+
+			for _, v := range existing {
+				if v.Kind != m.Kind {
+					continue
+				}
+				if err := u.mediaRepository.Delete(ctx, v.ID); err != nil {
+					return err
+				}
+				replaced = append(replaced, v)
+			}
+		}
+
+		if err := u.mediaRepository.Save(ctx, m); err != nil {
+			return err
+		}
+
+		for _, v := range m.Variants {
+			if err := u.mediaVariantRepository.Save(ctx, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		u.deleteFiles(ctx, m)
+		return MediaResponse{}, err
+	}
+
+	u.deleteFiles(ctx, replaced...)
+
+	resp := MediaResponse{}
+	resp.PopulateFromEntity(m, u.storage)
+
+	return resp, nil
+}
+
+// GetManyMedia implements MediaUseCase.
+func (u *mediaUseCase) GetManyMedia(ctx context.Context, req GetManyMediaRequest) (GetManyMediaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if err := u.findOwner(ctx, req.OwnerType, req.OwnerID); err != nil {
+		return GetManyMediaResponse{}, err
+	}
+
+	bunchOfMedia, err := u.findManyByOwner(ctx, req.OwnerType, req.OwnerID)
+	if err != nil {
+		return GetManyMediaResponse{}, err
+	}
+
+	resp := GetManyMediaResponse{
+		Media: make([]MediaResponse, len(bunchOfMedia)),
+	}
+	for k, v := range bunchOfMedia {
+		resp.Media[k].PopulateFromEntity(v, u.storage)
+	}
+
+	return resp, nil
+}
+
+// DeleteMedia implements MediaUseCase.
+func (u *mediaUseCase) DeleteMedia(ctx context.Context, req DeleteMediaRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	m, err := u.mediaRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if m.OwnerType != req.OwnerType || m.OwnerID != req.OwnerID {
+		return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("media"), req.ID))
+	}
+
+	variants, err := u.mediaVariantRepository.FindManyByMediaIDs(ctx, []string{m.ID})
+	if err != nil {
+		return err
+	}
+	m.Variants = variants
+
+	if err := u.mediaRepository.Delete(ctx, m.ID); err != nil {
+		return err
+	}
+
+	u.deleteFiles(ctx, m)
+
+	return nil
+}
@@ -2,60 +2,47 @@ package order
 
 import (
 	"context"
-	"database/sql"
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type OrderRuleDayRepository interface {
-	FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]OrderRuleDay, error)
-	Save(ctx context.Context, rule OrderRuleDay, tx *sql.Tx) error
-	Delete(ctx context.Context, eventID string, tx *sql.Tx) error
+	FindManyByEventID(ctx context.Context, eventID string) ([]OrderRuleDay, error)
+	Save(ctx context.Context, rule OrderRuleDay) error
+	SaveMany(ctx context.Context, rules []OrderRuleDay) error
+	Delete(ctx context.Context, eventID string) error
 }
 
 type orderRuleDayRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
 // Delete implements OrderRuleDayRepository.
-func (r *orderRuleDayRepository) Delete(ctx context.Context, eventID string, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleDayRepository) Delete(ctx context.Context, eventID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		DELETE FROM order_rule_day WHERE event_id = $1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting order rule day's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, eventID)
+	_, err := cmd.ExecContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting order rule days's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting order rule days's prorperties")
 	}
 
 	return nil
 }
 
 // FindManyByEventID implements OrderRuleDayRepository.
-func (r *orderRuleDayRepository) FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]OrderRuleDay, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleDayRepository) FindManyByEventID(ctx context.Context, eventID string) ([]OrderRuleDay, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
@@ -65,17 +52,10 @@ func (r *orderRuleDayRepository) FindManyByEventID(ctx context.Context, eventID
 			event_id = $1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	rows, err := cmd.QueryContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, eventID)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
 	}
 
 	defer rows.Close()
@@ -87,7 +67,7 @@ func (r *orderRuleDayRepository) FindManyByEventID(ctx context.Context, eventID
 		err := rows.Scan(&rule.EventID, &rule.Day)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
 		}
 
 		data = append(data, rule)
@@ -97,12 +77,8 @@ func (r *orderRuleDayRepository) FindManyByEventID(ctx context.Context, eventID
 }
 
 // Save implements OrderRuleDayRepository.
-func (r *orderRuleDayRepository) Save(ctx context.Context, rule OrderRuleDay, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleDayRepository) Save(ctx context.Context, rule OrderRuleDay) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO order_rule_day
@@ -115,25 +91,50 @@ func (r *orderRuleDayRepository) Save(ctx context.Context, rule OrderRuleDay, tx
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, rule.EventID, rule.Day)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule day's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, rule.EventID, rule.Day)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule days's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule days's prorperties")
 	}
 
 	return nil
 }
 
-func NewOrderRuleDayRepository(logger *logrus.Logger, db *sql.DB) OrderRuleDayRepository {
+func NewOrderRuleDayRepository(logger *logrus.Logger, db *pgxpool.Pool) OrderRuleDayRepository {
 	return &orderRuleDayRepository{
 		logger: logger,
 		db:     db,
 	}
 }
+
+// SaveMany implements OrderRuleDayRepository. The rules are inserted by one statement.
+func (r *orderRuleDayRepository) SaveMany(ctx context.Context, rules []OrderRuleDay) error {
+	if len(rules) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO order_rule_day
+		(
+			event_id, day
+		)
+		SELECT * FROM unnest($1::varchar[], $2::smallint[])
+	`
+
+	eventIDs := make([]string, len(rules))
+	days := make([]int64, len(rules))
+	for k, rule := range rules {
+		eventIDs[k] = rule.EventID
+		days[k] = rule.Day
+	}
+
+	_, err := cmd.ExecContext(ctx, query, eventIDs, days)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of order rule days's prorperties")
+	}
+
+	return nil
+}
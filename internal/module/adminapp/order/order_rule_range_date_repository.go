@@ -3,33 +3,28 @@ package order
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type OrderRuleRangeDateRepository interface {
-	FindByEventID(ctx context.Context, eventID string, tx *sql.Tx) (OrderRuleRangeDate, error)
-	Save(ctx context.Context, rule OrderRuleRangeDate, tx *sql.Tx) error
-	Update(ctx context.Context, eventID string, rule OrderRuleRangeDate, tx *sql.Tx) error
-}
-
-type sqlCommand interface {
-	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
-	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
-	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	FindByEventID(ctx context.Context, eventID string) (OrderRuleRangeDate, error)
+	Save(ctx context.Context, rule OrderRuleRangeDate) error
+	Update(ctx context.Context, eventID string, rule OrderRuleRangeDate) error
 }
 
 type orderRuleRangeDateRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewOrderRuleRangeDateRepository(logger *logrus.Logger, db *sql.DB) OrderRuleRangeDateRepository {
+func NewOrderRuleRangeDateRepository(logger *logrus.Logger, db *pgxpool.Pool) OrderRuleRangeDateRepository {
 	return &orderRuleRangeDateRepository{
 		logger: logger,
 		db:     db,
@@ -37,12 +32,8 @@ func NewOrderRuleRangeDateRepository(logger *logrus.Logger, db *sql.DB) OrderRul
 }
 
 // FindByEventID implements OrderRuleRangeDateRepository.
-func (r *orderRuleRangeDateRepository) FindByEventID(ctx context.Context, eventID string, tx *sql.Tx) (OrderRuleRangeDate, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleRangeDateRepository) FindByEventID(ctx context.Context, eventID string) (OrderRuleRangeDate, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
@@ -53,37 +44,26 @@ func (r *orderRuleRangeDateRepository) FindByEventID(ctx context.Context, eventI
 		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return OrderRuleRangeDate{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting order rule range date's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, eventID)
+	row := cmd.QueryRowContext(ctx, query, eventID)
 
 	var data OrderRuleRangeDate
-	err = row.Scan(
+	err := row.Scan(
 		&data.EventID, &data.StartDate, &data.EndDate,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return OrderRuleRangeDate{}, errors.New(http.StatusNotFound, status.NOT_FOUND, fmt.Sprintf("order rule range date's properties with id '%s' is not found", eventID))
+			return OrderRuleRangeDate{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("order rule range date"), eventID))
 		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return OrderRuleRangeDate{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting order rule range date's prorperties")
+		return OrderRuleRangeDate{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting order rule range date's prorperties")
 	}
 
 	return data, nil
 }
 
 // Save implements OrderRuleRangeDateRepository.
-func (r *orderRuleRangeDateRepository) Save(ctx context.Context, rule OrderRuleRangeDate, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleRangeDateRepository) Save(ctx context.Context, rule OrderRuleRangeDate) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO order_rule_range_date
@@ -96,29 +76,18 @@ func (r *orderRuleRangeDateRepository) Save(ctx context.Context, rule OrderRuleR
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, rule.EventID, rule.StartDate, rule.EndDate)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule range date's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, rule.EventID, rule.StartDate, rule.EndDate)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule range date's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving order rule range date's prorperties")
 	}
 
 	return nil
 }
 
 // Update implements OrderRuleRangeDateRepository.
-func (r *orderRuleRangeDateRepository) Update(ctx context.Context, eventID string, rule OrderRuleRangeDate, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *orderRuleRangeDateRepository) Update(ctx context.Context, eventID string, rule OrderRuleRangeDate) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		UPDATE order_rule_range_date
@@ -128,17 +97,10 @@ func (r *orderRuleRangeDateRepository) Update(ctx context.Context, eventID strin
 		WHERE event_id = $4
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating order rule range date's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, rule.StartDate, rule.EndDate)
+	_, err := cmd.ExecContext(ctx, query, rule.StartDate, rule.EndDate)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating order rule range date's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating order rule range date's prorperties")
 	}
 
 	return nil
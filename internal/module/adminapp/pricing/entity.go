@@ -0,0 +1,60 @@
+package pricing
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/pricing"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	PriceRuleTypePhase       string = "PHASE"
+	PriceRuleTypeSellThrough string = "SELL_THROUGH"
+	PriceRuleTypeOverride    string = "OVERRIDE"
+)
+
+type PriceRule struct {
+	ID             string
+	EventID        string
+	ShowID         string
+	TicketStockID  string
+	Type           string
+	Name           string
+	Price          money.Money
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	SoldPercentage *float64
+	CreatedAt      time.Time
+}
+
+// ToPricingRule returns the rule which is evaluated by the pricing engine.
+func (r PriceRule) ToPricingRule() pricing.Rule {
+	switch r.Type {
+	case PriceRuleTypeSellThrough:
+		return pricing.SellThroughRule{Step: r.Name, Amount: r.Price, Percentage: *r.SoldPercentage}
+	case PriceRuleTypeOverride:
+		return pricing.OverrideRule{Reason: r.Name, Amount: r.Price, StartsAt: *r.StartsAt, EndsAt: r.EndsAt}
+	default:
+		return pricing.PhaseRule{Phase: r.Name, Amount: r.Price, StartsAt: *r.StartsAt, EndsAt: r.EndsAt}
+	}
+}
+
+// NewPricingEngine returns the pricing engine of the given rules.
+func NewPricingEngine(rules []PriceRule) *pricing.Engine {
+	pricingRules := make([]pricing.Rule, len(rules))
+	for k, v := range rules {
+		pricingRules[k] = v.ToPricingRule()
+	}
+
+	return pricing.New(pricingRules...)
+}
+
+// PriceChange records a change of the price of a ticket stock which takes effect from the given time.
+type PriceChange struct {
+	ID            int64
+	TicketStockID string
+	Price         money.Money
+	Rule          string
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
@@ -0,0 +1,138 @@
+package pricing
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	PricingUseCase    PricingUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, pricingUseCase PricingUseCase) {
+	handler := &HTTPHandler{
+		Validate:       validate,
+		PricingUseCase: pricingUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/price-rules", publicMiddleware.SetRouteChain(handler.CreatePriceRule, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/price-rules", publicMiddleware.SetRouteChain(handler.GetManyPriceRule, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/price-rules/{priceRuleID}", publicMiddleware.SetRouteChain(handler.DeletePriceRule, adminSession.Verify)).Methods(http.MethodDelete)
+}
+
+func (handler HTTPHandler) CreatePriceRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := CreatePriceRuleRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.TicketStockID = vars["ticketStockID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.PricingUseCase.CreatePriceRule(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket price rule"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyPriceRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyPriceRuleRequest{
+		EventID:       vars["eventID"],
+		ShowID:        vars["showID"],
+		TicketStockID: vars["ticketStockID"],
+	}
+
+	resp, err := handler.PricingUseCase.GetManyPriceRule(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("ticket price rule")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) DeletePriceRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := DeletePriceRuleRequest{
+		EventID:       vars["eventID"],
+		ShowID:        vars["showID"],
+		TicketStockID: vars["ticketStockID"],
+		ID:            vars["priceRuleID"],
+	}
+
+	if err := handler.PricingUseCase.DeletePriceRule(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket price rule"), i18n.Noun("deleted")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,89 @@
+package pricing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PriceChangeRepository interface {
+	Save(ctx context.Context, pc PriceChange) error
+	FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceChange, error)
+}
+
+type priceChangeRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPriceChangeRepository(logger *logrus.Logger, db *pgxpool.Pool) PriceChangeRepository {
+	return &priceChangeRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements PriceChangeRepository.
+func (r *priceChangeRepository) Save(ctx context.Context, pc PriceChange) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_price_change
+		(
+			ticket_stock_id, price, currency, rule, effective_from, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, pc.TicketStockID, pc.Price.Amount, pc.Price.Currency, pc.Rule, pc.EffectiveFrom, pc.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket price change's prorperties")
+	}
+
+	return nil
+}
+
+// FindManyByTicketStockID implements PriceChangeRepository.
+func (r *priceChangeRepository) FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceChange, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, ticket_stock_id, price, currency, rule, effective_from, created_at
+		FROM ticket_price_change
+		WHERE
+			ticket_stock_id = $1
+		ORDER BY effective_from ASC, id ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ticketStockID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price change's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]PriceChange, 0)
+	for rows.Next() {
+		var pc PriceChange
+		err := rows.Scan(&pc.ID, &pc.TicketStockID, &pc.Price.Amount, &pc.Price.Currency, &pc.Rule, &pc.EffectiveFrom, &pc.CreatedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price change's prorperties")
+		}
+
+		data = append(data, pc)
+	}
+
+	return data, nil
+}
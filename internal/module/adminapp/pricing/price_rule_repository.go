@@ -0,0 +1,157 @@
+package pricing
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PriceRuleRepository interface {
+	Save(ctx context.Context, pr PriceRule) error
+	FindByID(ctx context.Context, ID string) (PriceRule, error)
+	FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceRule, error)
+	Delete(ctx context.Context, ID string) error
+}
+
+type priceRuleRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPriceRuleRepository(logger *logrus.Logger, db *pgxpool.Pool) PriceRuleRepository {
+	return &priceRuleRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements PriceRuleRepository.
+func (r *priceRuleRepository) Save(ctx context.Context, pr PriceRule) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_price_rule
+		(
+			id, event_id, show_id, ticket_stock_id, type, name, price, currency, starts_at, ends_at, sold_percentage, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, pr.ID, pr.EventID, pr.ShowID, pr.TicketStockID, pr.Type, pr.Name, pr.Price.Amount, pr.Price.Currency, pr.StartsAt, pr.EndsAt, pr.SoldPercentage, pr.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket price rule's prorperties")
+	}
+
+	return nil
+}
+
+func (r *priceRuleRepository) scan(row interface{ Scan(dest ...any) error }) (PriceRule, error) {
+	var pr PriceRule
+	var startsAt, endsAt sql.NullTime
+	var soldPercentage sql.NullFloat64
+
+	err := row.Scan(&pr.ID, &pr.EventID, &pr.ShowID, &pr.TicketStockID, &pr.Type, &pr.Name, &pr.Price.Amount, &pr.Price.Currency, &startsAt, &endsAt, &soldPercentage, &pr.CreatedAt)
+	if err != nil {
+		return PriceRule{}, err
+	}
+
+	if startsAt.Valid {
+		pr.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		pr.EndsAt = &endsAt.Time
+	}
+	if soldPercentage.Valid {
+		pr.SoldPercentage = &soldPercentage.Float64
+	}
+
+	return pr, nil
+}
+
+// FindByID implements PriceRuleRepository.
+func (r *priceRuleRepository) FindByID(ctx context.Context, ID string) (PriceRule, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, price, currency, starts_at, ends_at, sold_percentage, created_at
+		FROM ticket_price_rule
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	data, err := r.scan(cmd.QueryRowContext(ctx, query, ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PriceRule{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket price rule"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PriceRule{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket price rule's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManyByTicketStockID implements PriceRuleRepository. The rules are ordered the way the pricing engine has to apply them.
+func (r *priceRuleRepository) FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceRule, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, price, currency, starts_at, ends_at, sold_percentage, created_at
+		FROM ticket_price_rule
+		WHERE
+			ticket_stock_id = $1
+		ORDER BY starts_at ASC NULLS FIRST, sold_percentage ASC NULLS FIRST, created_at ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ticketStockID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]PriceRule, 0)
+	for rows.Next() {
+		pr, err := r.scan(rows)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+		}
+
+		data = append(data, pr)
+	}
+
+	return data, nil
+}
+
+// Delete implements PriceRuleRepository.
+func (r *priceRuleRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM ticket_price_rule WHERE id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting ticket price rule's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package pricing
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreatePriceRuleRequest struct {
+	EventID        string      `json:"-"`
+	ShowID         string      `json:"-"`
+	TicketStockID  string      `json:"-"`
+	Type           string      `json:"type" validate:"oneof=PHASE SELL_THROUGH OVERRIDE"`
+	Name           string      `json:"name" validate:"required"`
+	Price          money.Money `json:"price" validate:"money"`
+	StartsAt       string      `json:"starts_at" validate:"required_unless=Type SELL_THROUGH,omitempty,datetime=2006-01-02 15:04:05"`
+	EndsAt         string      `json:"ends_at" validate:"omitempty,datetime=2006-01-02 15:04:05"`
+	SoldPercentage *float64    `json:"sold_percentage" validate:"required_if=Type SELL_THROUGH,omitempty,gt=0,lte=100"`
+}
+
+func (r CreatePriceRuleRequest) ToEntityPriceRule(ctx context.Context, location *time.Location, now time.Time) (PriceRule, error) {
+	rule := PriceRule{
+		ID:            util.GenerateTimestampWithPrefix("PRICERULE"),
+		EventID:       r.EventID,
+		ShowID:        r.ShowID,
+		TicketStockID: r.TicketStockID,
+		Type:          r.Type,
+		Name:          r.Name,
+		Price:         r.Price,
+		CreatedAt:     now,
+	}
+
+	if r.Type == PriceRuleTypeSellThrough {
+		rule.SoldPercentage = r.SoldPercentage
+		return rule, nil
+	}
+
+	startsAt, err := time.ParseInLocation("2006-01-02 15:04:05", r.StartsAt, location)
+	if err != nil {
+		return PriceRule{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "starts_at", r.StartsAt))
+	}
+	rule.StartsAt = &startsAt
+
+	if r.EndsAt != "" {
+		endsAt, err := time.ParseInLocation("2006-01-02 15:04:05", r.EndsAt, location)
+		if err != nil {
+			return PriceRule{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "ends_at", r.EndsAt))
+		}
+
+		if !endsAt.After(startsAt) {
+			return PriceRule{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MustBeAfter, "ends_at", "starts_at"))
+		}
+		rule.EndsAt = &endsAt
+	}
+
+	return rule, nil
+}
+
+type GetManyPriceRuleRequest struct {
+	EventID       string
+	ShowID        string
+	TicketStockID string
+}
+
+type DeletePriceRuleRequest struct {
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	ID            string
+}
@@ -0,0 +1,46 @@
+package pricing
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type PriceRuleResponse struct {
+	ID             string      `json:"id"`
+	TicketStockID  string      `json:"ticket_stock_id"`
+	Type           string      `json:"type"`
+	Name           string      `json:"name"`
+	Price          money.Money `json:"price"`
+	StartsAt       *time.Time  `json:"starts_at"`
+	EndsAt         *time.Time  `json:"ends_at"`
+	SoldPercentage *float64    `json:"sold_percentage"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+func (r *PriceRuleResponse) PopulateFromEntity(pr PriceRule) {
+	r.ID = pr.ID
+	r.TicketStockID = pr.TicketStockID
+	r.Type = pr.Type
+	r.Name = pr.Name
+	r.Price = pr.Price
+	r.StartsAt = pr.StartsAt
+	r.EndsAt = pr.EndsAt
+	r.SoldPercentage = pr.SoldPercentage
+	r.CreatedAt = pr.CreatedAt
+}
+
+type PriceChangeResponse struct {
+	Price         money.Money `json:"price"`
+	Rule          string      `json:"rule"`
+	EffectiveFrom time.Time   `json:"effective_from"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type GetManyPriceRuleResponse struct {
+	TicketStockID string                `json:"ticket_stock_id"`
+	BasePrice     money.Money           `json:"base_price"`
+	CurrentPrice  money.Money           `json:"current_price"`
+	PriceRules    []PriceRuleResponse   `json:"price_rules"`
+	PriceChanges  []PriceChangeResponse `json:"price_changes"`
+}
@@ -0,0 +1,235 @@
+package pricing
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/pricing"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PricingUseCase interface {
+	CreatePriceRule(ctx context.Context, req CreatePriceRuleRequest) (PriceRuleResponse, error)
+	GetManyPriceRule(ctx context.Context, req GetManyPriceRuleRequest) (GetManyPriceRuleResponse, error)
+	DeletePriceRule(ctx context.Context, req DeletePriceRuleRequest) error
+}
+
+type pricingUseCase struct {
+	logger                *logrus.Logger
+	location              *time.Location
+	timeout               time.Duration
+	priceRuleRepository   PriceRuleRepository
+	priceChangeRepository PriceChangeRepository
+	ticketStockRepository ticket.TicketStockRepository
+	txManager             postgresql.TxManager
+}
+
+type PricingUseCaseProperty struct {
+	Logger                *logrus.Logger
+	Location              *time.Location
+	Timeout               time.Duration
+	PriceRuleRepository   PriceRuleRepository
+	PriceChangeRepository PriceChangeRepository
+	TicketStockRepository ticket.TicketStockRepository
+	TxManager             postgresql.TxManager
+}
+
+func NewPricingUseCase(props PricingUseCaseProperty) PricingUseCase {
+	return &pricingUseCase{
+		logger:                props.Logger,
+		location:              props.Location,
+		timeout:               props.Timeout,
+		priceRuleRepository:   props.PriceRuleRepository,
+		priceChangeRepository: props.PriceChangeRepository,
+		ticketStockRepository: props.TicketStockRepository,
+		txManager:             props.TxManager,
+	}
+}
+
+func (u *pricingUseCase) findTicketStock(ctx context.Context, eventID, showID, ticketStockID string) (ticket.TicketStock, error) {
+	ts, err := u.ticketStockRepository.FindByID(ctx, ticketStockID)
+	if err != nil {
+		return ticket.TicketStock{}, err
+	}
+
+	if ts.EventID != eventID || ts.ShowID != showID {
+		return ticket.TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), ticketStockID))
+	}
+
+	return ts, nil
+}
+
+// recordChanges records the price changes caused by replacing the rules of the ticket stock. The prices of both
+// rule sets are compared now and at every instant at which the changed rule starts or stops to apply.
+func (u *pricingUseCase) recordChanges(ctx context.Context, ts ticket.TicketStock, before, after []PriceRule, changed PriceRule, now time.Time) error {
+	beforeEngine := NewPricingEngine(before)
+	afterEngine := NewPricingEngine(after)
+
+	instants := []time.Time{now}
+	for _, t := range changed.ToPricingRule().ChangesAt() {
+		if t.After(now) {
+			instants = append(instants, t)
+		}
+	}
+
+	for _, t := range instants {
+		state := pricing.State{Now: t, BasePrice: ts.Price, Allocation: ts.Allocation, Sold: ts.Acquired}
+		beforeQuote := beforeEngine.Quote(state)
+		afterQuote := afterEngine.Quote(state)
+		if beforeQuote.Price.Equal(afterQuote.Price) {
+			continue
+		}
+
+		pc := PriceChange{
+			TicketStockID: ts.ID,
+			Price:         afterQuote.Price,
+			Rule:          afterQuote.Rule,
+			EffectiveFrom: t,
+			CreatedAt:     now,
+		}
+		if err := u.priceChangeRepository.Save(ctx, pc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreatePriceRule implements PricingUseCase.
+func (u *pricingUseCase) CreatePriceRule(ctx context.Context, req CreatePriceRuleRequest) (PriceRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	now := time.Now()
+	pr, err := req.ToEntityPriceRule(ctx, u.location, now)
+	if err != nil {
+		return PriceRuleResponse{}, err
+	}
+
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ts, err := u.findTicketStock(ctx, req.EventID, req.ShowID, req.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		if pr.Price.Currency != ts.Price.Currency {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.PriceCurrency, ts.Price.Currency))
+		}
+
+		before, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.priceRuleRepository.Save(ctx, pr); err != nil {
+			return err
+		}
+
+		after, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		return u.recordChanges(ctx, ts, before, after, pr, now)
+	})
+	if err != nil {
+		return PriceRuleResponse{}, err
+	}
+
+	resp := PriceRuleResponse{}
+	resp.PopulateFromEntity(pr)
+
+	return resp, nil
+}
+
+// GetManyPriceRule implements PricingUseCase.
+func (u *pricingUseCase) GetManyPriceRule(ctx context.Context, req GetManyPriceRuleRequest) (GetManyPriceRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	ts, err := u.findTicketStock(ctx, req.EventID, req.ShowID, req.TicketStockID)
+	if err != nil {
+		return GetManyPriceRuleResponse{}, err
+	}
+
+	rules, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+	if err != nil {
+		return GetManyPriceRuleResponse{}, err
+	}
+
+	changes, err := u.priceChangeRepository.FindManyByTicketStockID(ctx, ts.ID)
+	if err != nil {
+		return GetManyPriceRuleResponse{}, err
+	}
+
+	quote := NewPricingEngine(rules).Quote(pricing.State{Now: time.Now(), BasePrice: ts.Price, Allocation: ts.Allocation, Sold: ts.Acquired})
+
+	resp := GetManyPriceRuleResponse{
+		TicketStockID: ts.ID,
+		BasePrice:     ts.Price,
+		CurrentPrice:  quote.Price,
+		PriceRules:    make([]PriceRuleResponse, len(rules)),
+		PriceChanges:  make([]PriceChangeResponse, len(changes)),
+	}
+
+	for k, v := range rules {
+		resp.PriceRules[k].PopulateFromEntity(v)
+	}
+
+	for k, v := range changes {
+		resp.PriceChanges[k] = PriceChangeResponse{
+			Price:         v.Price,
+			Rule:          v.Rule,
+			EffectiveFrom: v.EffectiveFrom,
+			CreatedAt:     v.CreatedAt,
+		}
+	}
+
+	return resp, nil
+}
+
+// DeletePriceRule implements PricingUseCase.
+func (u *pricingUseCase) DeletePriceRule(ctx context.Context, req DeletePriceRuleRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	now := time.Now()
+
+	return u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ts, err := u.findTicketStock(ctx, req.EventID, req.ShowID, req.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		pr, err := u.priceRuleRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if pr.TicketStockID != ts.ID {
+			return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket price rule"), req.ID))
+		}
+
+		before, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.priceRuleRepository.Delete(ctx, pr.ID); err != nil {
+			return err
+		}
+
+		after, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		return u.recordChanges(ctx, ts, before, after, pr, now)
+	})
+}
@@ -0,0 +1,40 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	// PromoCodeTypeDiscount grants a discount on the tickets.
+	PromoCodeTypeDiscount string = "DISCOUNT"
+	// PromoCodeTypeAccess grants an early access to the tickets before the sale opens, it may grant a discount as well.
+	PromoCodeTypeAccess string = "ACCESS"
+
+	PromoCodeStatusActive   string = "ACTIVE"
+	PromoCodeStatusInactive string = "INACTIVE"
+)
+
+// PromoCode is a code of an event, it can be restricted to a show and/or a tier.
+type PromoCode struct {
+	ID                 string
+	EventID            string
+	ShowID             *string
+	Tier               *string
+	Code               string
+	Type               string
+	DiscountPercentage *float64
+	DiscountAmount     *money.Money
+	UsageLimit         *int64
+	PerCustomerLimit   *int64
+	StartsAt           time.Time
+	EndsAt             *time.Time
+	Status             string
+	Redeemed           int64
+	// DiscountMismatches is the number of the confirmed redemptions whose order paid another discount than the
+	// reserved one, which are confirmed nonetheless since the orders have already been paid.
+	DiscountMismatches int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
@@ -0,0 +1,179 @@
+package promo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	PromoUseCase      PromoUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, promoUseCase PromoUseCase) {
+	handler := &HTTPHandler{
+		Validate:     validate,
+		PromoUseCase: promoUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/promo-codes", publicMiddleware.SetRouteChain(handler.CreatePromoCode, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/promo-codes", publicMiddleware.SetRouteChain(handler.GetManyPromoCode, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/promo-codes/{promoCodeID}", publicMiddleware.SetRouteChain(handler.GetPromoCode, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/promo-codes/{promoCodeID}", publicMiddleware.SetRouteChain(handler.DeactivatePromoCode, adminSession.Verify)).Methods(http.MethodDelete)
+}
+
+func (handler HTTPHandler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := CreatePromoCodeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.PromoUseCase.CreatePromoCode(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("promo code"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyPromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyPromoCodeRequest{
+		EventID: vars["eventID"],
+	}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.PromoUseCase.GetManyPromoCode(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("promo code")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetPromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetPromoCodeRequest{
+		EventID: vars["eventID"],
+		ID:      vars["promoCodeID"],
+	}
+
+	resp, err := handler.PromoUseCase.GetPromoCode(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("promo code")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) DeactivatePromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := DeactivatePromoCodeRequest{
+		EventID: vars["eventID"],
+		ID:      vars["promoCodeID"],
+	}
+
+	resp, err := handler.PromoUseCase.DeactivatePromoCode(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("promo code"), i18n.Noun("deactivated")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,249 @@
+package promo
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PromoCodeRepository interface {
+	Save(ctx context.Context, pc PromoCode) error
+	FindByID(ctx context.Context, ID string) (PromoCode, error)
+	FindByCode(ctx context.Context, eventID, code string) (PromoCode, error)
+	FindManyByEventID(ctx context.Context, eventID string, offset, limit int) ([]PromoCode, error)
+	CountByEventID(ctx context.Context, eventID string) (int64, error)
+	Update(ctx context.Context, ID string, pc PromoCode) error
+}
+
+type promoCodeRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPromoCodeRepository(logger *logrus.Logger, db *pgxpool.Pool) PromoCodeRepository {
+	return &promoCodeRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// promoCodeColumns selects a promo code along with the number of tickets which have been redeemed with it and the
+// number of its confirmed redemptions whose order paid another discount than the reserved one.
+const promoCodeColumns = `
+	p.id, p.event_id, p.show_id, p.tier, p.code, p.type, p.discount_percentage, p.discount_amount, p.currency,
+	p.usage_limit, p.per_customer_limit, p.starts_at, p.ends_at, p.status, p.created_at, p.updated_at,
+	(
+		SELECT COALESCE(SUM(r.quantity), 0) FROM promo_redemption r WHERE r.promo_code_id = p.id AND r.status = 'CONFIRMED'
+	) AS redeemed,
+	(
+		SELECT count(*) FROM promo_redemption r
+		WHERE r.promo_code_id = p.id AND r.status = 'CONFIRMED' AND r.paid_discount IS DISTINCT FROM r.discount
+	) AS discount_mismatches
+`
+
+func (r *promoCodeRepository) scan(row interface{ Scan(dest ...any) error }) (PromoCode, error) {
+	var pc PromoCode
+	var showID, tier, currency sql.NullString
+	var discountPercentage sql.NullFloat64
+	var discountAmount decimal.NullDecimal
+	var usageLimit, perCustomerLimit sql.NullInt64
+	var endsAt sql.NullTime
+
+	err := row.Scan(
+		&pc.ID, &pc.EventID, &showID, &tier, &pc.Code, &pc.Type, &discountPercentage, &discountAmount, &currency,
+		&usageLimit, &perCustomerLimit, &pc.StartsAt, &endsAt, &pc.Status, &pc.CreatedAt, &pc.UpdatedAt, &pc.Redeemed,
+		&pc.DiscountMismatches,
+	)
+	if err != nil {
+		return PromoCode{}, err
+	}
+
+	if showID.Valid {
+		pc.ShowID = &showID.String
+	}
+	if tier.Valid {
+		pc.Tier = &tier.String
+	}
+	if discountPercentage.Valid {
+		pc.DiscountPercentage = &discountPercentage.Float64
+	}
+	if discountAmount.Valid {
+		amount := money.New(discountAmount.Decimal, currency.String)
+		pc.DiscountAmount = &amount
+	}
+	if usageLimit.Valid {
+		pc.UsageLimit = &usageLimit.Int64
+	}
+	if perCustomerLimit.Valid {
+		pc.PerCustomerLimit = &perCustomerLimit.Int64
+	}
+	if endsAt.Valid {
+		pc.EndsAt = &endsAt.Time
+	}
+
+	return pc, nil
+}
+
+// Save implements PromoCodeRepository.
+func (r *promoCodeRepository) Save(ctx context.Context, pc PromoCode) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO promo_code
+		(
+			id, event_id, show_id, tier, code, type, discount_percentage, discount_amount, currency,
+			usage_limit, per_customer_limit, starts_at, ends_at, status, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+		)
+	`
+
+	var discountAmount decimal.NullDecimal
+	var currency sql.NullString
+	if pc.DiscountAmount != nil {
+		discountAmount = decimal.NewNullDecimal(pc.DiscountAmount.Amount)
+		currency = sql.NullString{String: pc.DiscountAmount.Currency, Valid: true}
+	}
+
+	_, err := cmd.ExecContext(ctx, query,
+		pc.ID, pc.EventID, pc.ShowID, pc.Tier, pc.Code, pc.Type, pc.DiscountPercentage, discountAmount, currency,
+		pc.UsageLimit, pc.PerCustomerLimit, pc.StartsAt, pc.EndsAt, pc.Status, pc.CreatedAt, pc.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving promo code's prorperties")
+	}
+
+	return nil
+}
+
+// FindByID implements PromoCodeRepository.
+func (r *promoCodeRepository) FindByID(ctx context.Context, ID string) (PromoCode, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT ` + promoCodeColumns + `
+		FROM promo_code p
+		WHERE
+			p.id = $1
+		LIMIT 1
+	`
+
+	pc, err := r.scan(cmd.QueryRowContext(ctx, query, ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PromoCode{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("promo code"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PromoCode{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting promo code's prorperties")
+	}
+
+	return pc, nil
+}
+
+// FindByCode implements PromoCodeRepository.
+func (r *promoCodeRepository) FindByCode(ctx context.Context, eventID, code string) (PromoCode, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT ` + promoCodeColumns + `
+		FROM promo_code p
+		WHERE
+			p.event_id = $1
+			AND p.code = $2
+		LIMIT 1
+	`
+
+	pc, err := r.scan(cmd.QueryRowContext(ctx, query, eventID, code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PromoCode{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByCode, i18n.Noun("promo code"), code))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PromoCode{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting promo code's prorperties")
+	}
+
+	return pc, nil
+}
+
+// FindManyByEventID implements PromoCodeRepository.
+func (r *promoCodeRepository) FindManyByEventID(ctx context.Context, eventID string, offset, limit int) ([]PromoCode, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT ` + promoCodeColumns + `
+		FROM promo_code p
+		WHERE
+			p.event_id = $1
+		ORDER BY p.created_at DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, eventID, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of promo code's prorperties")
+	}
+	defer rows.Close()
+
+	var data = make([]PromoCode, 0)
+	for rows.Next() {
+		pc, err := r.scan(rows)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of promo code's prorperties")
+		}
+
+		data = append(data, pc)
+	}
+
+	return data, nil
+}
+
+// CountByEventID implements PromoCodeRepository.
+func (r *promoCodeRepository) CountByEventID(ctx context.Context, eventID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM promo_code WHERE event_id = $1`
+
+	var count int64
+	if err := cmd.QueryRowContext(ctx, query, eventID).Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of promo code's prorperties")
+	}
+
+	return count, nil
+}
+
+// Update implements PromoCodeRepository.
+func (r *promoCodeRepository) Update(ctx context.Context, ID string, pc PromoCode) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE promo_code
+		SET
+			status = $1,
+			updated_at = $2
+		WHERE id = $3
+	`
+
+	_, err := cmd.ExecContext(ctx, query, pc.Status, pc.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating promo code's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package promo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreatePromoCodeRequest struct {
+	EventID            string       `json:"-"`
+	Code               string       `json:"code" validate:"required,alphanum,min=4,max=32"`
+	Type               string       `json:"type" validate:"oneof=DISCOUNT ACCESS"`
+	ShowID             string       `json:"show_id" validate:"-"`
+	Tier               string       `json:"tier" validate:"omitempty,oneof=WOOD BRONZE SILVER GOLD ONLINE"`
+	DiscountPercentage *float64     `json:"discount_percentage" validate:"omitempty,gt=0,lte=100"`
+	DiscountAmount     *money.Money `json:"discount_amount" validate:"omitempty,positive_money"`
+	UsageLimit         *int64       `json:"usage_limit" validate:"omitempty,gt=0"`
+	PerCustomerLimit   *int64       `json:"per_customer_limit" validate:"omitempty,gt=0"`
+	StartsAt           string       `json:"starts_at" validate:"datetime=2006-01-02 15:04:05"`
+	EndsAt             string       `json:"ends_at" validate:"omitempty,datetime=2006-01-02 15:04:05"`
+}
+
+func (r CreatePromoCodeRequest) ToEntityPromoCode(ctx context.Context, location *time.Location, now time.Time) (PromoCode, error) {
+	if r.DiscountPercentage != nil && r.DiscountAmount != nil {
+		return PromoCode{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.DiscountExclusive))
+	}
+
+	if r.Type == PromoCodeTypeDiscount && r.DiscountPercentage == nil && r.DiscountAmount == nil {
+		return PromoCode{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.DiscountRequired))
+	}
+
+	pc := PromoCode{
+		ID:                 util.GenerateTimestampWithPrefix("PROMO"),
+		EventID:            r.EventID,
+		Code:               strings.ToUpper(r.Code),
+		Type:               r.Type,
+		DiscountPercentage: r.DiscountPercentage,
+		DiscountAmount:     r.DiscountAmount,
+		UsageLimit:         r.UsageLimit,
+		PerCustomerLimit:   r.PerCustomerLimit,
+		Status:             PromoCodeStatusActive,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if r.ShowID != "" {
+		pc.ShowID = &r.ShowID
+	}
+	if r.Tier != "" {
+		pc.Tier = &r.Tier
+	}
+
+	startsAt, err := time.ParseInLocation("2006-01-02 15:04:05", r.StartsAt, location)
+	if err != nil {
+		return PromoCode{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "starts_at", r.StartsAt))
+	}
+	pc.StartsAt = startsAt
+
+	if r.EndsAt != "" {
+		endsAt, err := time.ParseInLocation("2006-01-02 15:04:05", r.EndsAt, location)
+		if err != nil {
+			return PromoCode{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "ends_at", r.EndsAt))
+		}
+
+		if !endsAt.After(startsAt) {
+			return PromoCode{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MustBeAfter, "ends_at", "starts_at"))
+		}
+		pc.EndsAt = &endsAt
+	}
+
+	return pc, nil
+}
+
+type GetManyPromoCodeRequest struct {
+	EventID string
+	Page    int `validate:"required"`
+	Size    int `validate:"required"`
+}
+
+type GetPromoCodeRequest struct {
+	EventID string
+	ID      string
+}
+
+type DeactivatePromoCodeRequest struct {
+	EventID string
+	ID      string
+}
@@ -0,0 +1,52 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type PromoCodeResponse struct {
+	ID                 string       `json:"id"`
+	EventID            string       `json:"event_id"`
+	ShowID             *string      `json:"show_id"`
+	Tier               *string      `json:"tier"`
+	Code               string       `json:"code"`
+	Type               string       `json:"type"`
+	DiscountPercentage *float64     `json:"discount_percentage"`
+	DiscountAmount     *money.Money `json:"discount_amount"`
+	UsageLimit         *int64       `json:"usage_limit"`
+	PerCustomerLimit   *int64       `json:"per_customer_limit"`
+	StartsAt           time.Time    `json:"starts_at"`
+	EndsAt             *time.Time   `json:"ends_at"`
+	Status             string       `json:"status"`
+	Redeemed           int64        `json:"redeemed"`
+	DiscountMismatches int64        `json:"discount_mismatches"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+}
+
+func (r *PromoCodeResponse) PopulateFromEntity(pc PromoCode) {
+	r.ID = pc.ID
+	r.EventID = pc.EventID
+	r.ShowID = pc.ShowID
+	r.Tier = pc.Tier
+	r.Code = pc.Code
+	r.Type = pc.Type
+	r.DiscountPercentage = pc.DiscountPercentage
+	r.DiscountAmount = pc.DiscountAmount
+	r.UsageLimit = pc.UsageLimit
+	r.PerCustomerLimit = pc.PerCustomerLimit
+	r.StartsAt = pc.StartsAt
+	r.EndsAt = pc.EndsAt
+	r.Status = pc.Status
+	r.Redeemed = pc.Redeemed
+	r.DiscountMismatches = pc.DiscountMismatches
+	r.CreatedAt = pc.CreatedAt
+	r.UpdatedAt = pc.UpdatedAt
+}
+
+type GetManyPromoCodeResponse struct {
+	Total      int64               `json:"total"`
+	PromoCodes []PromoCodeResponse `json:"promo_codes"`
+}
@@ -0,0 +1,196 @@
+package promo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/event"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type PromoUseCase interface {
+	CreatePromoCode(ctx context.Context, req CreatePromoCodeRequest) (PromoCodeResponse, error)
+	GetManyPromoCode(ctx context.Context, req GetManyPromoCodeRequest) (GetManyPromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, req GetPromoCodeRequest) (PromoCodeResponse, error)
+	DeactivatePromoCode(ctx context.Context, req DeactivatePromoCodeRequest) (PromoCodeResponse, error)
+}
+
+type promoUseCase struct {
+	logger              *logrus.Logger
+	location            *time.Location
+	timeout             time.Duration
+	promoCodeRepository PromoCodeRepository
+	eventRepository     event.EventRepository
+	showRepository      event.ShowRepository
+}
+
+type PromoUseCaseProperty struct {
+	Logger              *logrus.Logger
+	Location            *time.Location
+	Timeout             time.Duration
+	PromoCodeRepository PromoCodeRepository
+	EventRepository     event.EventRepository
+	ShowRepository      event.ShowRepository
+}
+
+func NewPromoUseCase(props PromoUseCaseProperty) PromoUseCase {
+	return &promoUseCase{
+		logger:              props.Logger,
+		location:            props.Location,
+		timeout:             props.Timeout,
+		promoCodeRepository: props.PromoCodeRepository,
+		eventRepository:     props.EventRepository,
+		showRepository:      props.ShowRepository,
+	}
+}
+
+// CreatePromoCode implements PromoUseCase. The code is unique within its event.
+func (u *promoUseCase) CreatePromoCode(ctx context.Context, req CreatePromoCodeRequest) (PromoCodeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	pc, err := req.ToEntityPromoCode(ctx, u.location, time.Now())
+	if err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	e, err := u.eventRepository.FindByID(ctx, pc.EventID)
+	if err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	if pc.ShowID != nil {
+		s, err := u.showRepository.FindByID(ctx, *pc.ShowID)
+		if err != nil {
+			return PromoCodeResponse{}, err
+		}
+
+		if s.EventID != e.ID {
+			return PromoCodeResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), *pc.ShowID))
+		}
+	}
+
+	if pc.DiscountAmount != nil && pc.DiscountAmount.Currency != e.Currency {
+		return PromoCodeResponse{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.DiscountCurrency, e.Currency))
+	}
+
+	_, err = u.promoCodeRepository.FindByCode(ctx, e.ID, pc.Code)
+	if err == nil {
+		return PromoCodeResponse{}, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.PromoCodeExists, pc.Code))
+	}
+	if !errors.MatchStatus(err, status.NOT_FOUND) {
+		return PromoCodeResponse{}, err
+	}
+
+	if err := u.promoCodeRepository.Save(ctx, pc); err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	resp := PromoCodeResponse{}
+	resp.PopulateFromEntity(pc)
+
+	return resp, nil
+}
+
+// GetManyPromoCode implements PromoUseCase.
+func (u *promoUseCase) GetManyPromoCode(ctx context.Context, req GetManyPromoCodeRequest) (GetManyPromoCodeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var promoCodes []PromoCode
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.promoCodeRepository.CountByEventID(gctx, req.EventID)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.promoCodeRepository.FindManyByEventID(gctx, req.EventID, offset, limit)
+		if err != nil {
+			return err
+		}
+		promoCodes = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyPromoCodeResponse{}, err
+	}
+
+	resp := GetManyPromoCodeResponse{
+		Total:      total,
+		PromoCodes: make([]PromoCodeResponse, len(promoCodes)),
+	}
+
+	for k, v := range promoCodes {
+		resp.PromoCodes[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+func (u *promoUseCase) findPromoCode(ctx context.Context, eventID, ID string) (PromoCode, error) {
+	pc, err := u.promoCodeRepository.FindByID(ctx, ID)
+	if err != nil {
+		return PromoCode{}, err
+	}
+
+	if pc.EventID != eventID {
+		return PromoCode{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("promo code"), ID))
+	}
+
+	return pc, nil
+}
+
+// GetPromoCode implements PromoUseCase.
+func (u *promoUseCase) GetPromoCode(ctx context.Context, req GetPromoCodeRequest) (PromoCodeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	pc, err := u.findPromoCode(ctx, req.EventID, req.ID)
+	if err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	resp := PromoCodeResponse{}
+	resp.PopulateFromEntity(pc)
+
+	return resp, nil
+}
+
+// DeactivatePromoCode implements PromoUseCase. The code is kept for the reconciliation of its redemptions, it can
+// not be redeemed anymore.
+func (u *promoUseCase) DeactivatePromoCode(ctx context.Context, req DeactivatePromoCodeRequest) (PromoCodeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	pc, err := u.findPromoCode(ctx, req.EventID, req.ID)
+	if err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	pc.Status = PromoCodeStatusInactive
+	pc.UpdatedAt = time.Now()
+
+	if err := u.promoCodeRepository.Update(ctx, pc.ID, pc); err != nil {
+		return PromoCodeResponse{}, err
+	}
+
+	resp := PromoCodeResponse{}
+	resp.PopulateFromEntity(pc)
+
+	return resp, nil
+}
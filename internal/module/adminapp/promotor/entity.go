@@ -0,0 +1,34 @@
+package promotor
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+type Promotor struct {
+	ID        string
+	Slug      string
+	Name      string
+	Email     string
+	Phone     string
+	Bio       string
+	ImageURL  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPromotor returns a new promotor which slug is derived from its name.
+func NewPromotor(ID, name, email, phone, bio, imageURL string, now time.Time) Promotor {
+	return Promotor{
+		ID:        ID,
+		Slug:      util.Slugify(name),
+		Name:      name,
+		Email:     email,
+		Phone:     phone,
+		Bio:       bio,
+		ImageURL:  imageURL,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,285 @@
+package promotor
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PromotorRepository interface {
+	Save(ctx context.Context, p Promotor) error
+	FindByID(ctx context.Context, ID string) (Promotor, error)
+	FindBySlug(ctx context.Context, slug string) (Promotor, error)
+	FindMany(ctx context.Context, offset, limit int) ([]Promotor, error)
+	Count(ctx context.Context) (int64, error)
+	CountEvents(ctx context.Context, ID string) (int64, error)
+	Update(ctx context.Context, ID string, p Promotor) error
+	Delete(ctx context.Context, ID string) error
+	Merge(ctx context.Context, ID string, duplicateIDs []string) error
+}
+
+type promotorRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPromotorRepository(logger *logrus.Logger, db *pgxpool.Pool) PromotorRepository {
+	return &promotorRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *promotorRepository) findOne(ctx context.Context, query string, arg string) (Promotor, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	row := cmd.QueryRowContext(ctx, query, arg)
+
+	var data Promotor
+	err := row.Scan(&data.ID, &data.Slug, &data.Name, &data.Email, &data.Phone, &data.Bio, &data.ImageURL, &data.CreatedAt, &data.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Promotor{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("promotor"), arg))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Promotor{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting promotor's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindByID implements PromotorRepository.
+func (r *promotorRepository) FindByID(ctx context.Context, ID string) (Promotor, error) {
+	query := `
+		SELECT
+			id, slug, name, email, phone, bio, image_url, created_at, updated_at
+		FROM promotor
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, ID)
+}
+
+// FindBySlug implements PromotorRepository.
+func (r *promotorRepository) FindBySlug(ctx context.Context, slug string) (Promotor, error) {
+	query := `
+		SELECT
+			id, slug, name, email, phone, bio, image_url, created_at, updated_at
+		FROM promotor
+		WHERE
+			slug = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, slug)
+}
+
+// FindMany implements PromotorRepository.
+func (r *promotorRepository) FindMany(ctx context.Context, offset int, limit int) ([]Promotor, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, slug, name, email, phone, bio, image_url, created_at, updated_at
+		FROM promotor
+		ORDER BY name ASC
+		OFFSET $1
+		LIMIT $2
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of promotor's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Promotor, 0)
+	for rows.Next() {
+		var p Promotor
+		err := rows.Scan(&p.ID, &p.Slug, &p.Name, &p.Email, &p.Phone, &p.Bio, &p.ImageURL, &p.CreatedAt, &p.UpdatedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of promotor's prorperties")
+		}
+
+		data = append(data, p)
+	}
+
+	return data, nil
+}
+
+// Count implements PromotorRepository.
+func (r *promotorRepository) Count(ctx context.Context) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM promotor`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of promotor's prorperties")
+	}
+
+	return count, nil
+}
+
+// CountEvents implements PromotorRepository.
+func (r *promotorRepository) CountEvents(ctx context.Context, ID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(event_id) FROM event_promotor WHERE promotor_id = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, ID)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting events of promotor")
+	}
+
+	return count, nil
+}
+
+// Save implements PromotorRepository.
+func (r *promotorRepository) Save(ctx context.Context, p Promotor) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO promotor
+		(
+			id, slug, name, email, phone, bio, image_url, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, p.ID, p.Slug, p.Name, p.Email, p.Phone, p.Bio, p.ImageURL, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving promotor's prorperties")
+	}
+
+	return nil
+}
+
+// Update implements PromotorRepository.
+func (r *promotorRepository) Update(ctx context.Context, ID string, p Promotor) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE promotor
+		SET
+			slug = $1,
+			name = $2,
+			email = $3,
+			phone = $4,
+			bio = $5,
+			image_url = $6,
+			updated_at = $7
+		WHERE id = $8
+	`
+
+	_, err := cmd.ExecContext(ctx, query, p.Slug, p.Name, p.Email, p.Phone, p.Bio, p.ImageURL, p.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating promotor's prorperties")
+	}
+
+	return nil
+}
+
+// Delete implements PromotorRepository.
+func (r *promotorRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM promotor WHERE id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting promotor's prorperties")
+	}
+
+	return nil
+}
+
+// Merge implements PromotorRepository. The events, the followers and the media of the duplicates are relinked to
+// the promotor with the given id, the ones which are already linked to it are not linked twice, then the duplicates are
+// deleted.
+func (r *promotorRepository) Merge(ctx context.Context, ID string, duplicateIDs []string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{
+			query: `
+				INSERT INTO event_promotor
+				(
+					event_id, promotor_id
+				)
+				SELECT DISTINCT d.event_id, $1
+				FROM event_promotor d
+				WHERE
+					d.promotor_id = ANY($2)
+					AND NOT EXISTS (
+						SELECT 1 FROM event_promotor t WHERE t.event_id = d.event_id AND t.promotor_id = $1
+					)
+			`,
+			args: []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM event_promotor WHERE promotor_id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+		{
+			query: `
+				INSERT INTO follow
+				(
+					customer_id, target_type, target_id, created_at
+				)
+				SELECT customer_id, target_type, $1, MIN(created_at)
+				FROM follow
+				WHERE target_type = 'PROMOTOR' AND target_id = ANY($2)
+				GROUP BY customer_id, target_type
+				ON CONFLICT (customer_id, target_type, target_id) DO NOTHING
+			`,
+			args: []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM follow WHERE target_type = 'PROMOTOR' AND target_id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+		{
+			query: `UPDATE media SET owner_id = $1 WHERE owner_type = 'PROMOTOR' AND owner_id = ANY($2)`,
+			args:  []interface{}{ID, duplicateIDs},
+		},
+		{
+			query: `DELETE FROM promotor WHERE id = ANY($1)`,
+			args:  []interface{}{duplicateIDs},
+		},
+	}
+
+	for _, v := range statements {
+		if _, err := cmd.ExecContext(ctx, v.query, v.args...); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while merging promotor's prorperties")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package promotor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreatePromotorRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Slug     string `json:"slug" validate:"omitempty,max=128"`
+	Email    string `json:"email" validate:"email"`
+	Phone    string `json:"phone" validate:"required"`
+	Bio      string `json:"bio" validate:"-"`
+	ImageURL string `json:"image_url" validate:"omitempty,url"`
+}
+
+func (r CreatePromotorRequest) ToEntityPromotor(ctx context.Context, now time.Time) (Promotor, error) {
+	p := NewPromotor(util.GenerateTimestampWithPrefix("PROMOTOR"), r.Name, r.Email, r.Phone, r.Bio, r.ImageURL, now)
+	if r.Slug != "" {
+		p.Slug = util.Slugify(r.Slug)
+	}
+
+	if p.Slug == "" {
+		return Promotor{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.NameOrSlugRequired, i18n.Noun("promotor")))
+	}
+
+	return p, nil
+}
+
+type UpdatePromotorRequest struct {
+	ID string `json:"-"`
+	CreatePromotorRequest
+}
+
+type GetManyPromotorRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
+
+type GetPromotorRequest struct {
+	ID string
+}
+
+type DeletePromotorRequest struct {
+	ID string
+}
+
+type MergePromotorRequest struct {
+	ID           string   `json:"-"`
+	DuplicateIDs []string `json:"duplicate_ids" validate:"required,min=1,unique,dive,required"`
+}
@@ -0,0 +1,32 @@
+package promotor
+
+import "time"
+
+type PromotorResponse struct {
+	ID        string    `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	Bio       string    `json:"bio"`
+	ImageURL  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r *PromotorResponse) PopulateFromEntity(p Promotor) {
+	r.ID = p.ID
+	r.Slug = p.Slug
+	r.Name = p.Name
+	r.Email = p.Email
+	r.Phone = p.Phone
+	r.Bio = p.Bio
+	r.ImageURL = p.ImageURL
+	r.CreatedAt = p.CreatedAt
+	r.UpdatedAt = p.UpdatedAt
+}
+
+type GetManyPromotorResponse struct {
+	Total     int64              `json:"total"`
+	Promotors []PromotorResponse `json:"promotors"`
+}
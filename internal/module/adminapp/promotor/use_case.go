@@ -0,0 +1,239 @@
+package promotor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type PromotorUseCase interface {
+	CreatePromotor(ctx context.Context, req CreatePromotorRequest) (PromotorResponse, error)
+	GetManyPromotor(ctx context.Context, req GetManyPromotorRequest) (GetManyPromotorResponse, error)
+	GetPromotor(ctx context.Context, req GetPromotorRequest) (PromotorResponse, error)
+	UpdatePromotor(ctx context.Context, req UpdatePromotorRequest) (PromotorResponse, error)
+	DeletePromotor(ctx context.Context, req DeletePromotorRequest) error
+	MergePromotor(ctx context.Context, req MergePromotorRequest) (PromotorResponse, error)
+}
+
+type promotorUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	promotorRepository PromotorRepository
+	txManager          postgresql.TxManager
+}
+
+type PromotorUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	PromotorRepository PromotorRepository
+	TxManager          postgresql.TxManager
+}
+
+func NewPromotorUseCase(props PromotorUseCaseProperty) PromotorUseCase {
+	return &promotorUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		promotorRepository: props.PromotorRepository,
+		txManager:          props.TxManager,
+	}
+}
+
+// ensureUniqueSlug returns conflict if the slug is already taken by another promotor.
+func (u *promotorUseCase) ensureUniqueSlug(ctx context.Context, p Promotor) error {
+	existing, err := u.promotorRepository.FindBySlug(ctx, p.Slug)
+	if err != nil {
+		if errors.MatchStatus(err, status.NOT_FOUND) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != p.ID {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.SlugUsed, i18n.Noun("promotor"), p.Slug, i18n.Noun("promotor"), existing.ID))
+	}
+
+	return nil
+}
+
+// CreatePromotor implements PromotorUseCase.
+func (u *promotorUseCase) CreatePromotor(ctx context.Context, req CreatePromotorRequest) (PromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	p, err := req.ToEntityPromotor(ctx, time.Now())
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+
+	if err := u.ensureUniqueSlug(ctx, p); err != nil {
+		return PromotorResponse{}, err
+	}
+
+	if err := u.promotorRepository.Save(ctx, p); err != nil {
+		return PromotorResponse{}, err
+	}
+
+	resp := PromotorResponse{}
+	resp.PopulateFromEntity(p)
+
+	return resp, nil
+}
+
+// GetManyPromotor implements PromotorUseCase.
+func (u *promotorUseCase) GetManyPromotor(ctx context.Context, req GetManyPromotorRequest) (GetManyPromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var promotors []Promotor
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.promotorRepository.Count(gctx)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.promotorRepository.FindMany(gctx, offset, limit)
+		if err != nil {
+			return err
+		}
+		promotors = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyPromotorResponse{}, err
+	}
+
+	resp := GetManyPromotorResponse{
+		Total:     total,
+		Promotors: make([]PromotorResponse, len(promotors)),
+	}
+
+	for k, v := range promotors {
+		resp.Promotors[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+// GetPromotor implements PromotorUseCase.
+func (u *promotorUseCase) GetPromotor(ctx context.Context, req GetPromotorRequest) (PromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	p, err := u.promotorRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+
+	resp := PromotorResponse{}
+	resp.PopulateFromEntity(p)
+
+	return resp, nil
+}
+
+// UpdatePromotor implements PromotorUseCase.
+func (u *promotorUseCase) UpdatePromotor(ctx context.Context, req UpdatePromotorRequest) (PromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	current, err := u.promotorRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+
+	p, err := req.ToEntityPromotor(ctx, time.Now())
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+	p.ID = current.ID
+	p.CreatedAt = current.CreatedAt
+
+	if err := u.ensureUniqueSlug(ctx, p); err != nil {
+		return PromotorResponse{}, err
+	}
+
+	if err := u.promotorRepository.Update(ctx, p.ID, p); err != nil {
+		return PromotorResponse{}, err
+	}
+
+	resp := PromotorResponse{}
+	resp.PopulateFromEntity(p)
+
+	return resp, nil
+}
+
+// DeletePromotor implements PromotorUseCase. An promotor which is still linked to an event can not be deleted.
+func (u *promotorUseCase) DeletePromotor(ctx context.Context, req DeletePromotorRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	p, err := u.promotorRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	count, err := u.promotorRepository.CountEvents(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.StillLinked, i18n.Noun("promotor"), p.ID, count))
+	}
+
+	return u.promotorRepository.Delete(ctx, p.ID)
+}
+
+// MergePromotor implements PromotorUseCase. The duplicates are folded into the promotor of the request.
+func (u *promotorUseCase) MergePromotor(ctx context.Context, req MergePromotorRequest) (PromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var p Promotor
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		p, err = u.promotorRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, duplicateID := range req.DuplicateIDs {
+			if duplicateID == p.ID {
+				return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MergeIntoItself, i18n.Noun("promotor"), p.ID))
+			}
+
+			if _, err := u.promotorRepository.FindByID(ctx, duplicateID); err != nil {
+				return err
+			}
+		}
+
+		return u.promotorRepository.Merge(ctx, p.ID, req.DuplicateIDs)
+	})
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+
+	resp := PromotorResponse{}
+	resp.PopulateFromEntity(p)
+
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	// SalesChannelLive sells the tickets of a show at its venue, SalesChannelOnline sells the tickets of the online
+	// show which streams it.
+	SalesChannelLive   string = "LIVE"
+	SalesChannelOnline string = "ONLINE"
+
+	SalesIntervalHour string = "HOUR"
+	SalesIntervalDay  string = "DAY"
+
+	SalesReportFormatJSON string = "JSON"
+	SalesReportFormatCSV  string = "CSV"
+
+	SalesReportSectionTiers       string = "TIERS"
+	SalesReportSectionChannels    string = "CHANNELS"
+	SalesReportSectionSellThrough string = "SELL_THROUGH"
+	SalesReportSectionCities      string = "CITIES"
+)
+
+// SalesFilter narrows the sales of a report down, a field which is left empty does not filter. The dates bound the
+// time the tickets are acquired at, the end date is exclusive.
+type SalesFilter struct {
+	EventID    string
+	ShowID     string
+	PromotorID string
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
+
+// TierSales holds the tickets of a ticket stock which are acquired within the period of a report. The stock itself
+// is the current one regardless of the period.
+type TierSales struct {
+	EventID      string
+	EventName    string
+	ShowVenue    string
+	ShowTime     time.Time
+	TicketStock  ticket.TicketStock
+	Sold         int64
+	Comps        int64
+	GrossRevenue money.Money
+}
+
+// Channel tells whether the tickets are sold for the show itself or for the online show which streams it.
+func (ts TierSales) Channel() string {
+	if ts.TicketStock.OnlineFor != nil {
+		return SalesChannelOnline
+	}
+
+	return SalesChannelLive
+}
+
+// SalesBucket holds the tickets which are sold within an hour or a day, the cumulative number includes the ones
+// which are sold before the period of the report.
+type SalesBucket struct {
+	Time       time.Time
+	Sold       int64
+	Cumulative int64
+}
+
+type CitySales struct {
+	Country string
+	City    string
+	Sold    int64
+}
@@ -0,0 +1,119 @@
+package report
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// defaultTopCities is the number of the top cities of a report unless it is given.
+const defaultTopCities = 10
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	ReportUseCase     ReportUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, reportUseCase ReportUseCase) {
+	handler := &HTTPHandler{
+		Validate:      validate,
+		ReportUseCase: reportUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/reports/sales", publicMiddleware.SetRouteChain(handler.GetSalesReport, adminSession.Verify)).Methods(http.MethodGet)
+}
+
+// GetSalesReport returns the sales report as json, or a section of it as a csv by "format=CSV".
+func (handler HTTPHandler) GetSalesReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	qs := r.URL.Query()
+
+	req := GetSalesReportRequest{
+		EventID:    qs.Get("event_id"),
+		ShowID:     qs.Get("show_id"),
+		PromotorID: qs.Get("promotor_id"),
+		StartDate:  qs.Get("start_date"),
+		EndDate:    qs.Get("end_date"),
+		Interval:   strings.ToUpper(qs.Get("interval")),
+		TopCities:  defaultTopCities,
+		Format:     strings.ToUpper(qs.Get("format")),
+		Section:    strings.ToUpper(qs.Get("section")),
+	}
+	if req.Interval == "" {
+		req.Interval = SalesIntervalDay
+	}
+	if req.Format == "" {
+		req.Format = SalesReportFormatJSON
+	}
+	if v := qs.Get("top_cities"); v != "" {
+		req.TopCities, _ = strconv.Atoi(v)
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	if req.Format == SalesReportFormatCSV {
+		content, err := handler.ReportUseCase.ExportSalesReport(ctx, req)
+		if err != nil {
+			ae := errors.Destruct(err)
+			response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+				Status:  ae.Status,
+				Message: ae.Message,
+				Errors:  ae.Errors,
+			})
+
+			return
+		}
+
+		section := req.Section
+		if section == "" {
+			section = SalesReportSectionTiers
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"sales-%s.csv\"", strings.ReplaceAll(strings.ToLower(section), "_", "-")))
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+
+		return
+	}
+
+	resp, err := handler.ReportUseCase.GetSalesReport(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("sales report")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// GetSalesReportRequest asks for the sales of the events, the shows and the period of the filter. A csv holds one
+// section of the report.
+type GetSalesReportRequest struct {
+	EventID    string `validate:"-"`
+	ShowID     string `validate:"-"`
+	PromotorID string `validate:"-"`
+	StartDate  string `validate:"omitempty,datetime=2006-01-02 15:04:05"`
+	EndDate    string `validate:"omitempty,datetime=2006-01-02 15:04:05"`
+	Interval   string `validate:"oneof=HOUR DAY"`
+	TopCities  int    `validate:"gte=1,lte=100"`
+	Format     string `validate:"oneof=JSON CSV"`
+	Section    string `validate:"omitempty,oneof=TIERS CHANNELS SELL_THROUGH CITIES"`
+}
+
+func (r GetSalesReportRequest) ToSalesFilter(ctx context.Context, location *time.Location) (SalesFilter, error) {
+	filter := SalesFilter{
+		EventID:    r.EventID,
+		ShowID:     r.ShowID,
+		PromotorID: r.PromotorID,
+	}
+
+	if r.StartDate != "" {
+		startDate, err := time.ParseInLocation(time.DateTime, r.StartDate, location)
+		if err != nil {
+			return SalesFilter{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "start_date", r.StartDate))
+		}
+		filter.StartDate = &startDate
+	}
+
+	if r.EndDate != "" {
+		endDate, err := time.ParseInLocation(time.DateTime, r.EndDate, location)
+		if err != nil {
+			return SalesFilter{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidValue, "end_date", r.EndDate))
+		}
+		filter.EndDate = &endDate
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.EndDate.After(*filter.StartDate) {
+		return SalesFilter{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.MustBeAfter, "end_date", "start_date"))
+	}
+
+	return filter, nil
+}
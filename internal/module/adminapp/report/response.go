@@ -0,0 +1,212 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+// sellThrough returns the percentage of the allocation which is sold, it is rounded to two decimals.
+func sellThrough(sold, allocation int64) float64 {
+	if allocation < 1 {
+		return 0
+	}
+
+	return math.Round(float64(sold)/float64(allocation)*10000) / 100
+}
+
+type TierSalesResponse struct {
+	EventID       string      `json:"event_id"`
+	EventName     string      `json:"event_name"`
+	ShowID        string      `json:"show_id"`
+	ShowVenue     string      `json:"show_venue"`
+	ShowTime      time.Time   `json:"show_time"`
+	TicketStockID string      `json:"ticket_stock_id"`
+	Tier          string      `json:"tier"`
+	Channel       string      `json:"channel"`
+	Price         money.Money `json:"price"`
+	Allocation    int64       `json:"allocation"`
+	Sold          int64       `json:"sold"`
+	Comps         int64       `json:"comps"`
+	Remaining     int64       `json:"remaining"`
+	GrossRevenue  money.Money `json:"gross_revenue"`
+	SellThrough   float64     `json:"sell_through"`
+}
+
+func (r *TierSalesResponse) PopulateFromEntity(ts TierSales) {
+	r.EventID = ts.EventID
+	r.EventName = ts.EventName
+	r.ShowID = ts.TicketStock.ShowID
+	r.ShowVenue = ts.ShowVenue
+	r.ShowTime = ts.ShowTime
+	r.TicketStockID = ts.TicketStock.ID
+	r.Tier = ts.TicketStock.Tier
+	r.Channel = ts.Channel()
+	r.Price = ts.TicketStock.Price
+	r.Allocation = ts.TicketStock.Allocation
+	r.Sold = ts.Sold
+	r.Comps = ts.Comps
+	r.Remaining = ts.TicketStock.Available()
+	r.GrossRevenue = ts.GrossRevenue
+	r.SellThrough = sellThrough(ts.Sold, ts.TicketStock.Allocation)
+}
+
+// ChannelSalesResponse sums the tiers of a channel up, the tiers of another currency are summed up on their own.
+type ChannelSalesResponse struct {
+	Channel      string      `json:"channel"`
+	Allocation   int64       `json:"allocation"`
+	Sold         int64       `json:"sold"`
+	Comps        int64       `json:"comps"`
+	Remaining    int64       `json:"remaining"`
+	GrossRevenue money.Money `json:"gross_revenue"`
+	SellThrough  float64     `json:"sell_through"`
+}
+
+type SalesBucketResponse struct {
+	Time        time.Time `json:"time"`
+	Sold        int64     `json:"sold"`
+	Cumulative  int64     `json:"cumulative"`
+	SellThrough float64   `json:"sell_through"`
+}
+
+type CitySalesResponse struct {
+	Country string `json:"country"`
+	City    string `json:"city"`
+	Sold    int64  `json:"sold"`
+}
+
+type SalesReportResponse struct {
+	StartDate   *time.Time             `json:"start_date"`
+	EndDate     *time.Time             `json:"end_date"`
+	Interval    string                 `json:"interval"`
+	Allocation  int64                  `json:"allocation"`
+	Sold        int64                  `json:"sold"`
+	Comps       int64                  `json:"comps"`
+	Remaining   int64                  `json:"remaining"`
+	SellThrough float64                `json:"sell_through"`
+	Tiers       []TierSalesResponse    `json:"tiers"`
+	Channels    []ChannelSalesResponse `json:"channels"`
+	Buckets     []SalesBucketResponse  `json:"buckets"`
+	TopCities   []CitySalesResponse    `json:"top_cities"`
+}
+
+// PopulateFromEntity sums the tiers up by their channel and the whole report, the sell through of a bucket is the
+// share of the allocation of the tiers which is sold by its end.
+func (r *SalesReportResponse) PopulateFromEntity(tiers []TierSales, buckets []SalesBucket, cities []CitySales) {
+	r.Tiers = make([]TierSalesResponse, len(tiers))
+	r.Channels = make([]ChannelSalesResponse, 0)
+	channels := make(map[string]int)
+	for k, v := range tiers {
+		r.Tiers[k].PopulateFromEntity(v)
+
+		r.Allocation += v.TicketStock.Allocation
+		r.Sold += v.Sold
+		r.Comps += v.Comps
+		r.Remaining += v.TicketStock.Available()
+
+		key := v.Channel() + "/" + v.GrossRevenue.Currency
+		i, ok := channels[key]
+		if !ok {
+			i = len(r.Channels)
+			channels[key] = i
+			r.Channels = append(r.Channels, ChannelSalesResponse{
+				Channel:      v.Channel(),
+				GrossRevenue: money.Zero(v.GrossRevenue.Currency),
+			})
+		}
+
+		c := &r.Channels[i]
+		c.Allocation += v.TicketStock.Allocation
+		c.Sold += v.Sold
+		c.Comps += v.Comps
+		c.Remaining += v.TicketStock.Available()
+		// the tiers of a channel share its currency
+		c.GrossRevenue, _ = c.GrossRevenue.Add(v.GrossRevenue)
+	}
+	for k, v := range r.Channels {
+		r.Channels[k].SellThrough = sellThrough(v.Sold, v.Allocation)
+	}
+	r.SellThrough = sellThrough(r.Sold, r.Allocation)
+
+	r.Buckets = make([]SalesBucketResponse, len(buckets))
+	for k, v := range buckets {
+		r.Buckets[k] = SalesBucketResponse{
+			Time:        v.Time,
+			Sold:        v.Sold,
+			Cumulative:  v.Cumulative,
+			SellThrough: sellThrough(v.Cumulative, r.Allocation),
+		}
+	}
+
+	r.TopCities = make([]CitySalesResponse, len(cities))
+	for k, v := range cities {
+		r.TopCities[k] = CitySalesResponse{
+			Country: v.Country,
+			City:    v.City,
+			Sold:    v.Sold,
+		}
+	}
+}
+
+// CSV returns a section of the report as a csv.
+func (r SalesReportResponse) CSV(section string) ([]byte, error) {
+	format := func(n int64) string {
+		return strconv.FormatInt(n, 10)
+	}
+	percentage := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 2, 64)
+	}
+	// the currency has a column of its own
+	amount := func(m money.Money) string {
+		return m.Amount.StringFixed(m.Scale())
+	}
+
+	var records [][]string
+	switch section {
+	case SalesReportSectionTiers:
+		records = append(records, []string{
+			"event_id", "event_name", "show_id", "show_venue", "show_time", "ticket_stock_id", "tier", "channel", "price",
+			"currency", "allocation", "sold", "comps", "remaining", "gross_revenue", "sell_through",
+		})
+		for _, v := range r.Tiers {
+			records = append(records, []string{
+				v.EventID, v.EventName, v.ShowID, v.ShowVenue, v.ShowTime.Format(time.RFC3339), v.TicketStockID, v.Tier,
+				v.Channel, amount(v.Price), v.Price.Currency, format(v.Allocation), format(v.Sold), format(v.Comps),
+				format(v.Remaining), amount(v.GrossRevenue), percentage(v.SellThrough),
+			})
+		}
+	case SalesReportSectionChannels:
+		records = append(records, []string{"channel", "currency", "allocation", "sold", "comps", "remaining", "gross_revenue", "sell_through"})
+		for _, v := range r.Channels {
+			records = append(records, []string{
+				v.Channel, v.GrossRevenue.Currency, format(v.Allocation), format(v.Sold), format(v.Comps),
+				format(v.Remaining), amount(v.GrossRevenue), percentage(v.SellThrough),
+			})
+		}
+	case SalesReportSectionSellThrough:
+		records = append(records, []string{"time", "sold", "cumulative", "sell_through"})
+		for _, v := range r.Buckets {
+			records = append(records, []string{v.Time.Format(time.RFC3339), format(v.Sold), format(v.Cumulative), percentage(v.SellThrough)})
+		}
+	case SalesReportSectionCities:
+		records = append(records, []string{"country", "city", "sold"})
+		for _, v := range r.TopCities {
+			records = append(records, []string{v.Country, v.City, format(v.Sold)})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sales report section '%s'", section)
+	}
+
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,238 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// SalesRepository aggregates the ticket stocks and the acquired tickets. An acquired ticket stands for its quantity of
+// tickets at its unit price. A comp ticket, i.e. the one which is issued from a ticket hold, is not sold.
+type SalesRepository interface {
+	FindManyTierSales(ctx context.Context, filter SalesFilter) ([]TierSales, error)
+	FindManySalesBucket(ctx context.Context, filter SalesFilter, interval string, location *time.Location) ([]SalesBucket, error)
+	FindManyTopCitySales(ctx context.Context, filter SalesFilter, limit int) ([]CitySales, error)
+}
+
+type salesRepository struct {
+	logger     *logrus.Logger
+	db         *pgxpool.Pool
+	replicaSet *postgresql.ReplicaSet
+}
+
+// NewSalesRepository returns a repository whose aggregations run on the read replicas, the reports tolerate the
+// replication lag.
+func NewSalesRepository(logger *logrus.Logger, db *pgxpool.Pool, replicaSet *postgresql.ReplicaSet) SalesRepository {
+	return &salesRepository{
+		logger:     logger,
+		db:         db,
+		replicaSet: replicaSet,
+	}
+}
+
+// salesStockFilter narrows the ticket stocks down to the event ($1), the show ($2) and the promotor ($3) of the
+// filter.
+const salesStockFilter = `
+	($1::VARCHAR IS NULL OR ts.event_id = $1)
+	AND ($2::VARCHAR IS NULL OR ts.show_id = $2)
+	AND ($3::VARCHAR IS NULL OR EXISTS (
+		SELECT 1 FROM event_promotor ep WHERE ep.event_id = ts.event_id AND ep.promotor_id = $3
+	))
+`
+
+// salesPeriodFilter narrows the acquired tickets down to the start date ($4) and the end date ($5) of the filter.
+const salesPeriodFilter = `
+	($4::TIMESTAMPTZ IS NULL OR a.created_at >= $4)
+	AND ($5::TIMESTAMPTZ IS NULL OR a.created_at < $5)
+`
+
+func (f SalesFilter) args() []any {
+	nullString := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: s != ""}
+	}
+	nullTime := func(t *time.Time) sql.NullTime {
+		if t == nil {
+			return sql.NullTime{}
+		}
+		return sql.NullTime{Time: *t, Valid: true}
+	}
+
+	return []any{
+		nullString(f.EventID), nullString(f.ShowID), nullString(f.PromotorID), nullTime(f.StartDate), nullTime(f.EndDate),
+	}
+}
+
+// FindManyTierSales implements SalesRepository.
+func (r *salesRepository) FindManyTierSales(ctx context.Context, filter SalesFilter) ([]TierSales, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			ts.event_id, e.name, s.venue, s.time,
+			ts.id, ts.show_id, ts.online_for, ts.tier, ts.allocation, ts.price, ts.currency, ts.acquired, ts.held,
+			ts.reserved, ts.last_stock_update,
+			COALESCE(SUM(a.quantity) FILTER (WHERE a.ticket_hold_id IS NULL), 0)::BIGINT AS sold,
+			COALESCE(SUM(a.quantity) FILTER (WHERE a.ticket_hold_id IS NOT NULL), 0)::BIGINT AS comps,
+			COALESCE(SUM(a.price * a.quantity) FILTER (WHERE a.ticket_hold_id IS NULL), 0) AS gross_revenue
+		FROM ticket_stock ts
+		JOIN event e ON e.id = ts.event_id
+		JOIN event_show s ON s.id = ts.show_id
+		LEFT JOIN acquired_ticket a ON a.ticket_stock_id = ts.id AND a.returned_at IS NULL AND ` + salesPeriodFilter + `
+		WHERE ` + salesStockFilter + `
+		GROUP BY ts.id, e.name, s.venue, s.time
+		ORDER BY s.time, ts.show_id, ts.price DESC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, filter.args()...)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of tier sales' prorperties")
+	}
+	defer rows.Close()
+
+	var data = make([]TierSales, 0)
+	for rows.Next() {
+		var ts TierSales
+		var onlineFor sql.NullString
+		var price, grossRevenue decimal.Decimal
+		var currency string
+		err := rows.Scan(
+			&ts.EventID, &ts.EventName, &ts.ShowVenue, &ts.ShowTime,
+			&ts.TicketStock.ID, &ts.TicketStock.ShowID, &onlineFor, &ts.TicketStock.Tier, &ts.TicketStock.Allocation,
+			&price, &currency, &ts.TicketStock.Acquired, &ts.TicketStock.Held, &ts.TicketStock.Reserved,
+			&ts.TicketStock.LastStockUpdate, &ts.Sold, &ts.Comps, &grossRevenue,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of tier sales' prorperties")
+		}
+
+		ts.TicketStock.EventID = ts.EventID
+		if onlineFor.Valid {
+			ts.TicketStock.OnlineFor = &onlineFor.String
+		}
+		ts.TicketStock.Price = money.New(price, currency)
+		ts.GrossRevenue = money.New(grossRevenue, currency)
+
+		data = append(data, ts)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of tier sales' prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManySalesBucket implements SalesRepository. The tickets are bucketed by the hour or the day of the location,
+// the buckets without a ticket are left out.
+func (r *salesRepository) FindManySalesBucket(ctx context.Context, filter SalesFilter, interval string, location *time.Location) ([]SalesBucket, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	// the tickets which are sold before the start date are counted by the cumulative number of the first bucket
+	query := `
+		WITH sold AS (
+			SELECT
+				date_trunc($6::TEXT, a.created_at AT TIME ZONE $7::TEXT) AS bucket,
+				SUM(a.quantity) AS sold
+			FROM acquired_ticket a
+			JOIN ticket_stock ts ON ts.id = a.ticket_stock_id
+			WHERE
+				a.ticket_hold_id IS NULL
+				AND a.returned_at IS NULL
+				AND ($5::TIMESTAMPTZ IS NULL OR a.created_at < $5)
+				AND ` + salesStockFilter + `
+			GROUP BY bucket
+		), cumulative AS (
+			SELECT bucket, sold, SUM(sold) OVER (ORDER BY bucket) AS cumulative FROM sold
+		)
+		SELECT bucket AT TIME ZONE $7::TEXT, sold::BIGINT, cumulative::BIGINT
+		FROM cumulative
+		WHERE
+			$4::TIMESTAMPTZ IS NULL OR bucket >= date_trunc($6::TEXT, $4::TIMESTAMPTZ AT TIME ZONE $7::TEXT)
+		ORDER BY bucket
+	`
+
+	args := append(filter.args(), strings.ToLower(interval), location.String())
+	rows, err := cmd.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of sales bucket's prorperties")
+	}
+	defer rows.Close()
+
+	var data = make([]SalesBucket, 0)
+	for rows.Next() {
+		var b SalesBucket
+		if err := rows.Scan(&b.Time, &b.Sold, &b.Cumulative); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of sales bucket's prorperties")
+		}
+		b.Time = b.Time.In(location)
+
+		data = append(data, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of sales bucket's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManyTopCitySales implements SalesRepository. The cities are the ones of the shows, they are ordered by the
+// number of tickets which are sold for them.
+func (r *salesRepository) FindManyTopCitySales(ctx context.Context, filter SalesFilter, limit int) ([]CitySales, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT a.show_country, a.show_city, SUM(a.quantity)::BIGINT AS sold
+		FROM acquired_ticket a
+		JOIN ticket_stock ts ON ts.id = a.ticket_stock_id
+		WHERE
+			a.ticket_hold_id IS NULL
+			AND a.returned_at IS NULL
+			AND ` + salesStockFilter + `
+			AND ` + salesPeriodFilter + `
+		GROUP BY a.show_country, a.show_city
+		ORDER BY sold DESC, a.show_country, a.show_city
+		LIMIT $6
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, append(filter.args(), limit)...)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of city sales' prorperties")
+	}
+	defer rows.Close()
+
+	var data = make([]CitySales, 0)
+	for rows.Next() {
+		var c CitySales
+		if err := rows.Scan(&c.Country, &c.City, &c.Sold); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of city sales' prorperties")
+		}
+
+		data = append(data, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of city sales' prorperties")
+	}
+
+	return data, nil
+}
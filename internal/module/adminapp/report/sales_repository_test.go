@@ -0,0 +1,288 @@
+package report_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/report"
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+// openTestDatabase connects to the throwaway database of POSTGRESQL_TEST_DSN and applies the migrations to it, the
+// test is skipped when there is none.
+func openTestDatabase(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRESQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRESQL_TEST_DSN is not set")
+	}
+
+	db, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	require.NoError(t, err)
+
+	_, err = migration.NewMigrator(db, ms).Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestSalesRepositoryQuantity(t *testing.T) {
+	db := openTestDatabase(t)
+	ctx := context.Background()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := report.NewSalesRepository(logger, db, postgresql.NewReplicaSet())
+
+	now := time.Now().Truncate(time.Second)
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+	eventID, showID, ticketStockID := "EVTTEST"+suffix, "SHOWTEST"+suffix, "TSTEST"+suffix
+
+	_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2)`, eventID, now)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO event_show (event_id, id, venue, type, time, status, currency) VALUES ($1, $2, 'Test', 'LIVE', $3, 'ACTIVE', 'IDR')`, eventID, showID, now)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO ticket_stock (id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, show_id, event_id) VALUES ($1, 'CAT1', 10, 100000, 'IDR', 5, 0, 0, $2, $3, $4)`, ticketStockID, now, showID, eventID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM acquired_ticket WHERE ticket_stock_id = $1`, ticketStockID)
+		db.Exec(ctx, `DELETE FROM ticket_stock WHERE id = $1`, ticketStockID)
+		db.Exec(ctx, `DELETE FROM event_show WHERE id = $1`, showID)
+		db.Exec(ctx, `DELETE FROM event WHERE id = $1`, eventID)
+	})
+
+	// an order of 3 tickets without seats, an order of a seat and a comp ticket of a ticket hold
+	tickets := []struct {
+		orderID      string
+		quantity     int64
+		ticketHoldID *string
+	}{
+		{orderID: "ORDTEST1" + suffix, quantity: 3},
+		{orderID: "ORDTEST2" + suffix, quantity: 1},
+		{orderID: "", quantity: 1, ticketHoldID: &ticketStockID},
+	}
+	for k, v := range tickets {
+		_, err := db.Exec(ctx, `
+			INSERT INTO acquired_ticket
+			(
+				"number", event_id, show_id, tier, ticket_stock_id, ticket_hold_id, event_name, show_venue, show_type,
+				show_country, show_city, show_formatted_address, show_time, customer_name, customer_email, customer_id,
+				created_at, order_id, sequence, quantity, price, currency
+			)
+			VALUES
+			(
+				$1, $2, $3, 'CAT1', $4, $5, 'Test', 'Test', 'LIVE', 'Indonesia', 'Jakarta', '', $6, 'Test',
+				'test@example.com', 1, $6, $7, 1, $8, 100000, 'IDR'
+			)
+		`, fmt.Sprintf("TEST%d%s", k, suffix), eventID, showID, ticketStockID, v.ticketHoldID, now, v.orderID, v.quantity)
+		require.NoError(t, err)
+	}
+
+	filter := report.SalesFilter{EventID: eventID}
+
+	t.Run("the tickets sold and the gross revenue are the quantity of the tickets", func(t *testing.T) {
+		sales, err := repo.FindManyTierSales(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, sales, 1)
+
+		assert.Equal(t, int64(4), sales[0].Sold)
+		assert.Equal(t, int64(1), sales[0].Comps)
+		assert.True(t, money.MustParse("400000", "IDR").Equal(sales[0].GrossRevenue))
+	})
+
+	t.Run("the sell through is the quantity of the tickets", func(t *testing.T) {
+		buckets, err := repo.FindManySalesBucket(ctx, filter, report.SalesIntervalDay, time.UTC)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+
+		assert.Equal(t, int64(4), buckets[0].Sold)
+		assert.Equal(t, int64(4), buckets[0].Cumulative)
+	})
+
+	t.Run("the top cities are ordered by the quantity of the tickets", func(t *testing.T) {
+		cities, err := repo.FindManyTopCitySales(ctx, filter, 10)
+		require.NoError(t, err)
+		require.Len(t, cities, 1)
+
+		assert.Equal(t, "Jakarta", cities[0].City)
+		assert.Equal(t, int64(4), cities[0].Sold)
+	})
+}
+
+func TestSalesRepositoryFilter(t *testing.T) {
+	db := openTestDatabase(t)
+	ctx := context.Background()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := report.NewSalesRepository(logger, db, postgresql.NewReplicaSet())
+
+	now := time.Now().Truncate(time.Second)
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+	promotorID := "PROMTEST" + suffix
+	day := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	_, err := db.Exec(ctx, `INSERT INTO promotor (id, slug, name, email, phone, created_at, updated_at) VALUES ($1, $1, 'Test', 'test@example.com', '0811', $2, $2)`, promotorID, now)
+	require.NoError(t, err)
+
+	// the first event is of the promotor and has a show in Jakarta and a later one in Bandung, the second one is not
+	stocks := []struct {
+		eventID, showID, ticketStockID string
+		showTime                       time.Time
+	}{
+		{eventID: "EVTTEST1" + suffix, showID: "SHOWTEST1" + suffix, ticketStockID: "TSTEST1" + suffix, showTime: day.AddDate(0, 1, 0)},
+		{eventID: "EVTTEST1" + suffix, showID: "SHOWTEST2" + suffix, ticketStockID: "TSTEST2" + suffix, showTime: day.AddDate(0, 2, 0)},
+		{eventID: "EVTTEST2" + suffix, showID: "SHOWTEST3" + suffix, ticketStockID: "TSTEST3" + suffix, showTime: day.AddDate(0, 1, 0)},
+	}
+	for _, v := range stocks {
+		_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2) ON CONFLICT DO NOTHING`, v.eventID, now)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `INSERT INTO event_show (event_id, id, venue, type, time, status, currency) VALUES ($1, $2, 'Test', 'LIVE', $3, 'ACTIVE', 'IDR')`, v.eventID, v.showID, v.showTime)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, `INSERT INTO ticket_stock (id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, show_id, event_id) VALUES ($1, 'CAT1', 100, 100000, 'IDR', 0, 0, 0, $2, $3, $4)`, v.ticketStockID, now, v.showID, v.eventID)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(ctx, `INSERT INTO event_promotor (event_id, promotor_id) VALUES ($1, $2)`, "EVTTEST1"+suffix, promotorID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		for _, v := range stocks {
+			db.Exec(ctx, `DELETE FROM acquired_ticket WHERE ticket_stock_id = $1`, v.ticketStockID)
+			db.Exec(ctx, `DELETE FROM ticket_stock WHERE id = $1`, v.ticketStockID)
+			db.Exec(ctx, `DELETE FROM event_show WHERE id = $1`, v.showID)
+			db.Exec(ctx, `DELETE FROM event_promotor WHERE event_id = $1`, v.eventID)
+			db.Exec(ctx, `DELETE FROM event WHERE id = $1`, v.eventID)
+		}
+		db.Exec(ctx, `DELETE FROM promotor WHERE id = $1`, promotorID)
+	})
+
+	// the tickets of the period of the report are the ones of the first two days, the end date is exclusive and a
+	// returned ticket is no longer sold
+	returnedAt := day.Add(4 * time.Hour)
+	tickets := []struct {
+		stock      int
+		city       string
+		quantity   int64
+		createdAt  time.Time
+		returnedAt *time.Time
+	}{
+		{stock: 0, city: "Jakarta", quantity: 2, createdAt: day.Add(-time.Hour)},
+		{stock: 0, city: "Jakarta", quantity: 3, createdAt: day.Add(time.Hour)},
+		{stock: 1, city: "Bandung", quantity: 5, createdAt: day.Add(2 * time.Hour)},
+		{stock: 0, city: "Jakarta", quantity: 1, createdAt: day.Add(25 * time.Hour)},
+		{stock: 1, city: "Bandung", quantity: 7, createdAt: day.Add(48 * time.Hour)},
+		{stock: 0, city: "Jakarta", quantity: 6, createdAt: day.Add(3 * time.Hour), returnedAt: &returnedAt},
+		{stock: 2, city: "Surabaya", quantity: 9, createdAt: day.Add(time.Hour)},
+	}
+	for k, v := range tickets {
+		s := stocks[v.stock]
+		_, err := db.Exec(ctx, `
+			INSERT INTO acquired_ticket
+			(
+				"number", event_id, show_id, tier, ticket_stock_id, event_name, show_venue, show_type, show_country,
+				show_city, show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at,
+				order_id, sequence, quantity, price, currency, returned_at
+			)
+			VALUES
+			(
+				$1, $2, $3, 'CAT1', $4, 'Test', 'Test', 'LIVE', 'Indonesia', $5, '', $6, 'Test', 'test@example.com', 1,
+				$7, $8, 1, $9, 100000, 'IDR', $10
+			)
+		`, fmt.Sprintf("TEST%d%s", k, suffix), s.eventID, s.showID, s.ticketStockID, v.city, s.showTime, v.createdAt, fmt.Sprintf("ORDTEST%d%s", k, suffix), v.quantity, v.returnedAt)
+		require.NoError(t, err)
+	}
+
+	startDate, endDate := day, day.Add(48*time.Hour)
+	filter := report.SalesFilter{PromotorID: promotorID, StartDate: &startDate, EndDate: &endDate}
+
+	t.Run("the tiers are the ones of the events of the promotor ordered by their show", func(t *testing.T) {
+		sales, err := repo.FindManyTierSales(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, sales, 2)
+
+		assert.Equal(t, "TSTEST1"+suffix, sales[0].TicketStock.ID)
+		assert.Equal(t, int64(4), sales[0].Sold)
+		assert.Equal(t, "TSTEST2"+suffix, sales[1].TicketStock.ID)
+		assert.Equal(t, int64(5), sales[1].Sold)
+		assert.True(t, money.MustParse("500000", "IDR").Equal(sales[1].GrossRevenue))
+	})
+
+	t.Run("the tiers are narrowed down to the show", func(t *testing.T) {
+		showFilter := filter
+		showFilter.ShowID = "SHOWTEST2" + suffix
+
+		sales, err := repo.FindManyTierSales(ctx, showFilter)
+		require.NoError(t, err)
+		require.Len(t, sales, 1)
+
+		assert.Equal(t, "TSTEST2"+suffix, sales[0].TicketStock.ID)
+	})
+
+	t.Run("a tier without a ticket of the period is reported as unsold", func(t *testing.T) {
+		sales, err := repo.FindManyTierSales(ctx, report.SalesFilter{EventID: "EVTTEST2" + suffix, StartDate: &endDate})
+		require.NoError(t, err)
+		require.Len(t, sales, 1)
+
+		assert.Zero(t, sales[0].Sold)
+		assert.True(t, sales[0].GrossRevenue.IsZero())
+	})
+
+	t.Run("the buckets of a day count the tickets sold before the start date cumulatively", func(t *testing.T) {
+		buckets, err := repo.FindManySalesBucket(ctx, filter, report.SalesIntervalDay, time.UTC)
+		require.NoError(t, err)
+		require.Len(t, buckets, 2)
+
+		assert.Equal(t, report.SalesBucket{Time: day, Sold: 8, Cumulative: 10}, buckets[0])
+		assert.Equal(t, report.SalesBucket{Time: day.AddDate(0, 0, 1), Sold: 1, Cumulative: 11}, buckets[1])
+	})
+
+	t.Run("the buckets of an hour are in the location of the report", func(t *testing.T) {
+		location, err := time.LoadLocation("Asia/Jakarta")
+		require.NoError(t, err)
+
+		hourFilter := filter
+		hourFilter.ShowID = "SHOWTEST1" + suffix
+
+		buckets, err := repo.FindManySalesBucket(ctx, hourFilter, report.SalesIntervalHour, location)
+		require.NoError(t, err)
+		require.Len(t, buckets, 2)
+
+		assert.True(t, day.Add(time.Hour).Equal(buckets[0].Time))
+		assert.Equal(t, location, buckets[0].Time.Location())
+		assert.Equal(t, int64(5), buckets[0].Cumulative)
+		assert.True(t, day.Add(25*time.Hour).Equal(buckets[1].Time))
+		assert.Equal(t, int64(6), buckets[1].Cumulative)
+	})
+
+	t.Run("the top cities are limited to the ones which sold the most", func(t *testing.T) {
+		cities, err := repo.FindManyTopCitySales(ctx, filter, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, []report.CitySales{{Country: "Indonesia", City: "Bandung", Sold: 5}}, cities)
+
+		cities, err = repo.FindManyTopCitySales(ctx, filter, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, []report.CitySales{
+			{Country: "Indonesia", City: "Bandung", Sold: 5},
+			{Country: "Indonesia", City: "Jakarta", Sold: 4},
+		}, cities)
+	})
+}
@@ -0,0 +1,102 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type ReportUseCase interface {
+	GetSalesReport(ctx context.Context, req GetSalesReportRequest) (SalesReportResponse, error)
+	ExportSalesReport(ctx context.Context, req GetSalesReportRequest) ([]byte, error)
+}
+
+type reportUseCase struct {
+	logger          *logrus.Logger
+	location        *time.Location
+	timeout         time.Duration
+	salesRepository SalesRepository
+}
+
+type ReportUseCaseProperty struct {
+	Logger          *logrus.Logger
+	Location        *time.Location
+	Timeout         time.Duration
+	SalesRepository SalesRepository
+}
+
+func NewReportUseCase(props ReportUseCaseProperty) ReportUseCase {
+	return &reportUseCase{
+		logger:          props.Logger,
+		location:        props.Location,
+		timeout:         props.Timeout,
+		salesRepository: props.SalesRepository,
+	}
+}
+
+// GetSalesReport implements ReportUseCase. The aggregations of the report run at the same time, the remaining tickets
+// are the current ones regardless of the period.
+func (u *reportUseCase) GetSalesReport(ctx context.Context, req GetSalesReportRequest) (SalesReportResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	filter, err := req.ToSalesFilter(ctx, u.location)
+	if err != nil {
+		return SalesReportResponse{}, err
+	}
+
+	var tiers []TierSales
+	var buckets []SalesBucket
+	var cities []CitySales
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		tiers, err = u.salesRepository.FindManyTierSales(gctx, filter)
+		return err
+	})
+	g.Go(func() (err error) {
+		buckets, err = u.salesRepository.FindManySalesBucket(gctx, filter, req.Interval, u.location)
+		return err
+	})
+	g.Go(func() (err error) {
+		cities, err = u.salesRepository.FindManyTopCitySales(gctx, filter, req.TopCities)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return SalesReportResponse{}, err
+	}
+
+	resp := SalesReportResponse{
+		StartDate: filter.StartDate,
+		EndDate:   filter.EndDate,
+		Interval:  req.Interval,
+	}
+	resp.PopulateFromEntity(tiers, buckets, cities)
+
+	return resp, nil
+}
+
+// ExportSalesReport implements ReportUseCase. The csv holds the section of the request, the tiers by default.
+func (u *reportUseCase) ExportSalesReport(ctx context.Context, req GetSalesReportRequest) ([]byte, error) {
+	resp, err := u.GetSalesReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	section := req.Section
+	if section == "" {
+		section = SalesReportSectionTiers
+	}
+
+	content, err := resp.CSV(section)
+	if err != nil {
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while exporting sales report")
+	}
+
+	return content, nil
+}
@@ -0,0 +1,147 @@
+package report_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/report"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// salesStore returns the same sales whatever the filter is, the filter of the tiers is recorded.
+type salesStore struct {
+	tiers   []report.TierSales
+	buckets []report.SalesBucket
+	cities  []report.CitySales
+	filter  *report.SalesFilter
+}
+
+func (s salesStore) FindManyTierSales(ctx context.Context, filter report.SalesFilter) ([]report.TierSales, error) {
+	*s.filter = filter
+	return s.tiers, nil
+}
+
+func (s salesStore) FindManySalesBucket(ctx context.Context, filter report.SalesFilter, interval string, location *time.Location) ([]report.SalesBucket, error) {
+	return s.buckets, nil
+}
+
+func (s salesStore) FindManyTopCitySales(ctx context.Context, filter report.SalesFilter, limit int) ([]report.CitySales, error) {
+	return s.cities, nil
+}
+
+func TestReportUseCaseGetSalesReport(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	showTime := time.Date(2030, 2, 1, 19, 0, 0, 0, time.UTC)
+	onlineFor := "SHOW1"
+
+	// a live tier of 100 tickets of which 40 are sold and 5 are comps, a live tier of 50 tickets of which 10 are sold
+	// and an online tier of 200 tickets of which 50 are sold
+	store := salesStore{
+		tiers: []report.TierSales{
+			{
+				EventID: "EVENT1", EventName: "Concert", ShowVenue: "Hall", ShowTime: showTime,
+				TicketStock: ticket.TicketStock{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "GOLD", Allocation: 100, Acquired: 45, Held: 5, Price: money.MustParse("500000", "IDR")},
+				Sold:        40, Comps: 5, GrossRevenue: money.MustParse("20000000", "IDR"),
+			},
+			{
+				EventID: "EVENT1", EventName: "Concert", ShowVenue: "Hall", ShowTime: showTime,
+				TicketStock: ticket.TicketStock{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "SILVER", Allocation: 50, Acquired: 10, Reserved: 2, Price: money.MustParse("250000", "IDR")},
+				Sold:        10, GrossRevenue: money.MustParse("2500000", "IDR"),
+			},
+			{
+				EventID: "EVENT1", EventName: "Concert", ShowVenue: "Hall", ShowTime: showTime,
+				TicketStock: ticket.TicketStock{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS3", Tier: "ONLINE", Allocation: 200, Acquired: 50, Price: money.MustParse("50000", "IDR"), OnlineFor: &onlineFor},
+				Sold:        50, GrossRevenue: money.MustParse("2500000", "IDR"),
+			},
+		},
+		buckets: []report.SalesBucket{
+			{Time: time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC), Sold: 60, Cumulative: 70},
+			{Time: time.Date(2030, 1, 11, 0, 0, 0, 0, time.UTC), Sold: 30, Cumulative: 100},
+		},
+		cities: []report.CitySales{{Country: "Indonesia", City: "Jakarta", Sold: 100}},
+		filter: &report.SalesFilter{},
+	}
+
+	uc := report.NewReportUseCase(report.ReportUseCaseProperty{
+		Logger:          logger,
+		Location:        time.UTC,
+		Timeout:         10 * time.Second,
+		SalesRepository: store,
+	})
+
+	req := report.GetSalesReportRequest{
+		EventID: "EVENT1", StartDate: "2030-01-10 00:00:00", EndDate: "2030-01-12 00:00:00", Interval: report.SalesIntervalDay,
+		TopCities: 10, Format: report.SalesReportFormatJSON,
+	}
+
+	t.Run("the tiers are summed up by their channel and the whole report", func(t *testing.T) {
+		resp, err := uc.GetSalesReport(context.Background(), req)
+		require.NoError(t, err)
+
+		assert.Equal(t, "EVENT1", store.filter.EventID)
+		require.NotNil(t, store.filter.StartDate)
+		assert.True(t, time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC).Equal(*store.filter.StartDate))
+
+		assert.Equal(t, int64(350), resp.Allocation)
+		assert.Equal(t, int64(100), resp.Sold)
+		assert.Equal(t, int64(5), resp.Comps)
+		assert.Equal(t, int64(238), resp.Remaining)
+		assert.Equal(t, 28.57, resp.SellThrough)
+
+		require.Len(t, resp.Tiers, 3)
+		assert.Equal(t, 40.0, resp.Tiers[0].SellThrough)
+		assert.Equal(t, int64(50), resp.Tiers[0].Remaining)
+		assert.Equal(t, report.SalesChannelOnline, resp.Tiers[2].Channel)
+
+		require.Len(t, resp.Channels, 2)
+		assert.Equal(t, report.SalesChannelLive, resp.Channels[0].Channel)
+		assert.Equal(t, int64(150), resp.Channels[0].Allocation)
+		assert.Equal(t, int64(50), resp.Channels[0].Sold)
+		assert.True(t, money.MustParse("22500000", "IDR").Equal(resp.Channels[0].GrossRevenue))
+		assert.Equal(t, 33.33, resp.Channels[0].SellThrough)
+		assert.Equal(t, report.SalesChannelOnline, resp.Channels[1].Channel)
+		assert.Equal(t, 25.0, resp.Channels[1].SellThrough)
+
+		// the sell through of a bucket is the share of the whole allocation which is sold by its end
+		require.Len(t, resp.Buckets, 2)
+		assert.Equal(t, 20.0, resp.Buckets[0].SellThrough)
+		assert.Equal(t, 28.57, resp.Buckets[1].SellThrough)
+	})
+
+	t.Run("a period which ends before it starts is rejected", func(t *testing.T) {
+		invalid := req
+		invalid.EndDate = "2030-01-09 00:00:00"
+
+		_, err := uc.GetSalesReport(context.Background(), invalid)
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+	})
+
+	t.Run("the csv holds the tiers unless another section is asked for", func(t *testing.T) {
+		content, err := uc.ExportSalesReport(context.Background(), req)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		require.Len(t, lines, 4)
+		assert.True(t, strings.HasPrefix(lines[0], "event_id,event_name,"))
+		assert.Equal(t, "EVENT1,Concert,SHOW1,Hall,2030-02-01T19:00:00Z,TS1,GOLD,LIVE,500000,IDR,100,40,5,50,20000000,40.00", lines[1])
+
+		cities := req
+		cities.Section = report.SalesReportSectionCities
+
+		content, err = uc.ExportSalesReport(context.Background(), cities)
+		require.NoError(t, err)
+
+		assert.Equal(t, "country,city,sold\nIndonesia,Jakarta,100\n", string(content))
+	})
+}
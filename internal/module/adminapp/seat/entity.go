@@ -0,0 +1,51 @@
+package seat
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	SeatStatusAvailable string = "AVAILABLE"
+	SeatStatusHeld      string = "HELD"
+	SeatStatusSold      string = "SOLD"
+
+	SeatMapFormatJSON string = "JSON"
+	SeatMapFormatCSV  string = "CSV"
+)
+
+type SeatMap struct {
+	ID        string
+	Name      string
+	Venue     string
+	Seats     []Seat
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Seat struct {
+	SeatMapID string
+	ID        string
+	Section   string
+	Row       string
+	Number    string
+	Tier      string
+}
+
+type ShowSeat struct {
+	EventID       string
+	ShowID        string
+	SeatID        string
+	TicketStockID string
+	Section       string
+	Row           string
+	Number        string
+	Tier          string
+	Price         money.Money
+	Status        string
+	HeldBy        *int64
+	HeldUntil     *time.Time
+	OrderID       *string
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,190 @@
+package seat
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// maxMultipartMemory is the part of a multipart form which is kept in memory, the rest is buffered on disk.
+const maxMultipartMemory int64 = 1 << 20
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	SeatUseCase       SeatUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, seatUseCase SeatUseCase) {
+	handler := &HTTPHandler{
+		Validate:    validate,
+		SeatUseCase: seatUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/seat-maps", publicMiddleware.SetRouteChain(handler.ImportSeatMap, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/seat-maps/{seatMapID}", publicMiddleware.SetRouteChain(handler.GetSeatMap, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/seat-map", publicMiddleware.SetRouteChain(handler.AssignSeatMap, adminSession.Verify)).Methods(http.MethodPost)
+}
+
+func (handler HTTPHandler) ImportSeatMap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// the multipart envelope is allowed on top of the largest seat map file
+	r.Body = http.MaxBytesReader(w, r.Body, MaxSeatMapFileSize+maxMultipartMemory)
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, response.RESTEnvelope{
+			Status:  status.BAD_REQUEST,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	defer file.Close()
+
+	// one byte more than allowed is read so that an oversized seat map file is rejected rather than truncated
+	content, err := io.ReadAll(io.LimitReader(file, MaxSeatMapFileSize+1))
+	if err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	format := strings.ToUpper(r.FormValue("format"))
+	if format == "" {
+		format = strings.ToUpper(strings.TrimPrefix(filepath.Ext(header.Filename), "."))
+	}
+
+	req := ImportSeatMapRequest{
+		Name:    r.FormValue("name"),
+		Venue:   r.FormValue("venue"),
+		Format:  format,
+		Content: content,
+	}
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.SeatUseCase.ImportSeatMap(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("seat map"), i18n.Noun("imported")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetSeatMap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetSeatMapRequest{
+		ID: vars["seatMapID"],
+	}
+
+	resp, err := handler.SeatUseCase.GetSeatMap(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("seat map")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) AssignSeatMap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := AssignSeatMapRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.SeatUseCase.AssignSeatMap(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("seat map"), i18n.Noun("assigned to the show")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,75 @@
+package seat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// MaxSeatMapFileSize is the largest seat map file which can be imported.
+const MaxSeatMapFileSize int64 = 10 << 20
+
+type ImportSeatMapRequest struct {
+	Name    string `validate:"required"`
+	Venue   string `validate:"required"`
+	Format  string `validate:"oneof=JSON CSV"`
+	Content []byte `validate:"required"`
+}
+
+func (r ImportSeatMapRequest) ToEntitySeatMap(ctx context.Context, now time.Time) (SeatMap, error) {
+	if int64(len(r.Content)) > MaxSeatMapFileSize {
+		return SeatMap{}, errors.New(http.StatusRequestEntityTooLarge, status.BAD_REQUEST, i18n.Message(ctx, i18n.SeatMapTooLarge, MaxSeatMapFileSize))
+	}
+
+	seats, err := ParseSeatMap(ctx, r.Format, r.Content)
+	if err != nil {
+		return SeatMap{}, err
+	}
+
+	if len(seats) < 1 {
+		return SeatMap{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.EmptySeatMap))
+	}
+
+	seatMap := SeatMap{
+		ID:        util.GenerateTimestampWithPrefix("SMAP"),
+		Name:      r.Name,
+		Venue:     r.Venue,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	positions := make(map[string]bool, len(seats))
+	for k, s := range seats {
+		if s.Section == "" || s.Row == "" || s.Number == "" || s.Tier == "" {
+			return SeatMap{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.IncompleteSeat, k+1))
+		}
+
+		position := fmt.Sprintf("%s|%s|%s", s.Section, s.Row, s.Number)
+		if positions[position] {
+			return SeatMap{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.DuplicatedSeat, s.Section, s.Row, s.Number))
+		}
+		positions[position] = true
+
+		seats[k].SeatMapID = seatMap.ID
+		seats[k].ID = fmt.Sprintf("%s-%d", seatMap.ID, k+1)
+	}
+	seatMap.Seats = seats
+
+	return seatMap, nil
+}
+
+type GetSeatMapRequest struct {
+	ID string
+}
+
+type AssignSeatMapRequest struct {
+	EventID   string `json:"-"`
+	ShowID    string `json:"-"`
+	SeatMapID string `json:"seat_map_id" validate:"-"`
+}
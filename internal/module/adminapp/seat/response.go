@@ -0,0 +1,85 @@
+package seat
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type SeatResponse struct {
+	ID     string `json:"id"`
+	Number string `json:"number"`
+	Tier   string `json:"tier"`
+}
+
+type RowResponse struct {
+	Name  string         `json:"name"`
+	Seats []SeatResponse `json:"seats"`
+}
+
+type SectionResponse struct {
+	Name string        `json:"name"`
+	Rows []RowResponse `json:"rows"`
+}
+
+type SeatMapResponse struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Venue      string            `json:"venue"`
+	TotalSeats int64             `json:"total_seats"`
+	Sections   []SectionResponse `json:"sections"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+func (r *SeatMapResponse) PopulateFromEntity(sm SeatMap) {
+	r.ID = sm.ID
+	r.Name = sm.Name
+	r.Venue = sm.Venue
+	r.TotalSeats = int64(len(sm.Seats))
+	r.Sections = make([]SectionResponse, 0)
+
+	sectionIndex := make(map[string]int)
+	rowIndex := make(map[string]int)
+	for _, s := range sm.Seats {
+		sk, ok := sectionIndex[s.Section]
+		if !ok {
+			r.Sections = append(r.Sections, SectionResponse{Name: s.Section})
+			sk = len(r.Sections) - 1
+			sectionIndex[s.Section] = sk
+		}
+
+		section := &r.Sections[sk]
+		rowKey := s.Section + "|" + s.Row
+		rk, ok := rowIndex[rowKey]
+		if !ok {
+			section.Rows = append(section.Rows, RowResponse{Name: s.Row})
+			rk = len(section.Rows) - 1
+			rowIndex[rowKey] = rk
+		}
+
+		section.Rows[rk].Seats = append(section.Rows[rk].Seats, SeatResponse{
+			ID:     s.ID,
+			Number: s.Number,
+			Tier:   s.Tier,
+		})
+	}
+
+	r.CreatedAt = sm.CreatedAt
+	r.UpdatedAt = sm.UpdatedAt
+}
+
+type AssignedTierResponse struct {
+	TicketStockID string      `json:"ticket_stock_id"`
+	Tier          string      `json:"tier"`
+	Seats         int64       `json:"seats"`
+	Price         money.Money `json:"price"`
+}
+
+type AssignSeatMapResponse struct {
+	EventID    string                 `json:"event_id"`
+	ShowID     string                 `json:"show_id"`
+	SeatMapID  string                 `json:"seat_map_id"`
+	TotalSeats int64                  `json:"total_seats"`
+	Tiers      []AssignedTierResponse `json:"tiers"`
+}
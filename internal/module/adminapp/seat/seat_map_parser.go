@@ -0,0 +1,121 @@
+package seat
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// SeatMapLayout is the JSON representation of a seat map file. The tier of a row overrides the tier of its section.
+type SeatMapLayout struct {
+	Sections []struct {
+		Name string `json:"name"`
+		Tier string `json:"tier"`
+		Rows []struct {
+			Name  string   `json:"name"`
+			Tier  string   `json:"tier"`
+			Seats []string `json:"seats"`
+		} `json:"rows"`
+	} `json:"sections"`
+}
+
+var seatMapCSVHeader = []string{"section", "row", "number", "tier"}
+
+// ParseSeatMap reads the seats of a seat map file in the given format. The positions of the seats are trimmed and
+// their tiers are upper cased whichever the format is, so that they match the tiers of the ticket stocks.
+func ParseSeatMap(ctx context.Context, format string, content []byte) ([]Seat, error) {
+	var seats []Seat
+	var err error
+
+	switch format {
+	case SeatMapFormatJSON:
+		seats, err = parseSeatMapJSON(ctx, content)
+	case SeatMapFormatCSV:
+		seats, err = parseSeatMapCSV(ctx, content)
+	default:
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.UnsupportedSeatMapFormat, format))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for k, s := range seats {
+		seats[k].Section = strings.TrimSpace(s.Section)
+		seats[k].Row = strings.TrimSpace(s.Row)
+		seats[k].Number = strings.TrimSpace(s.Number)
+		seats[k].Tier = strings.ToUpper(strings.TrimSpace(s.Tier))
+	}
+
+	return seats, nil
+}
+
+func parseSeatMapJSON(ctx context.Context, content []byte) ([]Seat, error) {
+	layout := SeatMapLayout{}
+	if err := json.Unmarshal(content, &layout); err != nil {
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidSeatMapJSON, err.Error()))
+	}
+
+	seats := make([]Seat, 0)
+	for _, section := range layout.Sections {
+		for _, row := range section.Rows {
+			tier := section.Tier
+			if row.Tier != "" {
+				tier = row.Tier
+			}
+			for _, number := range row.Seats {
+				seats = append(seats, Seat{
+					Section: section.Name,
+					Row:     row.Name,
+					Number:  number,
+					Tier:    tier,
+				})
+			}
+		}
+	}
+
+	return seats, nil
+}
+
+func parseSeatMapCSV(ctx context.Context, content []byte) ([]Seat, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = len(seatMapCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidSeatMapCSV, err.Error()))
+	}
+	for k, v := range seatMapCSVHeader {
+		if strings.ToLower(strings.TrimSpace(header[k])) != v {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidSeatMapCSVHeader, strings.Join(seatMapCSVHeader, ",")))
+		}
+	}
+
+	seats := make([]Seat, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidSeatMapCSV, err.Error()))
+		}
+
+		seats = append(seats, Seat{
+			Section: record[0],
+			Row:     record[1],
+			Number:  record[2],
+			Tier:    record[3],
+		})
+	}
+
+	return seats, nil
+}
@@ -0,0 +1,109 @@
+package seat_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/seat"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+)
+
+func TestParseSeatMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		want    []seat.Seat
+		wantErr int
+	}{
+		{
+			name:   "csv",
+			format: seat.SeatMapFormatCSV,
+			content: "section,row,number,tier\n" +
+				"A,1,1,cat1\n" +
+				" A , 1 , 2 , Cat1 \n",
+			want: []seat.Seat{
+				{Section: "A", Row: "1", Number: "1", Tier: "CAT1"},
+				{Section: "A", Row: "1", Number: "2", Tier: "CAT1"},
+			},
+		},
+		{
+			name:    "csv header in another case",
+			format:  seat.SeatMapFormatCSV,
+			content: "Section,Row,Number,Tier\nA,1,1,VIP\n",
+			want:    []seat.Seat{{Section: "A", Row: "1", Number: "1", Tier: "VIP"}},
+		},
+		{
+			name:    "csv of a wrong header",
+			format:  seat.SeatMapFormatCSV,
+			content: "section,row,seat,tier\nA,1,1,VIP\n",
+			wantErr: http.StatusBadRequest,
+		},
+		{
+			name:    "csv of a missing column",
+			format:  seat.SeatMapFormatCSV,
+			content: "section,row,number,tier\nA,1,1\n",
+			wantErr: http.StatusBadRequest,
+		},
+		{
+			name:    "empty csv",
+			format:  seat.SeatMapFormatCSV,
+			content: "",
+			wantErr: http.StatusBadRequest,
+		},
+		{
+			name:   "json",
+			format: seat.SeatMapFormatJSON,
+			content: `{"sections":[{"name":"A","tier":"cat1","rows":[` +
+				`{"name":"1","seats":["1","2"]},` +
+				`{"name":"2","tier":" vip ","seats":["1"]}` +
+				`]}]}`,
+			want: []seat.Seat{
+				{Section: "A", Row: "1", Number: "1", Tier: "CAT1"},
+				{Section: "A", Row: "1", Number: "2", Tier: "CAT1"},
+				{Section: "A", Row: "2", Number: "1", Tier: "VIP"},
+			},
+		},
+		{
+			name:    "json of the same tier as the csv",
+			format:  seat.SeatMapFormatJSON,
+			content: `{"sections":[{"name":" A ","tier":"Cat1","rows":[{"name":" 1 ","seats":[" 1 "]}]}]}`,
+			want:    []seat.Seat{{Section: "A", Row: "1", Number: "1", Tier: "CAT1"}},
+		},
+		{
+			name:    "json without sections",
+			format:  seat.SeatMapFormatJSON,
+			content: `{}`,
+			want:    []seat.Seat{},
+		},
+		{
+			name:    "malformed json",
+			format:  seat.SeatMapFormatJSON,
+			content: `{"sections":[`,
+			wantErr: http.StatusBadRequest,
+		},
+		{
+			name:    "unsupported format",
+			format:  "XML",
+			content: "<seats/>",
+			wantErr: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seats, err := seat.ParseSeatMap(context.Background(), tt.format, []byte(tt.content))
+			if tt.wantErr != 0 {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, errors.Destruct(err).HTTPStatusCode)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, seats)
+		})
+	}
+}
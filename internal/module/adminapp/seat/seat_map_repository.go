@@ -0,0 +1,85 @@
+package seat
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type SeatMapRepository interface {
+	Save(ctx context.Context, sm SeatMap) error
+	FindByID(ctx context.Context, ID string) (SeatMap, error)
+}
+
+type seatMapRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewSeatMapRepository(logger *logrus.Logger, db *pgxpool.Pool) SeatMapRepository {
+	return &seatMapRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByID implements SeatMapRepository.
+func (r *seatMapRepository) FindByID(ctx context.Context, ID string) (SeatMap, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, name, venue, created_at, updated_at
+		FROM seat_map
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data SeatMap
+	err := row.Scan(
+		&data.ID, &data.Name, &data.Venue, &data.CreatedAt, &data.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return SeatMap{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("seat map"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return SeatMap{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting seat map's prorperties")
+	}
+
+	return data, nil
+}
+
+// Save implements SeatMapRepository.
+func (r *seatMapRepository) Save(ctx context.Context, sm SeatMap) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO seat_map
+		(
+			id, name, venue, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, sm.ID, sm.Name, sm.Venue, sm.CreatedAt, sm.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving seat map's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package seat
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type SeatRepository interface {
+	Save(ctx context.Context, s Seat) error
+	FindManyBySeatMapID(ctx context.Context, seatMapID string) ([]Seat, error)
+}
+
+type seatRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewSeatRepository(logger *logrus.Logger, db *pgxpool.Pool) SeatRepository {
+	return &seatRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindManyBySeatMapID implements SeatRepository.
+func (r *seatRepository) FindManyBySeatMapID(ctx context.Context, seatMapID string) ([]Seat, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			seat_map_id, id, section, "row", "number", tier
+		FROM seat_map_seat
+		WHERE
+			seat_map_id = $1
+		ORDER BY sequence ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, seatMapID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of seat's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Seat, 0)
+	for rows.Next() {
+		var s Seat
+
+		err := rows.Scan(&s.SeatMapID, &s.ID, &s.Section, &s.Row, &s.Number, &s.Tier)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of seat's prorperties")
+		}
+
+		data = append(data, s)
+	}
+
+	return data, nil
+}
+
+// Save implements SeatRepository.
+func (r *seatRepository) Save(ctx context.Context, s Seat) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO seat_map_seat
+		(
+			seat_map_id, id, section, "row", "number", tier
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, s.SeatMapID, s.ID, s.Section, s.Row, s.Number, s.Tier)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving seat's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package seat
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ShowSeatRepository interface {
+	Save(ctx context.Context, ss ShowSeat) error
+	CountByShowID(ctx context.Context, showID string) (int64, error)
+}
+
+type showSeatRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewShowSeatRepository(logger *logrus.Logger, db *pgxpool.Pool) ShowSeatRepository {
+	return &showSeatRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// CountByShowID implements ShowSeatRepository.
+func (r *showSeatRepository) CountByShowID(ctx context.Context, showID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(seat_id) FROM show_seat WHERE show_id = $1`
+
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, showID)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting show seat's prorperties")
+	}
+
+	return count, nil
+}
+
+// Save implements ShowSeatRepository.
+func (r *showSeatRepository) Save(ctx context.Context, ss ShowSeat) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO show_seat
+		(
+			event_id, show_id, seat_id, ticket_stock_id, section, "row", "number", tier, price, currency, status, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ss.EventID, ss.ShowID, ss.SeatID, ss.TicketStockID, ss.Section, ss.Row, ss.Number, ss.Tier, ss.Price.Amount, ss.Price.Currency, ss.Status, ss.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving show seat's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package seat
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type SeatUseCase interface {
+	ImportSeatMap(ctx context.Context, req ImportSeatMapRequest) (SeatMapResponse, error)
+	GetSeatMap(ctx context.Context, req GetSeatMapRequest) (SeatMapResponse, error)
+	AssignSeatMap(ctx context.Context, req AssignSeatMapRequest) (AssignSeatMapResponse, error)
+}
+
+type seatUseCase struct {
+	logger                *logrus.Logger
+	location              *time.Location
+	timeout               time.Duration
+	seatMapRepository     SeatMapRepository
+	seatRepository        SeatRepository
+	showSeatRepository    ShowSeatRepository
+	ticketStockRepository ticket.TicketStockRepository
+	venueRepository       venue.VenueRepository
+	txManager             postgresql.TxManager
+}
+
+type SeatUseCaseProperty struct {
+	Logger                *logrus.Logger
+	Location              *time.Location
+	Timeout               time.Duration
+	SeatMapRepository     SeatMapRepository
+	SeatRepository        SeatRepository
+	ShowSeatRepository    ShowSeatRepository
+	TicketStockRepository ticket.TicketStockRepository
+	VenueRepository       venue.VenueRepository
+	TxManager             postgresql.TxManager
+}
+
+func NewSeatUseCase(props SeatUseCaseProperty) SeatUseCase {
+	return &seatUseCase{
+		logger:                props.Logger,
+		location:              props.Location,
+		timeout:               props.Timeout,
+		seatMapRepository:     props.SeatMapRepository,
+		seatRepository:        props.SeatRepository,
+		showSeatRepository:    props.ShowSeatRepository,
+		ticketStockRepository: props.TicketStockRepository,
+		venueRepository:       props.VenueRepository,
+		txManager:             props.TxManager,
+	}
+}
+
+// ImportSeatMap implements SeatUseCase.
+func (u *seatUseCase) ImportSeatMap(ctx context.Context, req ImportSeatMapRequest) (SeatMapResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	sm, err := req.ToEntitySeatMap(ctx, time.Now())
+	if err != nil {
+		return SeatMapResponse{}, err
+	}
+
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.seatMapRepository.Save(ctx, sm); err != nil {
+			return err
+		}
+
+		for _, s := range sm.Seats {
+			if err := u.seatRepository.Save(ctx, s); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return SeatMapResponse{}, err
+	}
+
+	resp := SeatMapResponse{}
+	resp.PopulateFromEntity(sm)
+
+	return resp, nil
+}
+
+// GetSeatMap implements SeatUseCase.
+func (u *seatUseCase) GetSeatMap(ctx context.Context, req GetSeatMapRequest) (SeatMapResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	sm, err := u.seatMapRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return SeatMapResponse{}, err
+	}
+
+	seats, err := u.seatRepository.FindManyBySeatMapID(ctx, sm.ID)
+	if err != nil {
+		return SeatMapResponse{}, err
+	}
+	sm.Seats = seats
+
+	resp := SeatMapResponse{}
+	resp.PopulateFromEntity(sm)
+
+	return resp, nil
+}
+
+// AssignSeatMap implements SeatUseCase.
+func (u *seatUseCase) AssignSeatMap(ctx context.Context, req AssignSeatMapRequest) (AssignSeatMapResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	ticketStocks, err := u.ticketStockRepository.FindManyByShowID(ctx, req.ShowID)
+	if err != nil {
+		return AssignSeatMapResponse{}, err
+	}
+
+	if len(ticketStocks) < 1 || ticketStocks[0].EventID != req.EventID {
+		return AssignSeatMapResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), req.ShowID))
+	}
+
+	seatMapID := req.SeatMapID
+	if seatMapID == "" {
+		v, err := u.venueRepository.FindByShowID(ctx, req.ShowID)
+		if err != nil {
+			return AssignSeatMapResponse{}, err
+		}
+
+		if v.DefaultSeatMapID == nil {
+			return AssignSeatMapResponse{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.VenueWithoutSeatMap, v.Name))
+		}
+		seatMapID = *v.DefaultSeatMapID
+	}
+
+	sm, err := u.seatMapRepository.FindByID(ctx, seatMapID)
+	if err != nil {
+		return AssignSeatMapResponse{}, err
+	}
+
+	seats, err := u.seatRepository.FindManyBySeatMapID(ctx, sm.ID)
+	if err != nil {
+		return AssignSeatMapResponse{}, err
+	}
+
+	showSeats, err := buildShowSeats(ctx, seats, ticketStocks, time.Now())
+	if err != nil {
+		return AssignSeatMapResponse{}, err
+	}
+
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		count, err := u.showSeatRepository.CountByShowID(ctx, req.ShowID)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.ShowHasSeatMap, req.ShowID))
+		}
+
+		for _, ss := range showSeats {
+			if err := u.showSeatRepository.Save(ctx, ss); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return AssignSeatMapResponse{}, err
+	}
+
+	resp := AssignSeatMapResponse{
+		EventID:    req.EventID,
+		ShowID:     req.ShowID,
+		SeatMapID:  sm.ID,
+		TotalSeats: int64(len(showSeats)),
+		Tiers:      make([]AssignedTierResponse, 0),
+	}
+
+	seatsByStock := make(map[string]int64)
+	for _, ss := range showSeats {
+		seatsByStock[ss.TicketStockID]++
+	}
+	for _, ts := range ticketStocks {
+		if seatsByStock[ts.ID] < 1 {
+			continue
+		}
+		resp.Tiers = append(resp.Tiers, AssignedTierResponse{
+			TicketStockID: ts.ID,
+			Tier:          ts.Tier,
+			Seats:         seatsByStock[ts.ID],
+			Price:         ts.Price,
+		})
+	}
+
+	return resp, nil
+}
+
+// buildShowSeats links every seat of a seat map to the ticket stock of the show which has the same tier. The seats of a tier may not exceed its allocation.
+func buildShowSeats(ctx context.Context, seats []Seat, ticketStocks []ticket.TicketStock, now time.Time) ([]ShowSeat, error) {
+	stockByTier := make(map[string]ticket.TicketStock)
+	for _, ts := range ticketStocks {
+		if ts.OnlineFor != nil {
+			continue
+		}
+		stockByTier[ts.Tier] = ts
+	}
+
+	seatsByTier := make(map[string]int64)
+	showSeats := make([]ShowSeat, len(seats))
+	for k, s := range seats {
+		ts, ok := stockByTier[s.Tier]
+		if !ok {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.SeatTierNotAllocated, s.Tier, s.ID))
+		}
+
+		seatsByTier[s.Tier]++
+		if seatsByTier[s.Tier] > ts.Allocation {
+			return nil, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.SeatTierExceedsAllocation, s.Tier, ts.Allocation))
+		}
+
+		showSeats[k] = ShowSeat{
+			EventID:       ts.EventID,
+			ShowID:        ts.ShowID,
+			SeatID:        s.ID,
+			TicketStockID: ts.ID,
+			Section:       s.Section,
+			Row:           s.Row,
+			Number:        s.Number,
+			Tier:          s.Tier,
+			Price:         ts.Price,
+			Status:        SeatStatusAvailable,
+			UpdatedAt:     now,
+		}
+	}
+
+	return showSeats, nil
+}
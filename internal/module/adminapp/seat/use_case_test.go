@@ -0,0 +1,283 @@
+package seat_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/seat"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the seat map flow is not expected to call panics.
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	stocks []ticket.TicketStock
+}
+
+func (s ticketStockStore) FindManyByShowID(ctx context.Context, showID string) ([]ticket.TicketStock, error) {
+	data := make([]ticket.TicketStock, 0)
+	for _, ts := range s.stocks {
+		if ts.ShowID == showID {
+			data = append(data, ts)
+		}
+	}
+
+	return data, nil
+}
+
+type venueStore struct {
+	venue.VenueRepository
+	v venue.Venue
+}
+
+func (s *venueStore) FindByShowID(ctx context.Context, showID string) (venue.Venue, error) {
+	return s.v, nil
+}
+
+type seatMapStore struct {
+	seat.SeatMapRepository
+	seatMaps map[string]seat.SeatMap
+}
+
+func (s *seatMapStore) Save(ctx context.Context, sm seat.SeatMap) error {
+	s.seatMaps[sm.ID] = sm
+	return nil
+}
+
+func (s *seatMapStore) FindByID(ctx context.Context, ID string) (seat.SeatMap, error) {
+	sm, ok := s.seatMaps[ID]
+	if !ok {
+		return seat.SeatMap{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return sm, nil
+}
+
+type seatStore struct {
+	seat.SeatRepository
+	seats []seat.Seat
+}
+
+func (s *seatStore) Save(ctx context.Context, st seat.Seat) error {
+	s.seats = append(s.seats, st)
+	return nil
+}
+
+func (s *seatStore) FindManyBySeatMapID(ctx context.Context, seatMapID string) ([]seat.Seat, error) {
+	data := make([]seat.Seat, 0)
+	for _, st := range s.seats {
+		if st.SeatMapID == seatMapID {
+			data = append(data, st)
+		}
+	}
+
+	return data, nil
+}
+
+type showSeatStore struct {
+	seat.ShowSeatRepository
+	showSeats []seat.ShowSeat
+}
+
+func (s *showSeatStore) Save(ctx context.Context, ss seat.ShowSeat) error {
+	s.showSeats = append(s.showSeats, ss)
+	return nil
+}
+
+func (s *showSeatStore) CountByShowID(ctx context.Context, showID string) (int64, error) {
+	var count int64
+	for _, ss := range s.showSeats {
+		if ss.ShowID == showID {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// txManager runs fn right away, the seat map flow does not roll anything back which matters here.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestSeatUseCaseAssignSeatMap(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	price := money.MustParse("100000", "IDR")
+	onlineFor := "PARTNER"
+
+	type fixture struct {
+		uc        seat.SeatUseCase
+		showSeats *showSeatStore
+		seatMapID string
+	}
+
+	newFixture := func(t *testing.T, csv string, stocks []ticket.TicketStock, defaultSeatMap bool) fixture {
+		seatMaps := &seatMapStore{seatMaps: make(map[string]seat.SeatMap)}
+		seats := &seatStore{}
+		showSeats := &showSeatStore{}
+
+		venues := &venueStore{v: venue.Venue{ID: "VENUE1", Name: "Test"}}
+		uc := seat.NewSeatUseCase(seat.SeatUseCaseProperty{
+			Logger:                logger,
+			Location:              time.UTC,
+			Timeout:               10 * time.Second,
+			SeatMapRepository:     seatMaps,
+			SeatRepository:        seats,
+			ShowSeatRepository:    showSeats,
+			TicketStockRepository: ticketStockStore{stocks: stocks},
+			VenueRepository:       venues,
+			TxManager:             txManager{},
+		})
+
+		sm, err := uc.ImportSeatMap(context.Background(), seat.ImportSeatMapRequest{
+			Name: "Test", Venue: "Test", Format: seat.SeatMapFormatCSV, Content: []byte(csv),
+		})
+		require.NoError(t, err)
+
+		if defaultSeatMap {
+			venues.v.DefaultSeatMapID = &sm.ID
+		}
+
+		return fixture{uc: uc, showSeats: showSeats, seatMapID: sm.ID}
+	}
+
+	layout := "section,row,number,tier\nA,1,1,cat1\nA,1,2,CAT1\nB,1,1,vip\n"
+
+	t.Run("the seats are linked to the ticket stock of their tier", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "VIP", Allocation: 5, Price: price},
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS3", Tier: "VIP", Allocation: 5, Price: price, OnlineFor: &onlineFor},
+		}, false)
+
+		resp, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1", SeatMapID: f.seatMapID})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), resp.TotalSeats)
+		assert.Equal(t, []seat.AssignedTierResponse{
+			{TicketStockID: "TS1", Tier: "CAT1", Seats: 2, Price: price},
+			{TicketStockID: "TS2", Tier: "VIP", Seats: 1, Price: price},
+		}, resp.Tiers)
+
+		require.Len(t, f.showSeats.showSeats, 3)
+		for _, ss := range f.showSeats.showSeats {
+			assert.Equal(t, seat.SeatStatusAvailable, ss.Status)
+			assert.NotEqual(t, "TS3", ss.TicketStockID)
+		}
+	})
+
+	t.Run("the default seat map of the venue is assigned when none is given", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "VIP", Allocation: 5, Price: price},
+		}, true)
+
+		resp, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1"})
+		require.NoError(t, err)
+		assert.Equal(t, f.seatMapID, resp.SeatMapID)
+	})
+
+	t.Run("a venue without a default seat map needs one to be given", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+		}, false)
+
+		_, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1"})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+	})
+
+	t.Run("a show of another event is not found", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT2", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+		}, false)
+
+		_, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1", SeatMapID: f.seatMapID})
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+	})
+
+	t.Run("a tier without a ticket stock is rejected", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+		}, false)
+
+		_, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1", SeatMapID: f.seatMapID})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+		assert.Empty(t, f.showSeats.showSeats)
+	})
+
+	t.Run("the seats of a tier may not exceed its allocation", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 1, Price: price},
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "VIP", Allocation: 5, Price: price},
+		}, false)
+
+		_, err := f.uc.AssignSeatMap(context.Background(), seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1", SeatMapID: f.seatMapID})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+		assert.Empty(t, f.showSeats.showSeats)
+	})
+
+	t.Run("a show is assigned a seat map once", func(t *testing.T) {
+		f := newFixture(t, layout, []ticket.TicketStock{
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "CAT1", Allocation: 2, Price: price},
+			{EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "VIP", Allocation: 5, Price: price},
+		}, false)
+
+		req := seat.AssignSeatMapRequest{EventID: "EVENT1", ShowID: "SHOW1", SeatMapID: f.seatMapID}
+		_, err := f.uc.AssignSeatMap(context.Background(), req)
+		require.NoError(t, err)
+
+		_, err = f.uc.AssignSeatMap(context.Background(), req)
+		assert.True(t, errors.MatchStatus(err, status.ALREADY_EXIST))
+		assert.Len(t, f.showSeats.showSeats, 3)
+	})
+}
+
+func TestSeatUseCaseImportSeatMap(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	uc := seat.NewSeatUseCase(seat.SeatUseCaseProperty{
+		Logger:            logger,
+		Location:          time.UTC,
+		Timeout:           10 * time.Second,
+		SeatMapRepository: &seatMapStore{seatMaps: make(map[string]seat.SeatMap)},
+		SeatRepository:    &seatStore{},
+		TxManager:         txManager{},
+	})
+
+	t.Run("an oversized seat map file is rejected", func(t *testing.T) {
+		content := append([]byte("section,row,number,tier\n"), bytes.Repeat([]byte("A,1,1,CAT1\n"), int(seat.MaxSeatMapFileSize/11)+1)...)
+
+		_, err := uc.ImportSeatMap(context.Background(), seat.ImportSeatMapRequest{
+			Name: "Test", Venue: "Test", Format: seat.SeatMapFormatCSV, Content: content,
+		})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, errors.Destruct(err).HTTPStatusCode)
+	})
+
+	t.Run("duplicated seats are rejected", func(t *testing.T) {
+		_, err := uc.ImportSeatMap(context.Background(), seat.ImportSeatMapRequest{
+			Name: "Test", Venue: "Test", Format: seat.SeatMapFormatCSV, Content: []byte("section,row,number,tier\nA,1,1,CAT1\n A ,1,1,VIP\n"),
+		})
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+	})
+}
@@ -0,0 +1,60 @@
+package taxonomy
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+const (
+	TypeCategory string = "CATEGORY"
+	TypeGenre    string = "GENRE"
+	TypeTag      string = "TAG"
+)
+
+// types maps the path segment of the taxonomy's routes to its type.
+var types = map[string]string{
+	"categories": TypeCategory,
+	"genres":     TypeGenre,
+	"tags":       TypeTag,
+}
+
+// nouns holds the noun of each type which is used in the messages, e.g. "category's properties with id ...".
+var nouns = map[string]string{
+	TypeCategory: "category",
+	TypeGenre:    "genre",
+	TypeTag:      "tag",
+}
+
+// TypeFromPath returns the type of the taxonomy which is managed under the given path segment, e.g. "genres".
+func TypeFromPath(path string) (string, bool) {
+	t, ok := types[path]
+	return t, ok
+}
+
+// Noun returns the noun of the type of taxonomy.
+func Noun(taxonomyType string) string {
+	return nouns[taxonomyType]
+}
+
+// Taxonomy is a category, a genre or a tag which the events are browsed by.
+type Taxonomy struct {
+	ID        string
+	Type      string
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewTaxonomy returns a new taxonomy of the given type which slug is derived from its name.
+func NewTaxonomy(taxonomyType, name string, now time.Time) Taxonomy {
+	return Taxonomy{
+		ID:        util.GenerateTimestampWithPrefix(taxonomyType),
+		Type:      taxonomyType,
+		Slug:      util.Slugify(name),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
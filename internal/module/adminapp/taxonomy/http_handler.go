@@ -0,0 +1,226 @@
+package taxonomy
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.AdminSession
+	Validate          *validator.Validate
+	TaxonomyUseCase   TaxonomyUseCase
+}
+
+// InitHTTPHandler registers the routes of the categories, the genres and the tags, they share the same handlers which
+// tell the type of the taxonomy by the path.
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, taxonomyUseCase TaxonomyUseCase) {
+	handler := &HTTPHandler{
+		Validate:        validate,
+		TaxonomyUseCase: taxonomyUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/{taxonomyType:categories|genres|tags}", publicMiddleware.SetRouteChain(handler.CreateTaxonomy, adminSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/adminapp/{taxonomyType:categories|genres|tags}", publicMiddleware.SetRouteChain(handler.GetManyTaxonomy, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/{taxonomyType:categories|genres|tags}/{taxonomyID}", publicMiddleware.SetRouteChain(handler.GetTaxonomy, adminSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/adminapp/{taxonomyType:categories|genres|tags}/{taxonomyID}", publicMiddleware.SetRouteChain(handler.UpdateTaxonomy, adminSession.Verify)).Methods(http.MethodPut)
+	router.HandleFunc("/tm-event/v1/adminapp/{taxonomyType:categories|genres|tags}/{taxonomyID}", publicMiddleware.SetRouteChain(handler.DeleteTaxonomy, adminSession.Verify)).Methods(http.MethodDelete)
+}
+
+func (handler HTTPHandler) CreateTaxonomy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := CreateTaxonomyRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.Type, _ = TypeFromPath(vars["taxonomyType"])
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.TaxonomyUseCase.CreateTaxonomy(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun(Noun(req.Type)), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyTaxonomy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyTaxonomyRequest{}
+	req.Type, _ = TypeFromPath(vars["taxonomyType"])
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.TaxonomyUseCase.GetManyTaxonomy(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun(Noun(req.Type))),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetTaxonomy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetTaxonomyRequest{
+		ID: vars["taxonomyID"],
+	}
+	req.Type, _ = TypeFromPath(vars["taxonomyType"])
+
+	resp, err := handler.TaxonomyUseCase.GetTaxonomy(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: Noun(req.Type),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) UpdateTaxonomy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := UpdateTaxonomyRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req.CreateTaxonomyRequest); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.ID = vars["taxonomyID"]
+	req.Type, _ = TypeFromPath(vars["taxonomyType"])
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.TaxonomyUseCase.UpdateTaxonomy(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun(Noun(req.Type)), i18n.Noun("updated")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) DeleteTaxonomy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := DeleteTaxonomyRequest{
+		ID: vars["taxonomyID"],
+	}
+	req.Type, _ = TypeFromPath(vars["taxonomyType"])
+
+	if err := handler.TaxonomyUseCase.DeleteTaxonomy(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun(Noun(req.Type)), i18n.Noun("deleted")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
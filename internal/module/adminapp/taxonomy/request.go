@@ -0,0 +1,52 @@
+package taxonomy
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreateTaxonomyRequest struct {
+	Type string `json:"-"`
+	Name string `json:"name" validate:"required,max=255"`
+	Slug string `json:"slug" validate:"omitempty,max=128"`
+}
+
+func (r CreateTaxonomyRequest) ToEntityTaxonomy(ctx context.Context, now time.Time) (Taxonomy, error) {
+	t := NewTaxonomy(r.Type, r.Name, now)
+	if r.Slug != "" {
+		t.Slug = util.Slugify(r.Slug)
+	}
+
+	if t.Slug == "" {
+		return Taxonomy{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.NameOrSlugRequired, i18n.Noun(Noun(r.Type))))
+	}
+
+	return t, nil
+}
+
+type UpdateTaxonomyRequest struct {
+	ID string `json:"-"`
+	CreateTaxonomyRequest
+}
+
+type GetManyTaxonomyRequest struct {
+	Type string
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
+
+type GetTaxonomyRequest struct {
+	Type string
+	ID   string
+}
+
+type DeleteTaxonomyRequest struct {
+	Type string
+	ID   string
+}
@@ -0,0 +1,26 @@
+package taxonomy
+
+import "time"
+
+type TaxonomyResponse struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r *TaxonomyResponse) PopulateFromEntity(t Taxonomy) {
+	r.ID = t.ID
+	r.Type = t.Type
+	r.Slug = t.Slug
+	r.Name = t.Name
+	r.CreatedAt = t.CreatedAt
+	r.UpdatedAt = t.UpdatedAt
+}
+
+type GetManyTaxonomyResponse struct {
+	Total      int64              `json:"total"`
+	Taxonomies []TaxonomyResponse `json:"taxonomies"`
+}
@@ -0,0 +1,217 @@
+package taxonomy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type TaxonomyRepository interface {
+	Save(ctx context.Context, t Taxonomy) error
+	FindByID(ctx context.Context, taxonomyType, ID string) (Taxonomy, error)
+	FindBySlug(ctx context.Context, taxonomyType, slug string) (Taxonomy, error)
+	FindMany(ctx context.Context, taxonomyType string, offset, limit int) ([]Taxonomy, error)
+	Count(ctx context.Context, taxonomyType string) (int64, error)
+	CountEvents(ctx context.Context, ID string) (int64, error)
+	Update(ctx context.Context, ID string, t Taxonomy) error
+	Delete(ctx context.Context, ID string) error
+}
+
+type taxonomyRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewTaxonomyRepository(logger *logrus.Logger, db *pgxpool.Pool) TaxonomyRepository {
+	return &taxonomyRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *taxonomyRepository) findOne(ctx context.Context, query string, taxonomyType, arg string) (Taxonomy, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	row := cmd.QueryRowContext(ctx, query, taxonomyType, arg)
+
+	var data Taxonomy
+	err := row.Scan(&data.ID, &data.Type, &data.Slug, &data.Name, &data.CreatedAt, &data.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Taxonomy{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun(Noun(taxonomyType)), arg))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Taxonomy{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while getting %s's prorperties", Noun(taxonomyType)))
+	}
+
+	return data, nil
+}
+
+// FindByID implements TaxonomyRepository.
+func (r *taxonomyRepository) FindByID(ctx context.Context, taxonomyType, ID string) (Taxonomy, error) {
+	query := `
+		SELECT
+			id, type, slug, name, created_at, updated_at
+		FROM taxonomy
+		WHERE
+			type = $1
+			AND id = $2
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, taxonomyType, ID)
+}
+
+// FindBySlug implements TaxonomyRepository.
+func (r *taxonomyRepository) FindBySlug(ctx context.Context, taxonomyType, slug string) (Taxonomy, error) {
+	query := `
+		SELECT
+			id, type, slug, name, created_at, updated_at
+		FROM taxonomy
+		WHERE
+			type = $1
+			AND slug = $2
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, taxonomyType, slug)
+}
+
+// FindMany implements TaxonomyRepository.
+func (r *taxonomyRepository) FindMany(ctx context.Context, taxonomyType string, offset int, limit int) ([]Taxonomy, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, type, slug, name, created_at, updated_at
+		FROM taxonomy
+		WHERE
+			type = $1
+		ORDER BY name ASC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, taxonomyType, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while getting bunch of %s's prorperties", Noun(taxonomyType)))
+	}
+
+	defer rows.Close()
+
+	var data = make([]Taxonomy, 0)
+	for rows.Next() {
+		var t Taxonomy
+		err := rows.Scan(&t.ID, &t.Type, &t.Slug, &t.Name, &t.CreatedAt, &t.UpdatedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while getting bunch of %s's prorperties", Noun(taxonomyType)))
+		}
+
+		data = append(data, t)
+	}
+
+	return data, nil
+}
+
+// Count implements TaxonomyRepository.
+func (r *taxonomyRepository) Count(ctx context.Context, taxonomyType string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM taxonomy WHERE type = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, taxonomyType)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while counting bunch of %s's prorperties", Noun(taxonomyType)))
+	}
+
+	return count, nil
+}
+
+// CountEvents implements TaxonomyRepository.
+func (r *taxonomyRepository) CountEvents(ctx context.Context, ID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(event_id) FROM event_taxonomy WHERE taxonomy_id = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, ID)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting events of taxonomy")
+	}
+
+	return count, nil
+}
+
+// Save implements TaxonomyRepository.
+func (r *taxonomyRepository) Save(ctx context.Context, t Taxonomy) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO taxonomy
+		(
+			id, type, slug, name, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, t.ID, t.Type, t.Slug, t.Name, t.CreatedAt, t.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while saving %s's prorperties", Noun(t.Type)))
+	}
+
+	return nil
+}
+
+// Update implements TaxonomyRepository.
+func (r *taxonomyRepository) Update(ctx context.Context, ID string, t Taxonomy) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE taxonomy
+		SET
+			slug = $1,
+			name = $2,
+			updated_at = $3
+		WHERE id = $4
+	`
+
+	_, err := cmd.ExecContext(ctx, query, t.Slug, t.Name, t.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while updating %s's prorperties", Noun(t.Type)))
+	}
+
+	return nil
+}
+
+// Delete implements TaxonomyRepository.
+func (r *taxonomyRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM taxonomy WHERE id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting taxonomy's prorperties")
+	}
+
+	return nil
+}
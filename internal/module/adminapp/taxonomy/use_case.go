@@ -0,0 +1,200 @@
+package taxonomy
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type TaxonomyUseCase interface {
+	CreateTaxonomy(ctx context.Context, req CreateTaxonomyRequest) (TaxonomyResponse, error)
+	GetManyTaxonomy(ctx context.Context, req GetManyTaxonomyRequest) (GetManyTaxonomyResponse, error)
+	GetTaxonomy(ctx context.Context, req GetTaxonomyRequest) (TaxonomyResponse, error)
+	UpdateTaxonomy(ctx context.Context, req UpdateTaxonomyRequest) (TaxonomyResponse, error)
+	DeleteTaxonomy(ctx context.Context, req DeleteTaxonomyRequest) error
+}
+
+type taxonomyUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	taxonomyRepository TaxonomyRepository
+}
+
+type TaxonomyUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	TaxonomyRepository TaxonomyRepository
+}
+
+func NewTaxonomyUseCase(props TaxonomyUseCaseProperty) TaxonomyUseCase {
+	return &taxonomyUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		taxonomyRepository: props.TaxonomyRepository,
+	}
+}
+
+// ensureUniqueSlug returns conflict if the slug is already taken by another taxonomy of the same type.
+func (u *taxonomyUseCase) ensureUniqueSlug(ctx context.Context, t Taxonomy) error {
+	existing, err := u.taxonomyRepository.FindBySlug(ctx, t.Type, t.Slug)
+	if err != nil {
+		if errors.MatchStatus(err, status.NOT_FOUND) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != t.ID {
+		noun := i18n.Noun(Noun(t.Type))
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.SlugUsed, noun, t.Slug, noun, existing.ID))
+	}
+
+	return nil
+}
+
+// CreateTaxonomy implements TaxonomyUseCase.
+func (u *taxonomyUseCase) CreateTaxonomy(ctx context.Context, req CreateTaxonomyRequest) (TaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	t, err := req.ToEntityTaxonomy(ctx, time.Now())
+	if err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	if err := u.ensureUniqueSlug(ctx, t); err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	if err := u.taxonomyRepository.Save(ctx, t); err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	resp := TaxonomyResponse{}
+	resp.PopulateFromEntity(t)
+
+	return resp, nil
+}
+
+// GetManyTaxonomy implements TaxonomyUseCase.
+func (u *taxonomyUseCase) GetManyTaxonomy(ctx context.Context, req GetManyTaxonomyRequest) (GetManyTaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var taxonomies []Taxonomy
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.taxonomyRepository.Count(gctx, req.Type)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.taxonomyRepository.FindMany(gctx, req.Type, offset, limit)
+		if err != nil {
+			return err
+		}
+		taxonomies = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyTaxonomyResponse{}, err
+	}
+
+	resp := GetManyTaxonomyResponse{
+		Total:      total,
+		Taxonomies: make([]TaxonomyResponse, len(taxonomies)),
+	}
+
+	for k, v := range taxonomies {
+		resp.Taxonomies[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+// GetTaxonomy implements TaxonomyUseCase.
+func (u *taxonomyUseCase) GetTaxonomy(ctx context.Context, req GetTaxonomyRequest) (TaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	t, err := u.taxonomyRepository.FindByID(ctx, req.Type, req.ID)
+	if err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	resp := TaxonomyResponse{}
+	resp.PopulateFromEntity(t)
+
+	return resp, nil
+}
+
+// UpdateTaxonomy implements TaxonomyUseCase.
+func (u *taxonomyUseCase) UpdateTaxonomy(ctx context.Context, req UpdateTaxonomyRequest) (TaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	current, err := u.taxonomyRepository.FindByID(ctx, req.Type, req.ID)
+	if err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	t, err := req.ToEntityTaxonomy(ctx, time.Now())
+	if err != nil {
+		return TaxonomyResponse{}, err
+	}
+	t.ID = current.ID
+	t.CreatedAt = current.CreatedAt
+
+	if err := u.ensureUniqueSlug(ctx, t); err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	if err := u.taxonomyRepository.Update(ctx, t.ID, t); err != nil {
+		return TaxonomyResponse{}, err
+	}
+
+	resp := TaxonomyResponse{}
+	resp.PopulateFromEntity(t)
+
+	return resp, nil
+}
+
+// DeleteTaxonomy implements TaxonomyUseCase. A taxonomy which is still attached to an event can not be deleted.
+func (u *taxonomyUseCase) DeleteTaxonomy(ctx context.Context, req DeleteTaxonomyRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	t, err := u.taxonomyRepository.FindByID(ctx, req.Type, req.ID)
+	if err != nil {
+		return err
+	}
+
+	count, err := u.taxonomyRepository.CountEvents(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.StillLinked, i18n.Noun(Noun(t.Type)), t.ID, count))
+	}
+
+	return u.taxonomyRepository.Delete(ctx, t.ID)
+}
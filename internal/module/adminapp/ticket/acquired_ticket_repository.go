@@ -0,0 +1,136 @@
+package ticket
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type AcquiredTicketRepository interface {
+	Save(ctx context.Context, aq AcquiredTicket) (int64, error)
+	SaveMany(ctx context.Context, aqs []AcquiredTicket) ([]int64, error)
+}
+
+type acquiredTicketRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewAcquiredTicketRepository(logger *logrus.Logger, db *pgxpool.Pool) AcquiredTicketRepository {
+	return &acquiredTicketRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements AcquiredTicketRepository.
+func (r *acquiredTicketRepository) Save(ctx context.Context, aq AcquiredTicket) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO acquired_ticket
+		(
+			"number", event_id, show_id, tier, ticket_stock_id, ticket_hold_id, event_name, show_venue, show_type, show_country, show_city,
+			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id, sequence, quantity, price, currency
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, $19, $20, $21, $22
+		)
+		RETURNING id
+	`
+
+	row := cmd.QueryRowContext(ctx, query, aq.Number, aq.EventID, aq.ShowID, aq.Tier, aq.TicketStockID, aq.TicketHoldID,
+		aq.EventName, aq.ShowVenue, aq.ShowType, aq.ShowCountry, aq.ShowCity, aq.ShowFormattedAddress,
+		aq.ShowTime, aq.CustomerName, aq.CustomerEmail, aq.CustomerID, aq.CreatedAt, aq.OrderID, aq.Sequence, aq.Quantity, aq.Price.Amount, aq.Price.Currency,
+	)
+	var ID int64
+	err := row.Scan(&ID)
+	if err != nil {
+		if code, constraint, ok := postgresql.ConstraintViolation(err); ok {
+			switch {
+			case code == postgresql.UniqueViolation && constraint == "acquired_ticket_number_idx":
+				return 0, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketNumberExists, aq.Number))
+			case code == postgresql.UniqueViolation:
+				return 0, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketsIssued, aq.OrderID, aq.TicketStockID))
+			case code == postgresql.ForeignKeyViolation:
+				return 0, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), aq.TicketStockID))
+			}
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving acquired ticket's prorperties")
+	}
+
+	return ID, nil
+}
+
+// SaveMany implements AcquiredTicketRepository. The tickets are copied in one round trip, their ids are taken from the
+// sequence up front since a copy does not return them.
+func (r *acquiredTicketRepository) SaveMany(ctx context.Context, aqs []AcquiredTicket) ([]int64, error) {
+	if len(aqs) < 1 {
+		return []int64{}, nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT nextval(pg_get_serial_sequence('acquired_ticket', 'id')) FROM generate_series(1, $1)`
+
+	rows, err := cmd.QueryContext(ctx, query, len(aqs))
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	defer rows.Close()
+
+	IDs := make([]int64, 0, len(aqs))
+	for rows.Next() {
+		var ID int64
+		if err := rows.Scan(&ID); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+		}
+		IDs = append(IDs, ID)
+	}
+	// the connection has to be free of the rows before the copy runs on it
+	rows.Close()
+
+	columns := []string{
+		"id", "number", "event_id", "show_id", "tier", "ticket_stock_id", "ticket_hold_id", "event_name", "show_venue", "show_type", "show_country", "show_city",
+		"show_formatted_address", "show_time", "customer_name", "customer_email", "customer_id", "created_at", "order_id", "sequence", "quantity", "price", "currency",
+	}
+
+	data := make([][]interface{}, len(aqs))
+	for k, aq := range aqs {
+		data[k] = []interface{}{IDs[k], aq.Number, aq.EventID, aq.ShowID, aq.Tier, aq.TicketStockID, aq.TicketHoldID,
+			aq.EventName, aq.ShowVenue, aq.ShowType, aq.ShowCountry, aq.ShowCity, aq.ShowFormattedAddress,
+			aq.ShowTime, aq.CustomerName, aq.CustomerEmail, aq.CustomerID, aq.CreatedAt, aq.OrderID, aq.Sequence, aq.Quantity, aq.Price.Amount, aq.Price.Currency,
+		}
+	}
+
+	if _, err := postgresql.CopyFrom(ctx, r.db, "acquired_ticket", columns, data); err != nil {
+		if code, constraint, ok := postgresql.ConstraintViolation(err); ok {
+			switch {
+			case code == postgresql.UniqueViolation && constraint == "acquired_ticket_number_idx":
+				return nil, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketNumbersExist))
+			case code == postgresql.UniqueViolation:
+				return nil, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketsIssued, aqs[0].OrderID, aqs[0].TicketStockID))
+			case code == postgresql.ForeignKeyViolation:
+				return nil, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), aqs[0].TicketStockID))
+			}
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	return IDs, nil
+}
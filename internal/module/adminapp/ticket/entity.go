@@ -1,6 +1,10 @@
 package ticket
 
-import "time"
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
 
 type TicketStock struct {
 	EventID         string
@@ -9,11 +13,19 @@ type TicketStock struct {
 	OnlineFor       *string
 	Tier            string
 	Allocation      int64
-	Price           float64
+	Price           money.Money
 	Acquired        int64
+	Held            int64
+	Reserved        int64
 	LastStockUpdate time.Time
 }
 
+// Available returns the number of tickets which are left for the general sale, the tickets which are held back for
+// comps, sponsors and the box office and the tickets which are reserved for the waitlisted customers are not.
+func (ts TicketStock) Available() int64 {
+	return ts.Allocation - ts.Acquired - ts.Held - ts.Reserved
+}
+
 type TicketStockJournal struct {
 	TicketStockID string
 	ID            int
@@ -24,13 +36,26 @@ type TicketStockJournal struct {
 }
 
 type AcquiredTicket struct {
-	EventID       string
-	ShowID        string
-	TicketStockID string
-	Number        string
-	CustomerID    int64
-	CustomerEmail string
-	CustomerName  string
-	ShowTime      time.Time
-	OrderID       string
+	ID                   int64
+	Number               string
+	EventID              string
+	ShowID               string
+	Tier                 string
+	TicketStockID        string
+	TicketHoldID         *string
+	EventName            string
+	ShowVenue            string
+	ShowType             string
+	ShowCountry          string
+	ShowCity             string
+	ShowFormattedAddress string
+	ShowTime             time.Time
+	CustomerName         string
+	CustomerEmail        string
+	CustomerID           int64
+	CreatedAt            time.Time
+	OrderID              string
+	Sequence             int64
+	Quantity             int64
+	Price                money.Money
 }
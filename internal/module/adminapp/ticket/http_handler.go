@@ -0,0 +1,78 @@
+package ticket
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware  *middleware.AdminSession
+	Validate           *validator.Validate
+	TicketStockUseCase TicketStockUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, adminSession *middleware.AdminSession, validate *validator.Validate, ticketStockUseCase TicketStockUseCase) {
+	handler := &HTTPHandler{
+		Validate:           validate,
+		TicketStockUseCase: ticketStockUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/adminapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/allocation", publicMiddleware.SetRouteChain(handler.UpdateTicketStockAllocation, adminSession.Verify)).Methods(http.MethodPut)
+}
+
+func (handler HTTPHandler) UpdateTicketStockAllocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := UpdateTicketStockAllocationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.ID = vars["ticketStockID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.TicketStockUseCase.UpdateTicketStockAllocation(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("ticket stock"), i18n.Noun("updated")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
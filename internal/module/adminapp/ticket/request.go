@@ -0,0 +1,8 @@
+package ticket
+
+type UpdateTicketStockAllocationRequest struct {
+	EventID    string `json:"-"`
+	ShowID     string `json:"-"`
+	ID         string `json:"-"`
+	Allocation int64  `json:"allocation" validate:"required,gt=0"`
+}
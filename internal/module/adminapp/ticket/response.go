@@ -0,0 +1,35 @@
+package ticket
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type TicketStockResponse struct {
+	ID              string      `json:"id"`
+	EventID         string      `json:"event_id"`
+	ShowID          string      `json:"show_id"`
+	Tier            string      `json:"tier"`
+	Price           money.Money `json:"price"`
+	Allocation      int64       `json:"allocation"`
+	Acquired        int64       `json:"acquired"`
+	Held            int64       `json:"held"`
+	Reserved        int64       `json:"reserved"`
+	Available       int64       `json:"available"`
+	LastStockUpdate time.Time   `json:"last_stock_update"`
+}
+
+func (r *TicketStockResponse) PopulateFromEntity(ts TicketStock) {
+	r.ID = ts.ID
+	r.EventID = ts.EventID
+	r.ShowID = ts.ShowID
+	r.Tier = ts.Tier
+	r.Price = ts.Price
+	r.Allocation = ts.Allocation
+	r.Acquired = ts.Acquired
+	r.Held = ts.Held
+	r.Reserved = ts.Reserved
+	r.Available = ts.Available()
+	r.LastStockUpdate = ts.LastStockUpdate
+}
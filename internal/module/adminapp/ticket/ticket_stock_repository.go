@@ -4,63 +4,148 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type TicketStockRepository interface {
-	Save(ctx context.Context, ts TicketStock, tx *sql.Tx) error
-	FindManyByShowID(ctx context.Context, showID string, tx *sql.Tx) ([]TicketStock, error)
-}
-
-type sqlCommand interface {
-	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
-	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
-	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	Save(ctx context.Context, ts TicketStock) error
+	SaveMany(ctx context.Context, stocks []TicketStock) error
+	FindManyByShowID(ctx context.Context, showID string) ([]TicketStock, error)
+	FindByID(ctx context.Context, ID string) (TicketStock, error)
+	FindByIDForUpdate(ctx context.Context, ID string) (TicketStock, error)
+	Update(ctx context.Context, ID string, ts TicketStock) error
 }
 
 type ticketStockRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewTicketStockRepository(logger *logrus.Logger, db *sql.DB) TicketStockRepository {
+func NewTicketStockRepository(logger *logrus.Logger, db *pgxpool.Pool) TicketStockRepository {
 	return &ticketStockRepository{
 		logger: logger,
 		db:     db,
 	}
 }
 
-// FindManyByShowID implements TicketStockRepository.
-func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID string, tx *sql.Tx) ([]TicketStock, error) {
-	var cmd sqlCommand = r.db
+// FindByID implements TicketStockRepository.
+func (r *ticketStockRepository) FindByID(ctx context.Context, ID string) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+		FROM ticket_stock
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
 
-	if tx != nil {
-		cmd = tx
+	var data TicketStock
+	var onlineFor sql.NullString
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties")
+	}
+
+	if onlineFor.Valid {
+		data.OnlineFor = &onlineFor.String
 	}
 
+	return data, nil
+}
+
+// FindByIDForUpdate implements TicketStockRepository.
+func (r *ticketStockRepository) FindByIDForUpdate(ctx context.Context, ID string) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
 	query := `
-		SELECT 
-			id, tier, allocation, price, acquired, last_stock_update, online_for, show_id, event_id
+		SELECT
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
 		FROM ticket_stock
 		WHERE
 			id = $1
+		FOR UPDATE
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data TicketStock
+	var onlineFor sql.NullString
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), ID))
+		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties for update")
+	}
+
+	if onlineFor.Valid {
+		data.OnlineFor = &onlineFor.String
 	}
-	defer stmt.Close()
 
-	rows, err := stmt.QueryContext(ctx, showID)
+	return data, nil
+}
+
+// Update implements TicketStockRepository.
+func (r *ticketStockRepository) Update(ctx context.Context, ID string, ts TicketStock) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE ticket_stock
+		SET
+			allocation = $1,
+			acquired = $2,
+			held = $3,
+			reserved = $4,
+			last_stock_update = $5
+		WHERE
+			id = $6
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ts.Allocation, ts.Acquired, ts.Held, ts.Reserved, ts.LastStockUpdate, ID)
 	if err != nil {
+		if code, _, ok := postgresql.ConstraintViolation(err); ok && code == postgresql.CheckViolation {
+			return errors.New(http.StatusConflict, status.SOLD_OUT, i18n.Message(ctx, i18n.SoldOut, ID))
+		}
+
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")
+	}
+
+	return nil
+}
+
+// FindManyByShowID implements TicketStockRepository.
+func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID string) ([]TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT 
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+		FROM ticket_stock
+		WHERE
+			show_id = $1
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, showID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
 	}
 
 	defer rows.Close()
@@ -69,10 +154,10 @@ func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID str
 	for rows.Next() {
 		var ts TicketStock
 		var onlineFor sql.NullString
-		err := rows.Scan(&ts.ID, &ts.Tier, &ts.Allocation, &ts.Price, &ts.Acquired, &ts.LastStockUpdate, &onlineFor, &ts.ShowID, &ts.EventID)
+		err := rows.Scan(&ts.ID, &ts.Tier, &ts.Allocation, &ts.Price.Amount, &ts.Price.Currency, &ts.Acquired, &ts.Held, &ts.Reserved, &ts.LastStockUpdate, &onlineFor, &ts.ShowID, &ts.EventID)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
 		}
 
 		if onlineFor.Valid {
@@ -86,41 +171,94 @@ func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID str
 }
 
 // Save implements TicketStockRepository.
-func (r *ticketStockRepository) Save(ctx context.Context, ts TicketStock, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *ticketStockRepository) Save(ctx context.Context, ts TicketStock) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO ticket_stock
 		(
-			id, tier, allocation, price, acquired, last_stock_update, online_for, show_id, event_id
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
 		)
 		VALUES
 		(
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
-	}
-	defer stmt.Close()
-
 	var onlineFor sql.NullString
 	if ts.OnlineFor != nil {
 		onlineFor.Valid = true
 		onlineFor.String = *ts.OnlineFor
 	}
 
-	_, err = stmt.ExecContext(ctx, ts.ID, ts.Tier, ts.Allocation, ts.Price, ts.Acquired, ts.LastStockUpdate, onlineFor, ts.ShowID, ts.EventID)
+	_, err := cmd.ExecContext(ctx, query, ts.ID, ts.Tier, ts.Allocation, ts.Price.Amount, ts.Price.Currency, ts.Acquired, ts.Held, ts.Reserved, ts.LastStockUpdate, onlineFor, ts.ShowID, ts.EventID)
+	if err != nil {
+		if code, _, ok := postgresql.ConstraintViolation(err); ok {
+			switch code {
+			case postgresql.UniqueViolation:
+				return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.AlreadyExistsByID, i18n.Noun("ticket stock"), ts.ID))
+			case postgresql.ForeignKeyViolation:
+				return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), ts.ShowID))
+			case postgresql.CheckViolation:
+				return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.AllocationTooLow, ts.ID, ts.Acquired+ts.Held+ts.Reserved))
+			}
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
+	}
+
+	return nil
+}
+
+// SaveMany implements TicketStockRepository. The ticket stocks are inserted by one statement which is queued in the
+// batch of the event, its constraint violations are told apart once the batch is sent.
+func (r *ticketStockRepository) SaveMany(ctx context.Context, stocks []TicketStock) error {
+	if len(stocks) < 1 {
+		return nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_stock
+		(
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+		)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::int8[], $4::numeric[], $5::varchar[], $6::int8[], $7::int8[], $8::int8[], $9::timestamptz[], $10::varchar[], $11::varchar[], $12::varchar[])
+	`
+
+	IDs := make([]string, len(stocks))
+	tiers := make([]string, len(stocks))
+	allocations := make([]int64, len(stocks))
+	prices := make([]string, len(stocks))
+	currencies := make([]string, len(stocks))
+	acquired := make([]int64, len(stocks))
+	held := make([]int64, len(stocks))
+	reserved := make([]int64, len(stocks))
+	lastStockUpdates := make([]time.Time, len(stocks))
+	onlineFor := make([]*string, len(stocks))
+	showIDs := make([]string, len(stocks))
+	eventIDs := make([]string, len(stocks))
+	for k, ts := range stocks {
+		IDs[k] = ts.ID
+		tiers[k] = ts.Tier
+		allocations[k] = ts.Allocation
+		prices[k] = ts.Price.Amount.String()
+		currencies[k] = ts.Price.Currency
+		acquired[k] = ts.Acquired
+		held[k] = ts.Held
+		reserved[k] = ts.Reserved
+		lastStockUpdates[k] = ts.LastStockUpdate
+		onlineFor[k] = ts.OnlineFor
+		showIDs[k] = ts.ShowID
+		eventIDs[k] = ts.EventID
+	}
+
+	_, err := cmd.ExecContext(ctx, query, IDs, tiers, allocations, prices, currencies, acquired, held, reserved, lastStockUpdates, onlineFor, showIDs, eventIDs)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of ticket stock's prorperties")
 	}
 
 	return nil
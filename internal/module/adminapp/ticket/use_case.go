@@ -0,0 +1,148 @@
+package ticket
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// WaitlistOfferer offers the tickets which are back on the general sale to the customers who wait for them.
+type WaitlistOfferer interface {
+	OfferReturnedStock(ctx context.Context, ticketStockID string) error
+}
+
+type TicketStockUseCase interface {
+	UpdateTicketStockAllocation(ctx context.Context, req UpdateTicketStockAllocationRequest) (TicketStockResponse, error)
+}
+
+type ticketStockUseCase struct {
+	logger                *logrus.Logger
+	location              *time.Location
+	timeout               time.Duration
+	ticketStockRepository TicketStockRepository
+	venueRepository       venue.VenueRepository
+	waitlistOfferer       WaitlistOfferer
+	txManager             postgresql.TxManager
+}
+
+type TicketStockUseCaseProperty struct {
+	Logger                *logrus.Logger
+	Location              *time.Location
+	Timeout               time.Duration
+	TicketStockRepository TicketStockRepository
+	VenueRepository       venue.VenueRepository
+	WaitlistOfferer       WaitlistOfferer
+	TxManager             postgresql.TxManager
+}
+
+func NewTicketStockUseCase(props TicketStockUseCaseProperty) TicketStockUseCase {
+	return &ticketStockUseCase{
+		logger:                props.Logger,
+		location:              props.Location,
+		timeout:               props.Timeout,
+		ticketStockRepository: props.TicketStockRepository,
+		venueRepository:       props.VenueRepository,
+		waitlistOfferer:       props.WaitlistOfferer,
+		txManager:             props.TxManager,
+	}
+}
+
+// ensureVenueCapacity checks that the ticket stocks of the show do not exceed the capacity of its venue once the ticket
+// stock is given the allocation, the same as the total ticket allocation of a show when it is created. The online ticket
+// stocks and the shows without a venue are not bound by a capacity.
+func (u *ticketStockUseCase) ensureVenueCapacity(ctx context.Context, ts TicketStock, allocation int64) error {
+	if ts.OnlineFor != nil {
+		return nil
+	}
+
+	v, err := u.venueRepository.FindByShowID(ctx, ts.ShowID)
+	if err != nil {
+		if errors.MatchStatus(err, status.NOT_FOUND) {
+			return nil
+		}
+		return err
+	}
+
+	stocks, err := u.ticketStockRepository.FindManyByShowID(ctx, ts.ShowID)
+	if err != nil {
+		return err
+	}
+
+	total := allocation
+	for _, s := range stocks {
+		if s.ID == ts.ID || s.OnlineFor != nil {
+			continue
+		}
+		total += s.Allocation
+	}
+
+	if total > v.Capacity {
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.VenueCapacityExceeded, total, v.Capacity, v.Name))
+	}
+
+	return nil
+}
+
+// UpdateTicketStockAllocation implements TicketStockUseCase. The allocation can not be lowered below the tickets which
+// are acquired, held or reserved nor raised beyond the capacity of the venue, the added tickets are offered to the
+// waitlist first.
+func (u *ticketStockUseCase) UpdateTicketStockAllocation(ctx context.Context, req UpdateTicketStockAllocationRequest) (TicketStockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var ts TicketStock
+	var increased bool
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		ts, err = u.ticketStockRepository.FindByIDForUpdate(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if ts.EventID != req.EventID || ts.ShowID != req.ShowID {
+			return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), req.ID))
+		}
+
+		if taken := ts.Allocation - ts.Available(); req.Allocation < taken {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.AllocationTooLow, ts.ID, taken))
+		}
+
+		increased = req.Allocation > ts.Allocation
+		if increased {
+			if err := u.ensureVenueCapacity(ctx, ts, req.Allocation); err != nil {
+				return err
+			}
+		}
+
+		ts.Allocation = req.Allocation
+		ts.LastStockUpdate = time.Now()
+
+		return u.ticketStockRepository.Update(ctx, ts.ID, ts)
+	})
+	if err != nil {
+		return TicketStockResponse{}, err
+	}
+
+	if increased {
+		// the allocation is updated regardless of the waitlist, the scheduler of the waitlist retries a failed offer
+		if err := u.waitlistOfferer.OfferReturnedStock(ctx, ts.ID); err != nil {
+			u.logger.WithContext(ctx).WithError(err).Error()
+		}
+
+		if updated, err := u.ticketStockRepository.FindByID(ctx, ts.ID); err == nil {
+			ts = updated
+		}
+	}
+
+	resp := TicketStockResponse{}
+	resp.PopulateFromEntity(ts)
+
+	return resp, nil
+}
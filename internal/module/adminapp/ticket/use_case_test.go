@@ -0,0 +1,174 @@
+package ticket_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the allocation update is not expected to call
+// panics.
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	stocks map[string]ticket.TicketStock
+}
+
+func (s ticketStockStore) FindByID(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	ts, ok := s.stocks[ID]
+	if !ok {
+		return ticket.TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return ts, nil
+}
+
+func (s ticketStockStore) FindByIDForUpdate(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	return s.FindByID(ctx, ID)
+}
+
+func (s ticketStockStore) FindManyByShowID(ctx context.Context, showID string) ([]ticket.TicketStock, error) {
+	data := make([]ticket.TicketStock, 0)
+	for _, ts := range s.stocks {
+		if ts.ShowID == showID {
+			data = append(data, ts)
+		}
+	}
+
+	return data, nil
+}
+
+func (s ticketStockStore) Update(ctx context.Context, ID string, ts ticket.TicketStock) error {
+	s.stocks[ID] = ts
+	return nil
+}
+
+type venueStore struct {
+	venue.VenueRepository
+	venues map[string]venue.Venue
+}
+
+func (s venueStore) FindByShowID(ctx context.Context, showID string) (venue.Venue, error) {
+	v, ok := s.venues[showID]
+	if !ok {
+		return venue.Venue{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return v, nil
+}
+
+type offerer struct {
+	ticketStockIDs []string
+}
+
+func (o *offerer) OfferReturnedStock(ctx context.Context, ticketStockID string) error {
+	o.ticketStockIDs = append(o.ticketStockIDs, ticketStockID)
+	return nil
+}
+
+// txManager runs fn right away, the updates of a test are not concurrent.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestTicketStockUseCaseUpdateTicketStockAllocation(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	onlineFor := "PARTNER"
+
+	// newStocks returns the ticket stocks of a show at a venue of 100 seats, the online ones do not take a seat
+	newStocks := func() ticketStockStore {
+		return ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {EventID: "EVENT1", ShowID: "SHOW1", ID: "TS1", Tier: "GOLD", Allocation: 40, Acquired: 10, Held: 5, Reserved: 5},
+			"TS2": {EventID: "EVENT1", ShowID: "SHOW1", ID: "TS2", Tier: "SILVER", Allocation: 50},
+			"TS3": {EventID: "EVENT1", ShowID: "SHOW1", ID: "TS3", Tier: "ONLINE", Allocation: 1000, OnlineFor: &onlineFor},
+		}}
+	}
+
+	newUseCase := func(stocks ticketStockStore, o *offerer) ticket.TicketStockUseCase {
+		return ticket.NewTicketStockUseCase(ticket.TicketStockUseCaseProperty{
+			Logger:                logger,
+			Location:              time.UTC,
+			Timeout:               10 * time.Second,
+			TicketStockRepository: stocks,
+			VenueRepository:       venueStore{venues: map[string]venue.Venue{"SHOW1": {ID: "VENUE1", Name: "Hall", Capacity: 100}}},
+			WaitlistOfferer:       o,
+			TxManager:             txManager{},
+		})
+	}
+
+	update := func(uc ticket.TicketStockUseCase, ID string, allocation int64) (ticket.TicketStockResponse, error) {
+		return uc.UpdateTicketStockAllocation(context.Background(), ticket.UpdateTicketStockAllocationRequest{
+			EventID: "EVENT1", ShowID: "SHOW1", ID: ID, Allocation: allocation,
+		})
+	}
+
+	t.Run("an allocation which fills the venue up is raised and offered to the waitlist", func(t *testing.T) {
+		stocks := newStocks()
+		o := &offerer{}
+
+		resp, err := update(newUseCase(stocks, o), "TS1", 50)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(50), resp.Allocation)
+		assert.Equal(t, int64(50), stocks.stocks["TS1"].Allocation)
+		assert.Equal(t, []string{"TS1"}, o.ticketStockIDs)
+	})
+
+	t.Run("an allocation beyond the capacity of the venue is rejected", func(t *testing.T) {
+		stocks := newStocks()
+		o := &offerer{}
+
+		_, err := update(newUseCase(stocks, o), "TS1", 51)
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+
+		assert.Equal(t, int64(40), stocks.stocks["TS1"].Allocation)
+		assert.Empty(t, o.ticketStockIDs)
+	})
+
+	t.Run("an online ticket stock is not bound by the capacity of the venue", func(t *testing.T) {
+		stocks := newStocks()
+
+		_, err := update(newUseCase(stocks, &offerer{}), "TS3", 5000)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(5000), stocks.stocks["TS3"].Allocation)
+	})
+
+	t.Run("an allocation can not be lowered below the tickets which are taken", func(t *testing.T) {
+		stocks := newStocks()
+		o := &offerer{}
+
+		_, err := update(newUseCase(stocks, o), "TS1", 19)
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+
+		_, err = update(newUseCase(stocks, o), "TS1", 20)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(20), stocks.stocks["TS1"].Allocation)
+		assert.Empty(t, o.ticketStockIDs)
+	})
+
+	t.Run("a ticket stock of another show is not found", func(t *testing.T) {
+		_, err := newUseCase(newStocks(), &offerer{}).UpdateTicketStockAllocation(context.Background(), ticket.UpdateTicketStockAllocationRequest{
+			EventID: "EVENT1", ShowID: "SHOW2", ID: "TS1", Allocation: 40,
+		})
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+	})
+}
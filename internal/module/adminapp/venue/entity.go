@@ -0,0 +1,18 @@
+package venue
+
+import "time"
+
+type Venue struct {
+	ID               string
+	Name             string
+	Country          string
+	City             string
+	FormattedAddress string
+	Latitude         float64
+	Longitude        float64
+	Capacity         int64
+	Timezone         string
+	DefaultSeatMapID *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,63 @@
+package venue
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type CreateVenueRequest struct {
+	Name             string  `json:"name" validate:"required"`
+	Country          string  `json:"country" validate:"required"`
+	City             string  `json:"city" validate:"required"`
+	FormattedAddress string  `json:"formatted_address" validate:"formatted_address"`
+	Latitude         float64 `json:"latitude" validate:"latitude"`
+	Longitude        float64 `json:"longitude" validate:"longitude"`
+	Capacity         int64   `json:"capacity" validate:"required,min=1"`
+	Timezone         string  `json:"timezone" validate:"required"`
+	DefaultSeatMapID *string `json:"default_seat_map_id" validate:"omitempty,min=1"`
+}
+
+func (r CreateVenueRequest) ToEntityVenue(ctx context.Context, now time.Time) (Venue, error) {
+	if _, err := time.LoadLocation(r.Timezone); err != nil {
+		return Venue{}, errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.InvalidTimezone, r.Timezone))
+	}
+
+	return Venue{
+		ID:               util.GenerateTimestampWithPrefix("VENUE"),
+		Name:             r.Name,
+		Country:          r.Country,
+		City:             r.City,
+		FormattedAddress: r.FormattedAddress,
+		Latitude:         r.Latitude,
+		Longitude:        r.Longitude,
+		Capacity:         r.Capacity,
+		Timezone:         r.Timezone,
+		DefaultSeatMapID: r.DefaultSeatMapID,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+type UpdateVenueRequest struct {
+	ID string `json:"-"`
+	CreateVenueRequest
+}
+
+type GetManyVenueRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
+
+type GetVenueRequest struct {
+	ID string
+}
+
+type DeleteVenueRequest struct {
+	ID string
+}
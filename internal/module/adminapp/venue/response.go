@@ -0,0 +1,38 @@
+package venue
+
+import "time"
+
+type VenueResponse struct {
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Country          string    `json:"country"`
+	City             string    `json:"city"`
+	FormattedAddress string    `json:"formatted_address"`
+	Latitude         float64   `json:"latitude"`
+	Longitude        float64   `json:"longitude"`
+	Capacity         int64     `json:"capacity"`
+	Timezone         string    `json:"timezone"`
+	DefaultSeatMapID *string   `json:"default_seat_map_id"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+func (r *VenueResponse) PopulateFromEntity(v Venue) {
+	r.ID = v.ID
+	r.Name = v.Name
+	r.Country = v.Country
+	r.City = v.City
+	r.FormattedAddress = v.FormattedAddress
+	r.Latitude = v.Latitude
+	r.Longitude = v.Longitude
+	r.Capacity = v.Capacity
+	r.Timezone = v.Timezone
+	r.DefaultSeatMapID = v.DefaultSeatMapID
+	r.CreatedAt = v.CreatedAt
+	r.UpdatedAt = v.UpdatedAt
+}
+
+type GetManyVenueResponse struct {
+	Total  int64           `json:"total"`
+	Venues []VenueResponse `json:"venues"`
+}
@@ -0,0 +1,174 @@
+package venue
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type VenueUseCase interface {
+	CreateVenue(ctx context.Context, req CreateVenueRequest) (VenueResponse, error)
+	GetManyVenue(ctx context.Context, req GetManyVenueRequest) (GetManyVenueResponse, error)
+	GetVenue(ctx context.Context, req GetVenueRequest) (VenueResponse, error)
+	UpdateVenue(ctx context.Context, req UpdateVenueRequest) (VenueResponse, error)
+	DeleteVenue(ctx context.Context, req DeleteVenueRequest) error
+}
+
+type venueUseCase struct {
+	logger          *logrus.Logger
+	location        *time.Location
+	timeout         time.Duration
+	venueRepository VenueRepository
+}
+
+type VenueUseCaseProperty struct {
+	Logger          *logrus.Logger
+	Location        *time.Location
+	Timeout         time.Duration
+	VenueRepository VenueRepository
+}
+
+func NewVenueUseCase(props VenueUseCaseProperty) VenueUseCase {
+	return &venueUseCase{
+		logger:          props.Logger,
+		location:        props.Location,
+		timeout:         props.Timeout,
+		venueRepository: props.VenueRepository,
+	}
+}
+
+// CreateVenue implements VenueUseCase.
+func (u *venueUseCase) CreateVenue(ctx context.Context, req CreateVenueRequest) (VenueResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	v, err := req.ToEntityVenue(ctx, time.Now())
+	if err != nil {
+		return VenueResponse{}, err
+	}
+
+	if err := u.venueRepository.Save(ctx, v); err != nil {
+		return VenueResponse{}, err
+	}
+
+	resp := VenueResponse{}
+	resp.PopulateFromEntity(v)
+
+	return resp, nil
+}
+
+// GetManyVenue implements VenueUseCase.
+func (u *venueUseCase) GetManyVenue(ctx context.Context, req GetManyVenueRequest) (GetManyVenueResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var venues []Venue
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.venueRepository.Count(gctx)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.venueRepository.FindMany(gctx, offset, limit)
+		if err != nil {
+			return err
+		}
+		venues = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyVenueResponse{}, err
+	}
+
+	resp := GetManyVenueResponse{
+		Total:  total,
+		Venues: make([]VenueResponse, len(venues)),
+	}
+
+	for k, v := range venues {
+		resp.Venues[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+// GetVenue implements VenueUseCase.
+func (u *venueUseCase) GetVenue(ctx context.Context, req GetVenueRequest) (VenueResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	v, err := u.venueRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return VenueResponse{}, err
+	}
+
+	resp := VenueResponse{}
+	resp.PopulateFromEntity(v)
+
+	return resp, nil
+}
+
+// UpdateVenue implements VenueUseCase.
+func (u *venueUseCase) UpdateVenue(ctx context.Context, req UpdateVenueRequest) (VenueResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	current, err := u.venueRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return VenueResponse{}, err
+	}
+
+	v, err := req.ToEntityVenue(ctx, time.Now())
+	if err != nil {
+		return VenueResponse{}, err
+	}
+	v.ID = current.ID
+	v.CreatedAt = current.CreatedAt
+
+	if err := u.venueRepository.Update(ctx, v.ID, v); err != nil {
+		return VenueResponse{}, err
+	}
+
+	resp := VenueResponse{}
+	resp.PopulateFromEntity(v)
+
+	return resp, nil
+}
+
+// DeleteVenue implements VenueUseCase. A venue which is still referenced by a show can not be deleted.
+func (u *venueUseCase) DeleteVenue(ctx context.Context, req DeleteVenueRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	v, err := u.venueRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	count, err := u.venueRepository.CountShows(ctx, v.ID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.VenueStillUsed, v.ID, count))
+	}
+
+	return u.venueRepository.Delete(ctx, v.ID)
+}
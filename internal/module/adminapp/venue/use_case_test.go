@@ -0,0 +1,131 @@
+package venue_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/venue"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// venueStore is an in-memory stand-in of the venue table along with the number of shows which reference each venue,
+// it embeds the interface it stands in for so a method which the use case is not expected to call panics.
+type venueStore struct {
+	venue.VenueRepository
+	venues map[string]venue.Venue
+	shows  map[string]int64
+}
+
+func (s venueStore) Save(ctx context.Context, v venue.Venue) error {
+	s.venues[v.ID] = v
+	return nil
+}
+
+func (s venueStore) FindByID(ctx context.Context, ID string) (venue.Venue, error) {
+	v, ok := s.venues[ID]
+	if !ok {
+		return venue.Venue{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return v, nil
+}
+
+func (s venueStore) CountShows(ctx context.Context, ID string) (int64, error) {
+	return s.shows[ID], nil
+}
+
+func (s venueStore) Update(ctx context.Context, ID string, v venue.Venue) error {
+	s.venues[ID] = v
+	return nil
+}
+
+func (s venueStore) Delete(ctx context.Context, ID string) error {
+	delete(s.venues, ID)
+	return nil
+}
+
+func TestVenueUseCase(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newStore := func() venueStore {
+		return venueStore{
+			venues: map[string]venue.Venue{
+				"VENUE1": {ID: "VENUE1", Name: "Hall", Capacity: 100, Timezone: "Asia/Jakarta", CreatedAt: createdAt, UpdatedAt: createdAt},
+				"VENUE2": {ID: "VENUE2", Name: "Arena", Capacity: 500, Timezone: "Asia/Jakarta", CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+			shows: map[string]int64{"VENUE1": 2},
+		}
+	}
+
+	newUseCase := func(store venueStore) venue.VenueUseCase {
+		return venue.NewVenueUseCase(venue.VenueUseCaseProperty{
+			Logger:          logger,
+			Location:        time.UTC,
+			Timeout:         10 * time.Second,
+			VenueRepository: store,
+		})
+	}
+
+	req := venue.CreateVenueRequest{
+		Name: "Stadium", Country: "Indonesia", City: "Jakarta", FormattedAddress: "Jl. Sudirman 1, Jakarta",
+		Latitude: -6.2, Longitude: 106.8, Capacity: 1000, Timezone: "Asia/Jakarta",
+	}
+
+	t.Run("a venue is created with its capacity and timezone", func(t *testing.T) {
+		store := newStore()
+
+		resp, err := newUseCase(store).CreateVenue(context.Background(), req)
+		require.NoError(t, err)
+
+		v, ok := store.venues[resp.ID]
+		require.True(t, ok)
+		assert.Equal(t, int64(1000), v.Capacity)
+		assert.Equal(t, "Asia/Jakarta", v.Timezone)
+	})
+
+	t.Run("a venue of an unknown timezone is rejected", func(t *testing.T) {
+		store := newStore()
+
+		invalid := req
+		invalid.Timezone = "Asia/Nowhere"
+
+		_, err := newUseCase(store).CreateVenue(context.Background(), invalid)
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+		assert.Len(t, store.venues, 2)
+	})
+
+	t.Run("an updated venue keeps its ID and creation time", func(t *testing.T) {
+		store := newStore()
+
+		resp, err := newUseCase(store).UpdateVenue(context.Background(), venue.UpdateVenueRequest{ID: "VENUE2", CreateVenueRequest: req})
+		require.NoError(t, err)
+
+		assert.Equal(t, "VENUE2", resp.ID)
+		assert.Equal(t, "Stadium", store.venues["VENUE2"].Name)
+		assert.Equal(t, createdAt, store.venues["VENUE2"].CreatedAt)
+		assert.Len(t, store.venues, 2)
+	})
+
+	t.Run("a venue which is still referenced by a show is not deleted", func(t *testing.T) {
+		store := newStore()
+		uc := newUseCase(store)
+
+		err := uc.DeleteVenue(context.Background(), venue.DeleteVenueRequest{ID: "VENUE1"})
+		assert.True(t, errors.MatchStatus(err, status.CONFLICT))
+		assert.Contains(t, store.venues, "VENUE1")
+
+		err = uc.DeleteVenue(context.Background(), venue.DeleteVenueRequest{ID: "VENUE2"})
+		require.NoError(t, err)
+		assert.NotContains(t, store.venues, "VENUE2")
+	})
+}
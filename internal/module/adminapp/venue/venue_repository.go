@@ -0,0 +1,244 @@
+package venue
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type VenueRepository interface {
+	Save(ctx context.Context, v Venue) error
+	FindByID(ctx context.Context, ID string) (Venue, error)
+	FindByShowID(ctx context.Context, showID string) (Venue, error)
+	FindMany(ctx context.Context, offset, limit int) ([]Venue, error)
+	Count(ctx context.Context) (int64, error)
+	CountShows(ctx context.Context, ID string) (int64, error)
+	Update(ctx context.Context, ID string, v Venue) error
+	Delete(ctx context.Context, ID string) error
+}
+
+type venueRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewVenueRepository(logger *logrus.Logger, db *pgxpool.Pool) VenueRepository {
+	return &venueRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *venueRepository) findOne(ctx context.Context, query string, arg string) (Venue, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	row := cmd.QueryRowContext(ctx, query, arg)
+
+	var data Venue
+	var defaultSeatMapID sql.NullString
+	err := row.Scan(
+		&data.ID, &data.Name, &data.Country, &data.City, &data.FormattedAddress, &data.Latitude, &data.Longitude,
+		&data.Capacity, &data.Timezone, &defaultSeatMapID, &data.CreatedAt, &data.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Venue{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("venue"), arg))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Venue{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting venue's prorperties")
+	}
+
+	if defaultSeatMapID.Valid {
+		data.DefaultSeatMapID = &defaultSeatMapID.String
+	}
+
+	return data, nil
+}
+
+// FindByID implements VenueRepository.
+func (r *venueRepository) FindByID(ctx context.Context, ID string) (Venue, error) {
+	query := `
+		SELECT
+			id, name, country, city, formatted_address, latitude, longitude,
+			capacity, timezone, default_seat_map_id, created_at, updated_at
+		FROM venue
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, ID)
+}
+
+// FindByShowID implements VenueRepository.
+func (r *venueRepository) FindByShowID(ctx context.Context, showID string) (Venue, error) {
+	query := `
+		SELECT
+			v.id, v.name, v.country, v.city, v.formatted_address, v.latitude, v.longitude,
+			v.capacity, v.timezone, v.default_seat_map_id, v.created_at, v.updated_at
+		FROM venue v
+		JOIN event_show s ON s.venue_id = v.id
+		WHERE
+			s.id = $1
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, showID)
+}
+
+// FindMany implements VenueRepository.
+func (r *venueRepository) FindMany(ctx context.Context, offset int, limit int) ([]Venue, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, name, country, city, formatted_address, latitude, longitude,
+			capacity, timezone, default_seat_map_id, created_at, updated_at
+		FROM venue
+		ORDER BY name ASC
+		OFFSET $1
+		LIMIT $2
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of venue's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Venue, 0)
+	for rows.Next() {
+		var v Venue
+		var defaultSeatMapID sql.NullString
+		err := rows.Scan(
+			&v.ID, &v.Name, &v.Country, &v.City, &v.FormattedAddress, &v.Latitude, &v.Longitude,
+			&v.Capacity, &v.Timezone, &defaultSeatMapID, &v.CreatedAt, &v.UpdatedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of venue's prorperties")
+		}
+
+		if defaultSeatMapID.Valid {
+			v.DefaultSeatMapID = &defaultSeatMapID.String
+		}
+
+		data = append(data, v)
+	}
+
+	return data, nil
+}
+
+// Count implements VenueRepository.
+func (r *venueRepository) Count(ctx context.Context) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM venue`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of venue's prorperties")
+	}
+
+	return count, nil
+}
+
+// CountShows implements VenueRepository.
+func (r *venueRepository) CountShows(ctx context.Context, ID string) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM event_show WHERE venue_id = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, ID)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting shows of venue")
+	}
+
+	return count, nil
+}
+
+// Save implements VenueRepository.
+func (r *venueRepository) Save(ctx context.Context, v Venue) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO venue
+		(
+			id, name, country, city, formatted_address, latitude, longitude,
+			capacity, timezone, default_seat_map_id, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, v.ID, v.Name, v.Country, v.City, v.FormattedAddress, v.Latitude, v.Longitude,
+		v.Capacity, v.Timezone, v.DefaultSeatMapID, v.CreatedAt, v.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving venue's prorperties")
+	}
+
+	return nil
+}
+
+// Update implements VenueRepository.
+func (r *venueRepository) Update(ctx context.Context, ID string, v Venue) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE venue
+		SET
+			name = $1,
+			country = $2,
+			city = $3,
+			formatted_address = $4,
+			latitude = $5,
+			longitude = $6,
+			capacity = $7,
+			timezone = $8,
+			default_seat_map_id = $9,
+			updated_at = $10
+		WHERE id = $11
+	`
+
+	_, err := cmd.ExecContext(ctx, query, v.Name, v.Country, v.City, v.FormattedAddress, v.Latitude, v.Longitude,
+		v.Capacity, v.Timezone, v.DefaultSeatMapID, v.UpdatedAt, ID,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating venue's prorperties")
+	}
+
+	return nil
+}
+
+// Delete implements VenueRepository.
+func (r *venueRepository) Delete(ctx context.Context, ID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM venue WHERE id = $1
+	`
+
+	_, err := cmd.ExecContext(ctx, query, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting venue's prorperties")
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package artist
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ArtistRepository interface {
+	FindByIDOrSlug(ctx context.Context, IDOrSlug string) (Artist, error)
+	FindManyUpcomingEvent(ctx context.Context, ID string, after time.Time, offset, limit int) ([]UpcomingEvent, error)
+	CountUpcomingEvent(ctx context.Context, ID string, after time.Time) (int64, error)
+}
+
+type artistRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewArtistRepository(logger *logrus.Logger, db *pgxpool.Pool) ArtistRepository {
+	return &artistRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByIDOrSlug implements ArtistRepository.
+func (r *artistRepository) FindByIDOrSlug(ctx context.Context, IDOrSlug string) (Artist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, slug, name, bio, image_url
+		FROM artist
+		WHERE
+			id = $1 OR slug = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, IDOrSlug)
+
+	var data Artist
+	err := row.Scan(&data.ID, &data.Slug, &data.Name, &data.Bio, &data.ImageURL)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("artist"), IDOrSlug))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Artist{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting artist's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManyUpcomingEvent implements ArtistRepository. The events are ordered by their nearest show after the given time.
+func (r *artistRepository) FindManyUpcomingEvent(ctx context.Context, ID string, after time.Time, offset int, limit int) ([]UpcomingEvent, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			e.id, e.name, e.description, e.status, min(s.time) AS next_show_time
+		FROM event e
+		JOIN event_artist ea ON ea.event_id = e.id
+		JOIN event_show s ON s.event_id = e.id
+		WHERE
+			ea.artist_id = $1
+			AND e.status = 'ACTIVE'
+			AND s.status = 'ACTIVE'
+			AND s.time > $2
+		GROUP BY e.id, e.name, e.description, e.status
+		ORDER BY next_show_time ASC
+		OFFSET $3
+		LIMIT $4
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ID, after, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of artist's upcoming event prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]UpcomingEvent, 0)
+	for rows.Next() {
+		var e UpcomingEvent
+		err := rows.Scan(&e.ID, &e.Name, &e.Description, &e.Status, &e.NextShowTime)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of artist's upcoming event prorperties")
+		}
+
+		data = append(data, e)
+	}
+
+	return data, nil
+}
+
+// CountUpcomingEvent implements ArtistRepository.
+func (r *artistRepository) CountUpcomingEvent(ctx context.Context, ID string, after time.Time) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			count(DISTINCT e.id)
+		FROM event e
+		JOIN event_artist ea ON ea.event_id = e.id
+		JOIN event_show s ON s.event_id = e.id
+		WHERE
+			ea.artist_id = $1
+			AND e.status = 'ACTIVE'
+			AND s.status = 'ACTIVE'
+			AND s.time > $2
+	`
+
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, ID, after)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of artist's upcoming event prorperties")
+	}
+
+	return count, nil
+}
@@ -0,0 +1,19 @@
+package artist
+
+import "time"
+
+type Artist struct {
+	ID       string
+	Slug     string
+	Name     string
+	Bio      string
+	ImageURL string
+}
+
+type UpcomingEvent struct {
+	ID           string
+	Name         string
+	Description  string
+	Status       string
+	NextShowTime time.Time
+}
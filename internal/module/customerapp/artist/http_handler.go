@@ -0,0 +1,102 @@
+package artist
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.CustomerSession
+	Validate          *validator.Validate
+	ArtistUseCase     ArtistUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, customerSession *middleware.CustomerSession, validate *validator.Validate, artistUseCase ArtistUseCase) {
+	handler := &HTTPHandler{
+		Validate:      validate,
+		ArtistUseCase: artistUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/customerapp/artists/{artist}", publicMiddleware.SetRouteChain(handler.GetArtist, customerSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/customerapp/artists/{artist}/events", publicMiddleware.SetRouteChain(handler.GetManyArtistUpcomingEvent, customerSession.Verify)).Methods(http.MethodGet)
+}
+
+func (handler HTTPHandler) GetArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetArtistRequest{
+		IDOrSlug: vars["artist"],
+	}
+
+	resp, err := handler.ArtistUseCase.GetArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("artist")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyArtistUpcomingEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetManyArtistUpcomingEventRequest{
+		IDOrSlug: vars["artist"],
+	}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.ArtistUseCase.GetManyArtistUpcomingEvent(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("artist's upcoming event")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,11 @@
+package artist
+
+type GetArtistRequest struct {
+	IDOrSlug string
+}
+
+type GetManyArtistUpcomingEventRequest struct {
+	IDOrSlug string `validate:"-"`
+	Page     int    `validate:"required"`
+	Size     int    `validate:"required"`
+}
@@ -0,0 +1,38 @@
+package artist
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/media"
+)
+
+type ArtistResponse struct {
+	ID       string                `json:"id"`
+	Slug     string                `json:"slug"`
+	Name     string                `json:"name"`
+	Bio      string                `json:"bio"`
+	ImageURL string                `json:"image_url"`
+	Media    []media.MediaResponse `json:"media,omitempty"`
+}
+
+func (r *ArtistResponse) PopulateFromEntity(a Artist) {
+	r.ID = a.ID
+	r.Slug = a.Slug
+	r.Name = a.Name
+	r.Bio = a.Bio
+	r.ImageURL = a.ImageURL
+}
+
+type UpcomingEventResponse struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Status       string    `json:"status"`
+	NextShowTime time.Time `json:"next_show_time"`
+}
+
+type GetManyArtistUpcomingEventResponse struct {
+	Total  int64                   `json:"total"`
+	Artist ArtistResponse          `json:"artist"`
+	Events []UpcomingEventResponse `json:"events"`
+}
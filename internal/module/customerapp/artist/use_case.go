@@ -0,0 +1,125 @@
+package artist
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/media"
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
+	"golang.org/x/sync/errgroup"
+)
+
+type ArtistUseCase interface {
+	GetArtist(ctx context.Context, req GetArtistRequest) (ArtistResponse, error)
+	GetManyArtistUpcomingEvent(ctx context.Context, req GetManyArtistUpcomingEventRequest) (GetManyArtistUpcomingEventResponse, error)
+}
+
+type artistUseCase struct {
+	logger           *logrus.Logger
+	location         *time.Location
+	timeout          time.Duration
+	artistRepository ArtistRepository
+	mediaRepository  media.MediaRepository
+	storage          storage.Storage
+}
+
+type ArtistUseCaseProperty struct {
+	Logger           *logrus.Logger
+	Location         *time.Location
+	Timeout          time.Duration
+	ArtistRepository ArtistRepository
+	MediaRepository  media.MediaRepository
+	Storage          storage.Storage
+}
+
+func NewArtistUseCase(props ArtistUseCaseProperty) ArtistUseCase {
+	return &artistUseCase{
+		logger:           props.Logger,
+		location:         props.Location,
+		timeout:          props.Timeout,
+		artistRepository: props.ArtistRepository,
+		mediaRepository:  props.MediaRepository,
+		storage:          props.Storage,
+	}
+}
+
+// GetArtist implements ArtistUseCase.
+func (u *artistUseCase) GetArtist(ctx context.Context, req GetArtistRequest) (ArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := u.artistRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	bunchOfMedia, err := u.mediaRepository.FindManyByOwnerIDs(ctx, media.OwnerTypeArtist, []string{a.ID})
+	if err != nil {
+		return ArtistResponse{}, err
+	}
+
+	resp := ArtistResponse{}
+	resp.PopulateFromEntity(a)
+	resp.Media = media.NewMediaResponses(bunchOfMedia, u.storage)
+
+	return resp, nil
+}
+
+// GetManyArtistUpcomingEvent implements ArtistUseCase.
+func (u *artistUseCase) GetManyArtistUpcomingEvent(ctx context.Context, req GetManyArtistUpcomingEventRequest) (GetManyArtistUpcomingEventResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	a, err := u.artistRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return GetManyArtistUpcomingEventResponse{}, err
+	}
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+	now := time.Now()
+
+	var events []UpcomingEvent
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.artistRepository.CountUpcomingEvent(gctx, a.ID, now)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.artistRepository.FindManyUpcomingEvent(gctx, a.ID, now, offset, limit)
+		if err != nil {
+			return err
+		}
+		events = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyArtistUpcomingEventResponse{}, err
+	}
+
+	resp := GetManyArtistUpcomingEventResponse{
+		Total:  total,
+		Events: make([]UpcomingEventResponse, len(events)),
+	}
+	resp.Artist.PopulateFromEntity(a)
+
+	for k, e := range events {
+		resp.Events[k] = UpcomingEventResponse{
+			ID:           e.ID,
+			Name:         e.Name,
+			Description:  e.Description,
+			Status:       e.Status,
+			NextShowTime: e.NextShowTime,
+		}
+	}
+
+	return resp, nil
+}
@@ -30,27 +30,77 @@ type Location struct {
 	Longitude        float64
 }
 
+type Venue struct {
+	ID               string
+	Name             string
+	Country          string
+	City             string
+	FormattedAddress string
+	Latitude         float64
+	Longitude        float64
+	Capacity         int64
+	Timezone         string
+}
+
 type Show struct {
 	EventID     string
 	ID          string
+	VenueID     *string
 	Venue       string
 	Type        string
 	TicketStock []ticket.TicketStock
 	Location    *Location
 	Time        time.Time
 	Status      string
+	Currency    string
 }
 
-type Promotor struct {
+// ShowTranslation holds the venue of a show in another locale.
+type ShowTranslation struct {
 	EventID string
-	Name    string
-	Email   string
-	Phone   string
+	ShowID  string
+	Locale  string
+	Venue   string
+}
+
+// Translate returns the show with its venue in the locale of the translation, an empty venue is not translated.
+func (s Show) Translate(t ShowTranslation) Show {
+	if t.Venue != "" {
+		s.Venue = t.Venue
+	}
+
+	return s
+}
+
+type NearbyShow struct {
+	EventName string
+	Show      Show
+	Distance  float64
+}
+
+type Promotor struct {
+	EventID    string
+	PromotorID string
+	Slug       string
+	Name       string
+	Email      string
+	Phone      string
 }
 
 type Artist struct {
-	EventID string
-	Name    string
+	EventID  string
+	ArtistID string
+	Slug     string
+	Name     string
+}
+
+// Taxonomy is a category, a genre or a tag which is attached to an event.
+type Taxonomy struct {
+	EventID    string
+	TaxonomyID string
+	Type       string
+	Slug       string
+	Name       string
 }
 
 type Event struct {
@@ -59,13 +109,52 @@ type Event struct {
 	Promotors   []Promotor
 	Artists     []Artist
 	Shows       []Show
+	Taxonomies  []Taxonomy
 	Description string
 	Status      string
+	Currency    string
 	OrderRules  OrderRuleAggregation
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// EventFilter narrows the events down to the ones which are attached to the category, to any of the genres and to any
+// of the tags, all of them are given by their slug. An empty criterion does not narrow the events down.
+type EventFilter struct {
+	Category string
+	Genres   []string
+	Tags     []string
+}
+
+// Facet is a taxonomy along with the number of the filtered events which are attached to it.
+type Facet struct {
+	Type  string
+	Slug  string
+	Name  string
+	Count int64
+}
+
+// EventTranslation holds the name and the description of an event in another locale.
+type EventTranslation struct {
+	EventID     string
+	Locale      string
+	Name        string
+	Description string
+}
+
+// Translate returns the event with its name and description in the locale of the translation. A field which is not
+// translated keeps the content of the event.
+func (e Event) Translate(t EventTranslation) Event {
+	if t.Name != "" {
+		e.Name = t.Name
+	}
+	if t.Description != "" {
+		e.Description = t.Description
+	}
+
+	return e
+}
+
 type OrderRuleAggregation struct {
 	OrderRuleRangeDate order.OrderRuleRangeDate
 	OrderRuleDay       []order.OrderRuleDay
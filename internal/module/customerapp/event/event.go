@@ -1,6 +1,10 @@
 package event
 
-import "time"
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
 
 type OrderPaidEvent struct {
 	ID                      string
@@ -14,16 +18,36 @@ type OrderPaidEvent struct {
 	TaxPercentage           float64
 	ServiceChargePercentage float64
 	DiscountPercentage      float64
-	ServiceCharge           float64
-	Tax                     float64
-	Discount                float64
+	ServiceCharge           money.Money
+	Tax                     money.Money
+	Discount                money.Money
+	PromoRedemptionID       *string
 	Items                   []Item
-	Subtotal                float64
-	TotalAmount             float64
+	Subtotal                money.Money
+	TotalAmount             money.Money
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
 }
 
+// orDefaultCurrency returns the order with its amounts which have no currency in the given one. The order service
+// publishes the amounts of an order as bare numbers until it sends their currency along.
+func (oe OrderPaidEvent) orDefaultCurrency(currencyCode string) OrderPaidEvent {
+	oe.ServiceCharge = oe.ServiceCharge.OrDefaultCurrency(currencyCode)
+	oe.Tax = oe.Tax.OrDefaultCurrency(currencyCode)
+	oe.Discount = oe.Discount.OrDefaultCurrency(currencyCode)
+	oe.Subtotal = oe.Subtotal.OrDefaultCurrency(currencyCode)
+	oe.TotalAmount = oe.TotalAmount.OrDefaultCurrency(currencyCode)
+
+	items := make([]Item, len(oe.Items))
+	for k, item := range oe.Items {
+		item.Price = item.Price.OrDefaultCurrency(currencyCode)
+		items[k] = item
+	}
+	oe.Items = items
+
+	return oe
+}
+
 type Item struct {
 	ID            int64
 	OrderID       string
@@ -33,6 +57,39 @@ type Item struct {
 	EventName     string
 	ShowVenue     string
 	Tier          string
-	Price         float64
+	Price         money.Money
+	PriceQuoteID  *string
 	Quantity      int64
+	SeatIDs       []string
+}
+
+// OversellTopic is the topic which the order service consumes to refund the orders whose tickets sold out before they
+// were acquired.
+const OversellTopic = "oversell"
+
+// OversellEvent reports an order item which could not be fulfilled, the available tickets are the ones which were
+// left when the order was paid.
+type OversellEvent struct {
+	OrderID       string
+	CustomerID    int64
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	Tier          string
+	Quantity      int64
+	Available     int64
+	CreatedAt     time.Time
+}
+
+// OrderRefundedTopic is the topic which the order service publishes the paid orders to once they are refunded or
+// cancelled.
+const OrderRefundedTopic = "order-refunded"
+
+// OrderRefundedEvent reports a paid order which is refunded or cancelled, its tickets go back to their ticket stock.
+type OrderRefundedEvent struct {
+	ID         string
+	Status     string
+	CustomerID int64
+	Items      []Item
+	UpdatedAt  time.Time
 }
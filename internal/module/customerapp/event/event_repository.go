@@ -3,129 +3,100 @@ package event
 import (
 	"context"
 	"database/sql"
-	"fmt"
 
 	"net/http"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type EventRepository interface {
-	BeginTx(ctx context.Context) (*sql.Tx, error)
-	CommitTx(ctx context.Context, tx *sql.Tx) error
-	Rollback(ctx context.Context, tx *sql.Tx) error
-
-	FindByID(ctx context.Context, ID string, tx *sql.Tx) (Event, error)
-	FindMany(ctx context.Context, offset, limit int, tx *sql.Tx) ([]Event, error)
-	Count(ctx context.Context, tx *sql.Tx) (int64, error)
+	FindByID(ctx context.Context, ID string) (Event, error)
+	FindMany(ctx context.Context, filter EventFilter, offset, limit int) ([]Event, error)
+	Count(ctx context.Context, filter EventFilter) (int64, error)
 }
 
-type sqlCommand interface {
-	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
-	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
-	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
-}
+// eventCategoryFilter, eventGenreFilter and eventTagFilter narrow the events aliased by "e" down by the category ($1),
+// the genres ($2) and the tags ($3) of an EventFilter.
+const (
+	eventCategoryFilter = `(
+		$1::VARCHAR = ''
+		OR EXISTS (
+			SELECT 1 FROM event_taxonomy fet JOIN taxonomy ft ON ft.id = fet.taxonomy_id
+			WHERE fet.event_id = e.id AND ft.type = 'CATEGORY' AND ft.slug = $1
+		)
+	)`
+	eventGenreFilter = `(
+		coalesce(cardinality($2::VARCHAR[]), 0) = 0
+		OR EXISTS (
+			SELECT 1 FROM event_taxonomy fet JOIN taxonomy ft ON ft.id = fet.taxonomy_id
+			WHERE fet.event_id = e.id AND ft.type = 'GENRE' AND ft.slug = ANY($2)
+		)
+	)`
+	eventTagFilter = `(
+		coalesce(cardinality($3::VARCHAR[]), 0) = 0
+		OR EXISTS (
+			SELECT 1 FROM event_taxonomy fet JOIN taxonomy ft ON ft.id = fet.taxonomy_id
+			WHERE fet.event_id = e.id AND ft.type = 'TAG' AND ft.slug = ANY($3)
+		)
+	)`
+)
+
+// eventFilterCondition narrows the events aliased by "e" down by the criteria of an EventFilter, which are bound to the
+// first three parameters of the query.
+const eventFilterCondition = eventCategoryFilter + ` AND ` + eventGenreFilter + ` AND ` + eventTagFilter
 
 type eventRepository struct {
-	logger *logrus.Logger
-	db     *sql.DB
+	logger     *logrus.Logger
+	db         *pgxpool.Pool
+	replicaSet *postgresql.ReplicaSet
 }
 
-func NewEventRepository(logger *logrus.Logger, db *sql.DB) EventRepository {
+func NewEventRepository(logger *logrus.Logger, db *pgxpool.Pool, replicaSet *postgresql.ReplicaSet) EventRepository {
 	return &eventRepository{
-		logger: logger,
-		db:     db,
-	}
-}
-
-// BeginTx implements EventRepository.
-func (r *eventRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred trying to begin transaction")
-	}
-
-	return tx, nil
-}
-
-// CommitTx implements EventRepository.
-func (r *eventRepository) CommitTx(ctx context.Context, tx *sql.Tx) error {
-	if err := tx.Commit(); err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred trying to commit transaction")
-	}
-
-	return nil
-}
-
-// Rollback implements EventRepository.
-func (r *eventRepository) Rollback(ctx context.Context, tx *sql.Tx) error {
-	if err := tx.Rollback(); err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred trying to rollback transaction")
+		logger:     logger,
+		db:         db,
+		replicaSet: replicaSet,
 	}
-
-	return nil
 }
 
 // Count implements EventRepository.
-func (r *eventRepository) Count(ctx context.Context, tx *sql.Tx) (int64, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
-
-	query := `SELECT count(id) FROM event`
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of event's prorperties")
-	}
-	defer stmt.Close()
+func (r *eventRepository) Count(ctx context.Context, filter EventFilter) (int64, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
 
+	query := `SELECT count(e.id) FROM event e WHERE ` + eventFilterCondition
 	var count int64
-	row := stmt.QueryRowContext(ctx)
+	row := cmd.QueryRowContext(ctx, query, filter.Category, filter.Genres, filter.Tags)
 	if err := row.Scan(&count); err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of event's prorperties")
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of event's prorperties")
 	}
 
 	return count, nil
 }
 
 // FindMany implements EventRepository.
-func (r *eventRepository) FindMany(ctx context.Context, offset int, limit int, tx *sql.Tx) ([]Event, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *eventRepository) FindMany(ctx context.Context, filter EventFilter, offset int, limit int) ([]Event, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			id, name, description, status, created_at, updated_at
-		FROM event
-		ORDER BY id DESC
-		OFFSET $1
-		LIMIT $2
+			e.id, e.name, e.description, e.status, e.currency, e.created_at, e.updated_at
+		FROM event e
+		WHERE ` + eventFilterCondition + `
+		ORDER BY e.id DESC
+		OFFSET $4
+		LIMIT $5
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	rows, err := cmd.QueryContext(ctx, query, filter.Category, filter.Genres, filter.Tags, offset, limit)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, offset, limit)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
 	}
 
 	defer rows.Close()
@@ -134,11 +105,11 @@ func (r *eventRepository) FindMany(ctx context.Context, offset int, limit int, t
 	for rows.Next() {
 		var data Event
 		err := rows.Scan(
-			&data.ID, &data.Name, &data.Description, &data.Status, &data.CreatedAt, &data.UpdatedAt,
+			&data.ID, &data.Name, &data.Description, &data.Status, &data.Currency, &data.CreatedAt, &data.UpdatedAt,
 		)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
 		}
 
 		bunchOfEvents = append(bunchOfEvents, data)
@@ -148,41 +119,30 @@ func (r *eventRepository) FindMany(ctx context.Context, offset int, limit int, t
 }
 
 // FindByID implements EventRepository.
-func (r *eventRepository) FindByID(ctx context.Context, ID string, tx *sql.Tx) (Event, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *eventRepository) FindByID(ctx context.Context, ID string) (Event, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			id, name, description, status, created_at, updated_at
+			id, name, description, status, currency, created_at, updated_at
 		FROM event
 		WHERE
 			id = $1
 		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return Event{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, ID)
+	row := cmd.QueryRowContext(ctx, query, ID)
 
 	var data Event
-	err = row.Scan(
-		&data.ID, &data.Name, &data.Description, &data.Status, &data.CreatedAt, &data.UpdatedAt,
+	err := row.Scan(
+		&data.ID, &data.Name, &data.Description, &data.Status, &data.Currency, &data.CreatedAt, &data.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return Event{}, errors.New(http.StatusNotFound, status.NOT_FOUND, fmt.Sprintf("event's properties with id '%s' is not found", ID))
+			return Event{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event"), ID))
 		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return Event{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event's prorperties")
+		return Event{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event's prorperties")
 	}
 
 	return data, nil
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type EventTranslationRepository interface {
+	FindManyByEventIDs(ctx context.Context, eventIDs []string, locale string) ([]EventTranslation, error)
+}
+
+type eventTranslationRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+// FindManyByEventIDs implements EventTranslationRepository.
+func (r *eventTranslationRepository) FindManyByEventIDs(ctx context.Context, eventIDs []string, locale string) ([]EventTranslation, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			event_id, locale, name, description
+		FROM event_translation
+		WHERE
+			event_id = ANY($1)
+			AND locale = $2
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, eventIDs, locale)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event translation's prorperties")
+	}
+	defer rows.Close()
+
+	bunchOfTranslations := make([]EventTranslation, 0)
+	for rows.Next() {
+		var data EventTranslation
+		if err := rows.Scan(&data.EventID, &data.Locale, &data.Name, &data.Description); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event translation's prorperties")
+		}
+		bunchOfTranslations = append(bunchOfTranslations, data)
+	}
+
+	return bunchOfTranslations, nil
+}
+
+func NewEventTranslationRepository(logger *logrus.Logger, db *pgxpool.Pool) EventTranslationRepository {
+	return &eventTranslationRepository{
+		logger: logger,
+		db:     db,
+	}
+}
@@ -1,16 +1,15 @@
 package event
 
 import (
-	"context"
-	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
 	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
 	"github.com/tsel-ticketmaster/tm-event/pkg/response"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
@@ -29,67 +28,114 @@ func InitHTTPHandler(router *mux.Router, customerSession *middleware.CustomerSes
 	}
 
 	router.HandleFunc("/tm-event/v1/customerapp/events", publicMiddleware.SetRouteChain(handler.GetManyEvent, customerSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/customerapp/shows/nearby", publicMiddleware.SetRouteChain(handler.GetManyNearbyShow, customerSession.Verify)).Methods(http.MethodGet)
 	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows", publicMiddleware.SetRouteChain(handler.GetManyShow, customerSession.Verify)).Methods(http.MethodGet)
 	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets", publicMiddleware.SetRouteChain(handler.GetManyShowTickets, customerSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/quotes", publicMiddleware.SetRouteChain(handler.CreatePriceQuote, customerSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/customerapp/quotes/{priceQuoteID}", publicMiddleware.SetRouteChain(handler.GetPriceQuote, customerSession.Verify)).Methods(http.MethodGet)
 	router.HandleFunc("/tm-event/v1/customerapp/events/acquired-tickets", publicMiddleware.SetRouteChain(handler.GetManyAcquiredTickets, customerSession.Verify)).Methods(http.MethodGet)
 }
 
-func (handler HTTPHandler) validate(ctx context.Context, payload interface{}) error {
-	err := handler.Validate.StructCtx(ctx, payload)
-	if err == nil {
-		return nil
-	}
+func (handler HTTPHandler) GetManyEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	errorFields := err.(validator.ValidationErrors)
+	req := GetManyEventRequest{}
+
+	qs := r.URL.Query()
 
-	errMessages := make([]string, len(errorFields))
+	req.Category = qs.Get("category")
+	req.Genres = qs["genre"]
+	req.Tags = qs["tag"]
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
 
-	for k, errorField := range errorFields {
-		errMessages[k] = fmt.Sprintf("invalid '%s' with value '%v'", errorField.Field(), errorField.Value())
+		return
 	}
 
-	errorMessage := strings.Join(errMessages, ", ")
+	resp, meta, err := handler.EventUseCase.GetManyEvent(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
 
-	return fmt.Errorf(errorMessage)
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("event")),
+		Data:    resp,
+		Meta:    meta,
+	})
 
 }
 
-func (handler HTTPHandler) GetManyEvent(w http.ResponseWriter, r *http.Request) {
+func (handler HTTPHandler) GetManyNearbyShow(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	req := GetManyEventRequest{}
+	req := GetManyNearbyShowRequest{}
 
 	qs := r.URL.Query()
 
-	req.Page, _ = strconv.Atoi(qs.Get("page"))
-	req.Size, _ = strconv.Atoi(qs.Get("size"))
+	var err error
+	if req.Latitude, err = strconv.ParseFloat(qs.Get("lat"), 64); err != nil {
+		response.JSON(w, http.StatusBadRequest, response.RESTEnvelope{
+			Status:  status.BAD_REQUEST,
+			Message: i18n.Message(ctx, i18n.InvalidValue, "lat", qs.Get("lat")),
+		})
 
-	if err := handler.validate(ctx, req); err != nil {
+		return
+	}
+	if req.Longitude, err = strconv.ParseFloat(qs.Get("lng"), 64); err != nil {
 		response.JSON(w, http.StatusBadRequest, response.RESTEnvelope{
 			Status:  status.BAD_REQUEST,
-			Message: err.Error(),
+			Message: i18n.Message(ctx, i18n.InvalidValue, "lng", qs.Get("lng")),
+		})
+
+		return
+	}
+	req.Radius, _ = strconv.ParseFloat(qs.Get("radius"), 64)
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
 
-	resp, err := handler.EventUseCase.GetManyEvent(ctx, req)
+	resp, err := handler.EventUseCase.GetManyNearbyShow(ctx, req)
 	if err != nil {
 		ae := errors.Destruct(err)
 		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
 			Status:  ae.Status,
 			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
 	response.JSON(w, http.StatusOK, response.RESTEnvelope{
 		Status:  status.OK,
-		Message: "list of event",
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("nearby show")),
 		Data:    resp,
 		Meta:    nil,
 	})
-
 }
 
 func (handler HTTPHandler) GetManyShow(w http.ResponseWriter, r *http.Request) {
@@ -106,13 +152,14 @@ func (handler HTTPHandler) GetManyShow(w http.ResponseWriter, r *http.Request) {
 		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
 			Status:  ae.Status,
 			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
 	response.JSON(w, http.StatusOK, response.RESTEnvelope{
 		Status:  status.OK,
-		Message: "list of show",
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("show")),
 		Data:    resp,
 		Meta:    nil,
 	})
@@ -133,13 +180,70 @@ func (handler HTTPHandler) GetManyShowTickets(w http.ResponseWriter, r *http.Req
 		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
 			Status:  ae.Status,
 			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("show tickets")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) CreatePriceQuote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := CreatePriceQuoteRequest{
+		EventID:       vars["eventID"],
+		ShowID:        vars["showID"],
+		TicketStockID: vars["ticketStockID"],
+	}
+
+	resp, err := handler.EventUseCase.CreatePriceQuote(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("price quote"), i18n.Noun("created")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetPriceQuote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetPriceQuoteRequest{
+		ID: vars["priceQuoteID"],
+	}
+
+	resp, err := handler.EventUseCase.GetPriceQuote(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
 	response.JSON(w, http.StatusOK, response.RESTEnvelope{
 		Status:  status.OK,
-		Message: "list of show tickets",
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("price quote")),
 		Data:    resp,
 		Meta:    nil,
 	})
@@ -161,13 +265,14 @@ func (handler HTTPHandler) GetManyAcquiredTickets(w http.ResponseWriter, r *http
 		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
 			Status:  ae.Status,
 			Message: ae.Message,
+			Errors:  ae.Errors,
 		})
 
 		return
 	}
 	response.JSON(w, http.StatusOK, response.RESTEnvelope{
 		Status:  status.OK,
-		Message: "list of acquired tickets",
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("acquired tickets")),
 		Data:    resp,
 		Meta:    nil,
 	})
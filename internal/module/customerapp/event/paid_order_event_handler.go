@@ -19,7 +19,9 @@ func (handler OrderPaidEventHandler) Handle(ctx context.Context, msg interface{}
 	}
 
 	event := OrderPaidEvent{}
-	json.Unmarshal(kafkaMessage.Value, &event)
+	if err := json.Unmarshal(kafkaMessage.Value, &event); err != nil {
+		return fmt.Errorf("invalid order paid event: %w", err)
+	}
 
 	return handler.EventUseCase.OnOrderPaid(ctx, event)
 }
@@ -0,0 +1,27 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ck "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+type OrderRefundedEventHandler struct {
+	EventUseCase EventUseCase
+}
+
+func (handler OrderRefundedEventHandler) Handle(ctx context.Context, msg interface{}) error {
+	kafkaMessage, ok := msg.(*ck.Message)
+	if !ok {
+		return fmt.Errorf("invalid message provider")
+	}
+
+	event := OrderRefundedEvent{}
+	if err := json.Unmarshal(kafkaMessage.Value, &event); err != nil {
+		return fmt.Errorf("invalid order refunded event: %w", err)
+	}
+
+	return handler.EventUseCase.OnOrderRefunded(ctx, event)
+}
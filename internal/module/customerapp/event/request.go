@@ -1,19 +1,49 @@
 package event
 
 type GetManyEventRequest struct {
-	Page int `validate:"required"`
-	Size int `validate:"required"`
+	Category string   `validate:"-"`
+	Genres   []string `validate:"omitempty,dive,required"`
+	Tags     []string `validate:"omitempty,dive,required"`
+	Page     int      `validate:"required"`
+	Size     int      `validate:"required"`
+}
+
+// Filter returns the filter of the events by their category, genres and tags.
+func (r GetManyEventRequest) Filter() EventFilter {
+	return EventFilter{
+		Category: r.Category,
+		Genres:   r.Genres,
+		Tags:     r.Tags,
+	}
 }
 
 type GetManyShowRequest struct {
 	EventID string
 }
 
+type GetManyNearbyShowRequest struct {
+	Latitude  float64 `validate:"latitude"`
+	Longitude float64 `validate:"longitude"`
+	Radius    float64 `validate:"gt=0,lte=500"`
+	Page      int     `validate:"required"`
+	Size      int     `validate:"required"`
+}
+
 type GetManyShowTicketsRequest struct {
 	EventID string
 	ShowID  string
 }
 
+type CreatePriceQuoteRequest struct {
+	EventID       string
+	ShowID        string
+	TicketStockID string
+}
+
+type GetPriceQuoteRequest struct {
+	ID string
+}
+
 type GetManyAcquiredTicketRequest struct {
 	Page int
 	Size int
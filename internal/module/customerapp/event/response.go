@@ -1,13 +1,29 @@
 package event
 
-import "time"
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/media"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/taxonomy"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/pricing"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
 
 type PromotorResponse struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
 	Phone string `json:"phone"`
 }
 
+type ArtistResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
 type LocationResponse struct {
 	Country          string  `json:"country"`
 	City             string  `json:"city"`
@@ -18,23 +34,63 @@ type LocationResponse struct {
 
 type ShowResponse struct {
 	ID       string            `json:"id"`
+	VenueID  *string           `json:"venue_id,omitempty"`
 	Venue    string            `json:"venue"`
 	Type     string            `json:"type"`
 	Location *LocationResponse `json:"location"`
 	Time     time.Time         `json:"time"`
 	Status   string            `json:"status"`
+	Currency string            `json:"currency"`
+}
+
+type TaxonomyResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// EventTaxonomyResponse holds the category, the genres and the tags of an event.
+type EventTaxonomyResponse struct {
+	Category *TaxonomyResponse  `json:"category"`
+	Genres   []TaxonomyResponse `json:"genres"`
+	Tags     []TaxonomyResponse `json:"tags"`
+}
+
+func (r *EventTaxonomyResponse) PopulateFromEntity(taxonomies []Taxonomy) {
+	r.Genres = make([]TaxonomyResponse, 0)
+	r.Tags = make([]TaxonomyResponse, 0)
+
+	for _, v := range taxonomies {
+		t := TaxonomyResponse{
+			ID:   v.TaxonomyID,
+			Slug: v.Slug,
+			Name: v.Name,
+		}
+
+		switch v.Type {
+		case taxonomy.TypeCategory:
+			r.Category = &t
+		case taxonomy.TypeGenre:
+			r.Genres = append(r.Genres, t)
+		case taxonomy.TypeTag:
+			r.Tags = append(r.Tags, t)
+		}
+	}
 }
 
 type EventResponse struct {
-	ID          string             `json:"id"`
-	Name        string             `json:"name"`
-	Description string             `json:"description"`
-	Status      string             `json:"status"`
-	Promotors   []PromotorResponse `json:"promotors"`
-	Artists     []string           `json:"artists"`
-	Shows       []ShowResponse     `json:"shows,omitempty"`
-	CreatedAt   time.Time          `json:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at"`
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Status      string                `json:"status"`
+	Currency    string                `json:"currency"`
+	Promotors   []PromotorResponse    `json:"promotors"`
+	Artists     []ArtistResponse      `json:"artists"`
+	Shows       []ShowResponse        `json:"shows,omitempty"`
+	Media       []media.MediaResponse `json:"media"`
+	EventTaxonomyResponse
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 func (r *EventResponse) PopulateFromEntity(e Event) {
@@ -42,9 +98,12 @@ func (r *EventResponse) PopulateFromEntity(e Event) {
 	r.Name = e.Name
 	r.Description = e.Description
 	r.Status = e.Status
+	r.Currency = e.Currency
 
 	for _, v := range e.Promotors {
 		r.Promotors = append(r.Promotors, PromotorResponse{
+			ID:    v.PromotorID,
+			Slug:  v.Slug,
 			Name:  v.Name,
 			Email: v.Email,
 			Phone: v.Phone,
@@ -52,7 +111,11 @@ func (r *EventResponse) PopulateFromEntity(e Event) {
 	}
 
 	for _, v := range e.Artists {
-		r.Artists = append(r.Artists, v.Name)
+		r.Artists = append(r.Artists, ArtistResponse{
+			ID:   v.ArtistID,
+			Slug: v.Slug,
+			Name: v.Name,
+		})
 	}
 
 	for _, v := range e.Shows {
@@ -68,14 +131,18 @@ func (r *EventResponse) PopulateFromEntity(e Event) {
 		}
 		r.Shows = append(r.Shows, ShowResponse{
 			ID:       v.ID,
+			VenueID:  v.VenueID,
 			Venue:    v.Venue,
 			Type:     v.Type,
 			Time:     v.Time,
 			Status:   v.Status,
+			Currency: v.Currency,
 			Location: location,
 		})
 	}
 
+	r.EventTaxonomyResponse.PopulateFromEntity(e.Taxonomies)
+
 	r.CreatedAt = e.CreatedAt
 	r.UpdatedAt = e.UpdatedAt
 }
@@ -85,40 +152,177 @@ type GetManyEventResponse struct {
 	Events []EventResponse `json:"events"`
 }
 
+type FacetResponse struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// FacetsResponse holds the number of the listed events per category, genre and tag.
+type FacetsResponse struct {
+	Category []FacetResponse `json:"category"`
+	Genre    []FacetResponse `json:"genre"`
+	Tag      []FacetResponse `json:"tag"`
+}
+
+func (r *FacetsResponse) PopulateFromEntity(facets []Facet) {
+	r.Category = make([]FacetResponse, 0)
+	r.Genre = make([]FacetResponse, 0)
+	r.Tag = make([]FacetResponse, 0)
+
+	for _, v := range facets {
+		f := FacetResponse{
+			Slug:  v.Slug,
+			Name:  v.Name,
+			Count: v.Count,
+		}
+
+		switch v.Type {
+		case taxonomy.TypeCategory:
+			r.Category = append(r.Category, f)
+		case taxonomy.TypeGenre:
+			r.Genre = append(r.Genre, f)
+		case taxonomy.TypeTag:
+			r.Tag = append(r.Tag, f)
+		}
+	}
+}
+
+type GetManyEventMeta struct {
+	Facets FacetsResponse `json:"facets"`
+}
+
 type GetManyShowResponse struct {
 	Shows []ShowResponse `json:"shows"`
 }
 
+type NearbyShowResponse struct {
+	EventID   string       `json:"event_id"`
+	EventName string       `json:"event_name"`
+	Show      ShowResponse `json:"show"`
+	Distance  float64      `json:"distance"`
+}
+
+func (r *NearbyShowResponse) PopulateFromEntity(ns NearbyShow) {
+	r.EventID = ns.Show.EventID
+	r.EventName = ns.EventName
+	r.Show = ShowResponse{
+		ID:       ns.Show.ID,
+		VenueID:  ns.Show.VenueID,
+		Venue:    ns.Show.Venue,
+		Type:     ns.Show.Type,
+		Time:     ns.Show.Time,
+		Status:   ns.Show.Status,
+		Currency: ns.Show.Currency,
+	}
+	if ns.Show.Location != nil {
+		r.Show.Location = &LocationResponse{
+			Country:          ns.Show.Location.Country,
+			City:             ns.Show.Location.City,
+			FormattedAddress: ns.Show.Location.FormattedAddress,
+			Latitude:         ns.Show.Location.Latitude,
+			Longitude:        ns.Show.Location.Longitude,
+		}
+	}
+	r.Distance = ns.Distance
+}
+
+type GetManyNearbyShowResponse struct {
+	Shows []NearbyShowResponse `json:"shows"`
+}
+
+type NextPriceChangeResponse struct {
+	Price          money.Money `json:"price"`
+	Rule           string      `json:"rule"`
+	EffectiveFrom  *time.Time  `json:"effective_from,omitempty"`
+	RemainingStock *int64      `json:"remaining_stock,omitempty"`
+}
+
 type ShowTicketResponse struct {
-	ID    string  `json:"id"`
-	Tier  string  `json:"tier"`
-	Stock int64   `json:"stock"`
-	Price float64 `json:"price"`
+	ID              string                   `json:"id"`
+	Tier            string                   `json:"tier"`
+	Stock           int64                    `json:"stock"`
+	BasePrice       money.Money              `json:"base_price"`
+	Price           money.Money              `json:"price"`
+	PriceRule       string                   `json:"price_rule"`
+	NextPriceChange *NextPriceChangeResponse `json:"next_price_change"`
+}
+
+func (r *ShowTicketResponse) PopulateFromEntity(ts ticket.TicketStock, q pricing.Quote) {
+	r.ID = ts.ID
+	r.Tier = ts.Tier
+	r.Stock = ts.Available()
+	r.BasePrice = ts.Price
+	r.Price = q.Price
+	r.PriceRule = q.Rule
+
+	if q.NextChange == nil {
+		return
+	}
+
+	r.NextPriceChange = &NextPriceChangeResponse{
+		Price:         q.NextChange.Price,
+		Rule:          q.NextChange.Rule,
+		EffectiveFrom: q.NextChange.EffectiveFrom,
+	}
+	if q.NextChange.AfterSold != nil {
+		remaining := *q.NextChange.AfterSold - ts.Acquired
+		r.NextPriceChange.RemainingStock = &remaining
+	}
 }
 
 type GetManyShowTicketsResponse struct {
 	ShowTickets []ShowTicketResponse `json:"show_tickets"`
 }
 
+type PriceQuoteResponse struct {
+	ID            string      `json:"id"`
+	EventID       string      `json:"event_id"`
+	ShowID        string      `json:"show_id"`
+	TicketStockID string      `json:"ticket_stock_id"`
+	Price         money.Money `json:"price"`
+	Rule          string      `json:"rule"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+func (r *PriceQuoteResponse) PopulateFromEntity(pq ticket.PriceQuote) {
+	r.ID = pq.ID
+	r.EventID = pq.EventID
+	r.ShowID = pq.ShowID
+	r.TicketStockID = pq.TicketStockID
+	r.Price = pq.Price
+	r.Rule = pq.Rule
+	r.ExpiresAt = pq.ExpiresAt
+	r.CreatedAt = pq.CreatedAt
+}
+
 type AcquiredTicketResponse struct {
-	ID                   int64     `json:"id"`
-	Number               string    `json:"number"`
-	EventID              string    `json:"event_id"`
-	ShowID               string    `json:"show_id"`
-	Tier                 string    `json:"tier"`
-	TicketStockID        string    `json:"ticket_stock_id"`
-	EventName            string    `json:"event_name"`
-	ShowVenue            string    `json:"show_venue"`
-	ShowType             string    `json:"show_type"`
-	ShowCountry          string    `json:"show_country"`
-	ShowCity             string    `json:"show_city"`
-	ShowFormattedAddress string    `json:"show_formatted_address"`
-	ShowTime             time.Time `json:"show_time"`
-	CustomerName         string    `json:"customer_name"`
-	CustomerEmail        string    `json:"customer_email"`
-	CustomerID           int64     `json:"customer_id"`
-	CreatedAt            time.Time `json:"created_at"`
-	OrderID              string    `json:"order_id"`
+	ID                   int64       `json:"id"`
+	Number               string      `json:"number"`
+	EventID              string      `json:"event_id"`
+	ShowID               string      `json:"show_id"`
+	Tier                 string      `json:"tier"`
+	TicketStockID        string      `json:"ticket_stock_id"`
+	EventName            string      `json:"event_name"`
+	ShowVenue            string      `json:"show_venue"`
+	ShowType             string      `json:"show_type"`
+	ShowCountry          string      `json:"show_country"`
+	ShowCity             string      `json:"show_city"`
+	ShowFormattedAddress string      `json:"show_formatted_address"`
+	ShowTime             time.Time   `json:"show_time"`
+	CustomerName         string      `json:"customer_name"`
+	CustomerEmail        string      `json:"customer_email"`
+	CustomerID           int64       `json:"customer_id"`
+	CreatedAt            time.Time   `json:"created_at"`
+	OrderID              string      `json:"order_id"`
+	Sequence             int64       `json:"sequence"`
+	Quantity             int64       `json:"quantity"`
+	Price                money.Money `json:"price"`
+	SeatID               *string     `json:"seat_id,omitempty"`
+	SeatSection          *string     `json:"seat_section,omitempty"`
+	SeatRow              *string     `json:"seat_row,omitempty"`
+	SeatNumber           *string     `json:"seat_number,omitempty"`
 }
 
 type GetManyAcquiredTicketResponse struct {
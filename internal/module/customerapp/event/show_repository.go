@@ -3,101 +3,91 @@ package event
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"math"
 	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type ShowRepository interface {
-	Save(ctx context.Context, s Show, tx *sql.Tx) error
-	FindByID(ctx context.Context, ID string, tx *sql.Tx) (Show, error)
-	FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Show, error)
-	Update(ctx context.Context, ID string, s Show, tx *sql.Tx) error
+	Save(ctx context.Context, s Show) error
+	FindByID(ctx context.Context, ID string) (Show, error)
+	FindManyByEventID(ctx context.Context, eventID string) ([]Show, error)
+	FindManyNearby(ctx context.Context, latitude, longitude, radius float64, after time.Time, offset, limit int) ([]NearbyShow, error)
+	Update(ctx context.Context, ID string, s Show) error
 }
 
 type showRepository struct {
-	logger *logrus.Logger
-	db     *sql.DB
+	logger     *logrus.Logger
+	db         *pgxpool.Pool
+	replicaSet *postgresql.ReplicaSet
 }
 
-func NewShowRepository(logger *logrus.Logger, db *sql.DB) ShowRepository {
+func NewShowRepository(logger *logrus.Logger, db *pgxpool.Pool, replicaSet *postgresql.ReplicaSet) ShowRepository {
 	return &showRepository{
-		logger: logger,
-		db:     db,
+		logger:     logger,
+		db:         db,
+		replicaSet: replicaSet,
 	}
 }
 
 // FindByID implements ShowRepository.
-func (r *showRepository) FindByID(ctx context.Context, ID string, tx *sql.Tx) (Show, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) FindByID(ctx context.Context, ID string) (Show, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		FROM event_show
 		WHERE
 			id = $1
 		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return Show{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, ID)
+	row := cmd.QueryRowContext(ctx, query, ID)
 
 	var data Show
-	err = row.Scan(
-		&data.EventID, &data.ID, &data.Venue, &data.Type, &data.Time, &data.Status,
+	var venueID sql.NullString
+	err := row.Scan(
+		&data.EventID, &data.ID, &venueID, &data.Venue, &data.Type, &data.Time, &data.Status, &data.Currency,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return Show{}, errors.New(http.StatusNotFound, status.NOT_FOUND, fmt.Sprintf("event show's properties with id '%s' is not found", ID))
+			return Show{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event show"), ID))
 		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return Show{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
+		return Show{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event show's prorperties")
+	}
+
+	if venueID.Valid {
+		data.VenueID = &venueID.String
 	}
 
 	return data, nil
 }
 
 // FindManyByEventID implements ShowRepository.
-func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string, tx *sql.Tx) ([]Show, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string) ([]Show, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		FROM event_show
 		WHERE
 			event_id = $1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, eventID)
+	rows, err := cmd.QueryContext(ctx, query, eventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
 	}
 
 	defer rows.Close()
@@ -105,11 +95,16 @@ func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string,
 	var data = make([]Show, 0)
 	for rows.Next() {
 		var s Show
+		var venueID sql.NullString
 
-		err := rows.Scan(&s.EventID, &s.ID, &s.Venue, &s.Type, &s.Time, &s.Status)
+		err := rows.Scan(&s.EventID, &s.ID, &venueID, &s.Venue, &s.Type, &s.Time, &s.Status, &s.Currency)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show's prorperties")
+		}
+
+		if venueID.Valid {
+			s.VenueID = &venueID.String
 		}
 
 		data = append(data, s)
@@ -118,70 +113,171 @@ func (r *showRepository) FindManyByEventID(ctx context.Context, eventID string,
 	return data, nil
 }
 
-// Save implements ShowRepository.
-func (r *showRepository) Save(ctx context.Context, s Show, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
+// earthRadius is the mean radius of the earth in kilometers.
+const earthRadius float64 = 6371.0088
+
+// boundingBox returns the latitude and longitude ranges which enclose the circle of the given radius (in kilometers).
+// The ranges are used as a prefilter that can be served by the (latitude, longitude) indexes before the exact
+// haversine distance is computed.
+func boundingBox(latitude, longitude, radius float64) (minLat, maxLat, minLng, maxLng float64) {
+	deltaLat := radius / earthRadius * 180 / math.Pi
+	minLat = math.Max(latitude-deltaLat, -90)
+	maxLat = math.Min(latitude+deltaLat, 90)
+
+	// the circle contains a pole or crosses the antimeridian, every longitude has to be considered.
+	if minLat == -90 || maxLat == 90 {
+		return minLat, maxLat, -180, 180
+	}
 
-	if tx != nil {
-		cmd = tx
+	deltaLng := math.Asin(math.Sin(radius/earthRadius)/math.Cos(latitude*math.Pi/180)) * 180 / math.Pi
+	minLng = longitude - deltaLng
+	maxLng = longitude + deltaLng
+	if minLng < -180 || maxLng > 180 {
+		return minLat, maxLat, -180, 180
 	}
 
+	return minLat, maxLat, minLng, maxLng
+}
+
+// FindManyNearby implements ShowRepository. Online shows are excluded, the distance is in kilometers.
+func (r *showRepository) FindManyNearby(ctx context.Context, latitude, longitude, radius float64, after time.Time, offset, limit int) ([]NearbyShow, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			c.event_id, c.event_name, c.id, c.venue_id, c.venue, c.type, c.time, c.status, c.currency,
+			c.country, c.city, c.formatted_address, c.latitude, c.longitude, d.distance
+		FROM (
+			SELECT
+				s.event_id, e.name AS event_name, s.id, s.venue_id, s.venue, s.type, s.time, s.status, s.currency,
+				v.country, v.city, v.formatted_address, v.latitude, v.longitude
+			FROM venue v
+			JOIN event_show s ON s.venue_id = v.id
+			JOIN event e ON e.id = s.event_id
+			WHERE
+				v.latitude BETWEEN $3 AND $4
+				AND v.longitude BETWEEN $5 AND $6
+				AND s.type <> 'ONLINE'
+				AND s.status = 'ACTIVE'
+				AND e.status = 'ACTIVE'
+				AND s.time > $7
+			UNION ALL
+			SELECT
+				s.event_id, e.name AS event_name, s.id, s.venue_id, s.venue, s.type, s.time, s.status, s.currency,
+				l.country, l.city, l.formatted_address, l.latitude, l.longitude
+			FROM event_show_location l
+			JOIN event_show s ON s.id = l.show_id
+			JOIN event e ON e.id = s.event_id
+			WHERE
+				l.latitude BETWEEN $3 AND $4
+				AND l.longitude BETWEEN $5 AND $6
+				AND s.venue_id IS NULL
+				AND s.type <> 'ONLINE'
+				AND s.status = 'ACTIVE'
+				AND e.status = 'ACTIVE'
+				AND s.time > $7
+		) c
+		CROSS JOIN LATERAL (
+			SELECT
+				2 * $8::DOUBLE PRECISION * asin(sqrt(
+					power(sin(radians(c.latitude - $1::DOUBLE PRECISION) / 2), 2) +
+					cos(radians($1::DOUBLE PRECISION)) * cos(radians(c.latitude)) *
+					power(sin(radians(c.longitude - $2::DOUBLE PRECISION) / 2), 2)
+				)) AS distance
+		) d
+		WHERE
+			d.distance <= $9
+		ORDER BY d.distance ASC, c.time ASC
+		OFFSET $10
+		LIMIT $11
+	`
+
+	minLat, maxLat, minLng, maxLng := boundingBox(latitude, longitude, radius)
+
+	rows, err := cmd.QueryContext(ctx, query, latitude, longitude, minLat, maxLat, minLng, maxLng, after, earthRadius, radius, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of nearby event show's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]NearbyShow, 0)
+	for rows.Next() {
+		var ns NearbyShow
+		var venueID sql.NullString
+		var l Location
+
+		err := rows.Scan(
+			&ns.Show.EventID, &ns.EventName, &ns.Show.ID, &venueID, &ns.Show.Venue, &ns.Show.Type, &ns.Show.Time, &ns.Show.Status, &ns.Show.Currency,
+			&l.Country, &l.City, &l.FormattedAddress, &l.Latitude, &l.Longitude, &ns.Distance,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of nearby event show's prorperties")
+		}
+
+		if venueID.Valid {
+			ns.Show.VenueID = &venueID.String
+		}
+		l.EventID = ns.Show.EventID
+		l.ShowID = ns.Show.ID
+		ns.Show.Location = &l
+
+		data = append(data, ns)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of nearby event show's prorperties")
+	}
+
+	return data, nil
+}
+
+// Save implements ShowRepository.
+func (r *showRepository) Save(ctx context.Context, s Show) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
 	query := `
 		INSERT INTO event_show
 		(
-			event_id, id, venue, type, time, status
+			event_id, id, venue_id, venue, type, time, status, currency
 		)
 		VALUES
 		(
-			$1, $2, $3, $4, $5, $6
+			$1, $2, $3, $4, $5, $6, $7, $8
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, s.EventID, s.ID, s.VenueID, s.Venue, s.Type, s.Time, s.Status, s.Currency)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, s.EventID, s.ID, s.Venue, s.Type, s.Time, s.Status)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving event show's prorperties")
 	}
 
 	return nil
 }
 
 // Update implements ShowRepository.
-func (r *showRepository) Update(ctx context.Context, ID string, s Show, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *showRepository) Update(ctx context.Context, ID string, s Show) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		UPDATE event_show
 		SET
-			venue = $1,
-			type = $2,
-			time = $3,
-			status = $4
-		WHERE id = $5
+			venue_id = $1,
+			venue = $2,
+			type = $3,
+			time = $4,
+			status = $5
+		WHERE id = $6
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event shows's prorperties")
-	}
-	defer stmt.Close()
-
-	_, err = stmt.ExecContext(ctx, s.Venue, s.Type, s.Time, s.Status)
+	_, err := cmd.ExecContext(ctx, query, s.VenueID, s.Venue, s.Type, s.Time, s.Status, ID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event show's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating event show's prorperties")
 	}
 
 	return nil
@@ -0,0 +1,111 @@
+package event_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/event"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+func TestShowRepositoryFindManyNearby(t *testing.T) {
+	db := openTestDatabase(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := event.NewShowRepository(logger, db, postgresql.NewReplicaSet())
+
+	ctx := context.Background()
+	now := time.Now()
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+
+	// the shows are placed around a point of the southern pacific ocean where no other show of the database is, a
+	// kilometer to the north is the latitude which is added to the one of the point
+	latitude, longitude := -45.0, -140.0
+	kilometer := 180 / (6371.0088 * math.Pi)
+
+	eventID := "EVTTEST" + suffix
+	venueID := "VENUETEST" + suffix
+
+	_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2)`, eventID, now)
+	require.NoError(t, err)
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO venue (id, name, country, city, formatted_address, latitude, longitude, capacity, timezone, created_at, updated_at)
+		VALUES ($1, 'Hall', 'Nowhere', 'Nowhere', '', $2, $3, 100, 'UTC', $4, $4)
+	`, venueID, latitude+5*kilometer, longitude, now)
+	require.NoError(t, err)
+
+	// show saves a show of the event, at the venue when there is no distance of its own, otherwise at a location of
+	// its own the given kilometers to the north of the point
+	show := func(ID, showType string, showTime time.Time, distance float64) {
+		var showVenueID *string
+		if distance == 0 {
+			showVenueID = &venueID
+		}
+
+		_, err := db.Exec(ctx, `
+			INSERT INTO event_show (event_id, id, venue_id, venue, type, time, status, currency)
+			VALUES ($1, $2, $3, 'Test', $4, $5, 'ACTIVE', 'IDR')
+		`, eventID, ID+suffix, showVenueID, showType, showTime)
+		require.NoError(t, err)
+
+		if distance == 0 {
+			return
+		}
+
+		_, err = db.Exec(ctx, `
+			INSERT INTO event_show_location (event_id, show_id, country, city, formatted_address, latitude, longitude)
+			VALUES ($1, $2, 'Nowhere', 'Nowhere', '', $3, $4)
+		`, eventID, ID+suffix, latitude+distance*kilometer, longitude)
+		require.NoError(t, err)
+	}
+
+	show("VENUELATER", "LIVE", now.Add(48*time.Hour), 0)
+	show("VENUESOONER", "LIVE", now.Add(24*time.Hour), 0)
+	show("NEAREST", "LIVE", now.Add(72*time.Hour), 1)
+	show("FARTHER", "LIVE", now.Add(24*time.Hour), 12)
+	show("OUTSIDE", "LIVE", now.Add(24*time.Hour), 30)
+	show("ONLINE", "ONLINE", now.Add(24*time.Hour), 0.5)
+	show("PAST", "LIVE", now.Add(-24*time.Hour), 2)
+
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM event_show_location WHERE event_id = $1`, eventID)
+		db.Exec(ctx, `DELETE FROM event_show WHERE event_id = $1`, eventID)
+		db.Exec(ctx, `DELETE FROM venue WHERE id = $1`, venueID)
+		db.Exec(ctx, `DELETE FROM event WHERE id = $1`, eventID)
+	})
+
+	t.Run("the upcoming shows within the radius are ordered by distance, then by time", func(t *testing.T) {
+		shows, err := repo.FindManyNearby(ctx, latitude, longitude, 20, now, 0, 10)
+		require.NoError(t, err)
+
+		IDs := make([]string, len(shows))
+		for k, ns := range shows {
+			IDs[k] = ns.Show.ID
+		}
+		assert.Equal(t, []string{"NEAREST" + suffix, "VENUESOONER" + suffix, "VENUELATER" + suffix, "FARTHER" + suffix}, IDs)
+
+		distances := []float64{1, 5, 5, 12}
+		for k, ns := range shows {
+			assert.InDelta(t, distances[k], ns.Distance, 0.01)
+		}
+	})
+
+	t.Run("a page starts after the shows of the pages before it", func(t *testing.T) {
+		shows, err := repo.FindManyNearby(ctx, latitude, longitude, 20, now, 2, 2)
+		require.NoError(t, err)
+
+		require.Len(t, shows, 2)
+		assert.Equal(t, "VENUELATER"+suffix, shows[0].Show.ID)
+		assert.Equal(t, "FARTHER"+suffix, shows[1].Show.ID)
+	})
+}
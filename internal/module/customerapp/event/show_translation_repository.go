@@ -0,0 +1,61 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ShowTranslationRepository interface {
+	FindManyByShowIDs(ctx context.Context, showIDs []string, locale string) ([]ShowTranslation, error)
+}
+
+type showTranslationRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+// FindManyByShowIDs implements ShowTranslationRepository.
+func (r *showTranslationRepository) FindManyByShowIDs(ctx context.Context, showIDs []string, locale string) ([]ShowTranslation, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			event_id, show_id, locale, venue
+		FROM event_show_translation
+		WHERE
+			show_id = ANY($1)
+			AND locale = $2
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, showIDs, locale)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show translation's prorperties")
+	}
+	defer rows.Close()
+
+	bunchOfTranslations := make([]ShowTranslation, 0)
+	for rows.Next() {
+		var data ShowTranslation
+		if err := rows.Scan(&data.EventID, &data.ShowID, &data.Locale, &data.Venue); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event show translation's prorperties")
+		}
+		bunchOfTranslations = append(bunchOfTranslations, data)
+	}
+
+	return bunchOfTranslations, nil
+}
+
+func NewShowTranslationRepository(logger *logrus.Logger, db *pgxpool.Pool) ShowTranslationRepository {
+	return &showTranslationRepository{
+		logger: logger,
+		db:     db,
+	}
+}
@@ -0,0 +1,111 @@
+package event
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type TaxonomyRepository interface {
+	FindManyByEventIDs(ctx context.Context, eventIDs []string) ([]Taxonomy, error)
+	FindManyFacets(ctx context.Context, filter EventFilter) ([]Facet, error)
+}
+
+type taxonomyRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewTaxonomyRepository(logger *logrus.Logger, db *pgxpool.Pool) TaxonomyRepository {
+	return &taxonomyRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindManyByEventIDs implements TaxonomyRepository.
+func (r *taxonomyRepository) FindManyByEventIDs(ctx context.Context, eventIDs []string) ([]Taxonomy, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			et.event_id, t.id, t.type, t.slug, t.name
+		FROM event_taxonomy et
+		JOIN taxonomy t ON t.id = et.taxonomy_id
+		WHERE
+			et.event_id = ANY($1)
+		ORDER BY t.type ASC, t.name ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, eventIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event taxonomy's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Taxonomy, 0)
+	for rows.Next() {
+		var t Taxonomy
+
+		err := rows.Scan(&t.EventID, &t.TaxonomyID, &t.Type, &t.Slug, &t.Name)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event taxonomy's prorperties")
+		}
+
+		data = append(data, t)
+	}
+
+	return data, nil
+}
+
+// FindManyFacets implements TaxonomyRepository. Every taxonomy is counted by the events which match the filter but the
+// criterion of its own type, so that the counts of a type tell what picking another of its taxonomies would return.
+// The ones which are not attached to any of the events are left out.
+func (r *taxonomyRepository) FindManyFacets(ctx context.Context, filter EventFilter) ([]Facet, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			t.type, t.slug, t.name, count(DISTINCT e.id)
+		FROM event e
+		JOIN event_taxonomy et ON et.event_id = e.id
+		JOIN taxonomy t ON t.id = et.taxonomy_id
+		WHERE
+			(t.type = 'CATEGORY' OR ` + eventCategoryFilter + `)
+			AND (t.type = 'GENRE' OR ` + eventGenreFilter + `)
+			AND (t.type = 'TAG' OR ` + eventTagFilter + `)
+		GROUP BY t.type, t.slug, t.name
+		ORDER BY t.type ASC, count(DISTINCT e.id) DESC, t.name ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, filter.Category, filter.Genres, filter.Tags)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of event taxonomy's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Facet, 0)
+	for rows.Next() {
+		var f Facet
+
+		err := rows.Scan(&f.Type, &f.Slug, &f.Name, &f.Count)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of event taxonomy's prorperties")
+		}
+
+		data = append(data, f)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,121 @@
+package event_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/event"
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+)
+
+// openTestDatabase connects to the database of POSTGRESQL_TEST_DSN and migrates it, the test is skipped without one.
+func openTestDatabase(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRESQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRESQL_TEST_DSN is not set")
+	}
+
+	db, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	require.NoError(t, err)
+
+	_, err = migration.NewMigrator(db, ms).Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestTaxonomyRepositoryFindManyFacets(t *testing.T) {
+	db := openTestDatabase(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := event.NewTaxonomyRepository(logger, db)
+
+	ctx := context.Background()
+	now := time.Now()
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+
+	// the taxonomies are of the test only, the events of the database do not count for them
+	taxonomies := map[string]string{
+		"concert": "CATEGORY", "festival": "CATEGORY",
+		"rock": "GENRE", "jazz": "GENRE",
+		"outdoor": "TAG",
+	}
+	for slug, taxonomyType := range taxonomies {
+		_, err := db.Exec(ctx, `INSERT INTO taxonomy (id, type, slug, name, created_at, updated_at) VALUES ($1, $2, $3, $3, $4, $4)`, "TAXTEST"+slug+suffix, taxonomyType, slug+suffix, now)
+		require.NoError(t, err)
+	}
+
+	events := map[string][]string{
+		"EVTTEST1" + suffix: {"concert", "rock", "outdoor"},
+		"EVTTEST2" + suffix: {"concert", "jazz"},
+		"EVTTEST3" + suffix: {"festival", "rock"},
+	}
+	for eventID, slugs := range events {
+		_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2)`, eventID, now)
+		require.NoError(t, err)
+
+		for _, slug := range slugs {
+			_, err := db.Exec(ctx, `INSERT INTO event_taxonomy (event_id, taxonomy_id) VALUES ($1, $2)`, eventID, "TAXTEST"+slug+suffix)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		for eventID := range events {
+			db.Exec(ctx, `DELETE FROM event WHERE id = $1`, eventID)
+		}
+		for slug := range taxonomies {
+			db.Exec(ctx, `DELETE FROM taxonomy WHERE id = $1`, "TAXTEST"+slug+suffix)
+		}
+	})
+
+	// counts returns the counts of the facets of the test by their slug
+	counts := func(t *testing.T, filter event.EventFilter) map[string]int64 {
+		facets, err := repo.FindManyFacets(ctx, filter)
+		require.NoError(t, err)
+
+		data := make(map[string]int64)
+		for _, f := range facets {
+			for slug := range taxonomies {
+				if f.Slug == slug+suffix {
+					data[slug] = f.Count
+				}
+			}
+		}
+
+		return data
+	}
+
+	t.Run("every taxonomy is counted by the events which are attached to it", func(t *testing.T) {
+		assert.Equal(t, map[string]int64{"concert": 2, "festival": 1, "rock": 2, "jazz": 1, "outdoor": 1}, counts(t, event.EventFilter{}))
+	})
+
+	t.Run("a type is counted without its own criterion but with the others", func(t *testing.T) {
+		got := counts(t, event.EventFilter{Category: "concert" + suffix, Genres: []string{"rock" + suffix}})
+
+		// the categories are counted by the rock events, the genres by the concerts, the tags by the rock concerts
+		assert.Equal(t, map[string]int64{"concert": 1, "festival": 1, "rock": 1, "jazz": 1, "outdoor": 1}, got)
+	})
+
+	t.Run("a type whose criterion matches nothing still counts its other taxonomies", func(t *testing.T) {
+		got := counts(t, event.EventFilter{Tags: []string{"outdoor" + suffix}, Genres: []string{"jazz" + suffix}})
+
+		// no event is both jazz and outdoor, the genres are counted by the outdoor events and the tags by the jazz ones
+		assert.Equal(t, map[string]int64{"rock": 1}, got)
+	})
+}
@@ -7,64 +7,125 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/follow"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/media"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/promo"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/seat"
 	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/waitlist"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
 	"golang.org/x/sync/errgroup"
 )
 
 type EventUseCase interface {
 	OnOrderPaid(ctx context.Context, e OrderPaidEvent) error
-	GetManyEvent(ctx context.Context, req GetManyEventRequest) (GetManyEventResponse, error)
+	OnOrderRefunded(ctx context.Context, e OrderRefundedEvent) error
+	GetManyEvent(ctx context.Context, req GetManyEventRequest) (GetManyEventResponse, GetManyEventMeta, error)
 	GetManyShow(ctx context.Context, req GetManyShowRequest) (GetManyShowResponse, error)
+	GetManyNearbyShow(ctx context.Context, req GetManyNearbyShowRequest) (GetManyNearbyShowResponse, error)
 	GetManyShowTickets(ctx context.Context, req GetManyShowTicketsRequest) (GetManyShowTicketsResponse, error)
+	CreatePriceQuote(ctx context.Context, req CreatePriceQuoteRequest) (PriceQuoteResponse, error)
+	GetPriceQuote(ctx context.Context, req GetPriceQuoteRequest) (PriceQuoteResponse, error)
 	GetManyAcquiredTickets(ctx context.Context, req GetManyAcquiredTicketRequest) (GetManyAcquiredTicketResponse, error)
 }
 
 type eventUseCase struct {
-	logger                   *logrus.Logger
-	location                 *time.Location
-	timeout                  time.Duration
-	eventRepository          EventRepository
-	artistRepository         ArtistRepository
-	promotorRepository       PromotorRepository
-	showRepository           ShowRepository
-	locationRepository       LocationRepository
-	ticketStockRepository    ticket.TicketStockRepository
-	acquiredTicketRepository ticket.AcquiredTicketRepository
-	publisher                pubsub.Publisher
+	logger                     *logrus.Logger
+	location                   *time.Location
+	timeout                    time.Duration
+	eventRepository            EventRepository
+	artistRepository           ArtistRepository
+	promotorRepository         PromotorRepository
+	showRepository             ShowRepository
+	locationRepository         LocationRepository
+	eventTranslationRepository EventTranslationRepository
+	showTranslationRepository  ShowTranslationRepository
+	taxonomyRepository         TaxonomyRepository
+	venueRepository            VenueRepository
+	ticketStockRepository      ticket.TicketStockRepository
+	acquiredTicketRepository   ticket.AcquiredTicketRepository
+	priceRuleRepository        ticket.PriceRuleRepository
+	priceChangeRepository      ticket.PriceChangeRepository
+	priceQuoteRepository       ticket.PriceQuoteRepository
+	showSeatRepository         seat.ShowSeatRepository
+	promoCodeRepository        promo.PromoCodeRepository
+	promoRedemptionRepository  promo.PromoRedemptionRepository
+	waitlistEntryRepository    waitlist.WaitlistEntryRepository
+	mediaRepository            media.MediaRepository
+	storage                    storage.Storage
+	publisher                  pubsub.Publisher
+	followNotifier             follow.Notifier
+	waitlistOfferer            waitlist.Offerer
+	txManager                  postgresql.TxManager
 }
 
 type EventUseCaseProperty struct {
-	Logger                   *logrus.Logger
-	Location                 *time.Location
-	Timeout                  time.Duration
-	EventRepository          EventRepository
-	ArtistRepository         ArtistRepository
-	PromotorRepository       PromotorRepository
-	ShowRepository           ShowRepository
-	LocationRepository       LocationRepository
-	TicketStockRepository    ticket.TicketStockRepository
-	AcquiredTicketRepository ticket.AcquiredTicketRepository
-	Publisher                pubsub.Publisher
+	Logger                     *logrus.Logger
+	Location                   *time.Location
+	Timeout                    time.Duration
+	EventRepository            EventRepository
+	ArtistRepository           ArtistRepository
+	PromotorRepository         PromotorRepository
+	ShowRepository             ShowRepository
+	LocationRepository         LocationRepository
+	EventTranslationRepository EventTranslationRepository
+	ShowTranslationRepository  ShowTranslationRepository
+	TaxonomyRepository         TaxonomyRepository
+	VenueRepository            VenueRepository
+	TicketStockRepository      ticket.TicketStockRepository
+	AcquiredTicketRepository   ticket.AcquiredTicketRepository
+	PriceRuleRepository        ticket.PriceRuleRepository
+	PriceChangeRepository      ticket.PriceChangeRepository
+	PriceQuoteRepository       ticket.PriceQuoteRepository
+	ShowSeatRepository         seat.ShowSeatRepository
+	PromoCodeRepository        promo.PromoCodeRepository
+	PromoRedemptionRepository  promo.PromoRedemptionRepository
+	WaitlistEntryRepository    waitlist.WaitlistEntryRepository
+	MediaRepository            media.MediaRepository
+	Storage                    storage.Storage
+	Publisher                  pubsub.Publisher
+	FollowNotifier             follow.Notifier
+	WaitlistOfferer            waitlist.Offerer
+	TxManager                  postgresql.TxManager
 }
 
 func NewEventUseCase(props EventUseCaseProperty) EventUseCase {
 	return &eventUseCase{
-		logger:                   props.Logger,
-		location:                 props.Location,
-		timeout:                  props.Timeout,
-		eventRepository:          props.EventRepository,
-		artistRepository:         props.ArtistRepository,
-		promotorRepository:       props.PromotorRepository,
-		showRepository:           props.ShowRepository,
-		locationRepository:       props.LocationRepository,
-		ticketStockRepository:    props.TicketStockRepository,
-		acquiredTicketRepository: props.AcquiredTicketRepository,
-		publisher:                props.Publisher,
+		logger:                     props.Logger,
+		location:                   props.Location,
+		timeout:                    props.Timeout,
+		eventRepository:            props.EventRepository,
+		artistRepository:           props.ArtistRepository,
+		promotorRepository:         props.PromotorRepository,
+		showRepository:             props.ShowRepository,
+		locationRepository:         props.LocationRepository,
+		eventTranslationRepository: props.EventTranslationRepository,
+		showTranslationRepository:  props.ShowTranslationRepository,
+		taxonomyRepository:         props.TaxonomyRepository,
+		venueRepository:            props.VenueRepository,
+		ticketStockRepository:      props.TicketStockRepository,
+		acquiredTicketRepository:   props.AcquiredTicketRepository,
+		priceRuleRepository:        props.PriceRuleRepository,
+		priceChangeRepository:      props.PriceChangeRepository,
+		priceQuoteRepository:       props.PriceQuoteRepository,
+		showSeatRepository:         props.ShowSeatRepository,
+		promoCodeRepository:        props.PromoCodeRepository,
+		promoRedemptionRepository:  props.PromoRedemptionRepository,
+		waitlistEntryRepository:    props.WaitlistEntryRepository,
+		mediaRepository:            props.MediaRepository,
+		storage:                    props.Storage,
+		publisher:                  props.Publisher,
+		followNotifier:             props.FollowNotifier,
+		waitlistOfferer:            props.WaitlistOfferer,
+		txManager:                  props.TxManager,
 	}
 }
 
@@ -86,7 +147,7 @@ func (u *eventUseCase) GetManyAcquiredTickets(ctx context.Context, req GetManyAc
 
 	g, gctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		acqs, err := u.acquiredTicketRepository.FindManyByCustomerID(gctx, acc.ID, offset, limit, nil)
+		acqs, err := u.acquiredTicketRepository.FindManyByCustomerID(gctx, acc.ID, offset, limit)
 		if err != nil {
 			return err
 		}
@@ -95,7 +156,7 @@ func (u *eventUseCase) GetManyAcquiredTickets(ctx context.Context, req GetManyAc
 		return nil
 	})
 	g.Go(func() error {
-		count, err := u.acquiredTicketRepository.CountByCustomerID(gctx, acc.ID, nil)
+		count, err := u.acquiredTicketRepository.CountByCustomerID(gctx, acc.ID)
 		if err != nil {
 			return err
 		}
@@ -119,20 +180,23 @@ func (u *eventUseCase) GetManyAcquiredTickets(ctx context.Context, req GetManyAc
 	return resp, nil
 }
 
-// GetManyEvent implements EventUseCase.
-func (u *eventUseCase) GetManyEvent(ctx context.Context, req GetManyEventRequest) (GetManyEventResponse, error) {
+// GetManyEvent implements EventUseCase. The events are filtered by their taxonomies, the meta holds the number of the
+// filtered events per category, genre and tag.
+func (u *eventUseCase) GetManyEvent(ctx context.Context, req GetManyEventRequest) (GetManyEventResponse, GetManyEventMeta, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
 	offset := (req.Page - 1) * req.Size
 	limit := req.Size
+	filter := req.Filter()
 
 	var bunchOfEvents []Event
 	var total int64
+	var facets []Facet
 
 	g, gctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		count, err := u.eventRepository.Count(gctx, nil)
+		count, err := u.eventRepository.Count(gctx, filter)
 		if err != nil {
 			return err
 		}
@@ -140,16 +204,49 @@ func (u *eventUseCase) GetManyEvent(ctx context.Context, req GetManyEventRequest
 		return nil
 	})
 	g.Go(func() error {
-		events, err := u.eventRepository.FindMany(ctx, offset, limit, nil)
+		events, err := u.eventRepository.FindMany(ctx, filter, offset, limit)
 		if err != nil {
 			return err
 		}
 		bunchOfEvents = events
 		return nil
 	})
+	g.Go(func() error {
+		data, err := u.taxonomyRepository.FindManyFacets(gctx, filter)
+		if err != nil {
+			return err
+		}
+		facets = data
+		return nil
+	})
 
 	if err := g.Wait(); err != nil {
-		return GetManyEventResponse{}, err
+		return GetManyEventResponse{}, GetManyEventMeta{}, err
+	}
+
+	bunchOfEvents, err := u.translateEvents(ctx, bunchOfEvents)
+	if err != nil {
+		return GetManyEventResponse{}, GetManyEventMeta{}, err
+	}
+
+	eventIDs := make([]string, len(bunchOfEvents))
+	for k, v := range bunchOfEvents {
+		eventIDs[k] = v.ID
+	}
+
+	bunchOfMedia, err := u.mediaRepository.FindManyByOwnerIDs(ctx, media.OwnerTypeEvent, eventIDs)
+	if err != nil {
+		return GetManyEventResponse{}, GetManyEventMeta{}, err
+	}
+	mediaByEventID := media.GroupByOwnerID(bunchOfMedia)
+
+	taxonomies, err := u.taxonomyRepository.FindManyByEventIDs(ctx, eventIDs)
+	if err != nil {
+		return GetManyEventResponse{}, GetManyEventMeta{}, err
+	}
+	taxonomiesByEventID := make(map[string][]Taxonomy)
+	for _, v := range taxonomies {
+		taxonomiesByEventID[v.EventID] = append(taxonomiesByEventID[v.EventID], v)
 	}
 
 	resp := GetManyEventResponse{
@@ -158,25 +255,30 @@ func (u *eventUseCase) GetManyEvent(ctx context.Context, req GetManyEventRequest
 	}
 
 	for k, v := range bunchOfEvents {
-		bunchOfArtist, err := u.artistRepository.FindManyByEventID(ctx, v.ID, nil)
+		bunchOfArtist, err := u.artistRepository.FindManyByEventID(ctx, v.ID)
 		if err != nil {
-			return GetManyEventResponse{}, nil
+			return GetManyEventResponse{}, GetManyEventMeta{}, nil
 		}
 
-		bunchOfPromotors, err := u.promotorRepository.FindManyByEventID(ctx, v.ID, nil)
+		bunchOfPromotors, err := u.promotorRepository.FindManyByEventID(ctx, v.ID)
 		if err != nil {
-			return GetManyEventResponse{}, nil
+			return GetManyEventResponse{}, GetManyEventMeta{}, nil
 		}
 
 		v.Artists = bunchOfArtist
 		v.Promotors = bunchOfPromotors
+		v.Taxonomies = taxonomiesByEventID[v.ID]
 
 		e := EventResponse{}
 		e.PopulateFromEntity(v)
+		e.Media = media.NewMediaResponses(mediaByEventID[v.ID], u.storage)
 		resp.Events[k] = e
 	}
 
-	return resp, nil
+	meta := GetManyEventMeta{}
+	meta.Facets.PopulateFromEntity(facets)
+
+	return resp, meta, nil
 }
 
 // GetManyShow implements EventUseCase.
@@ -184,7 +286,12 @@ func (u *eventUseCase) GetManyShow(ctx context.Context, req GetManyShowRequest)
 	ctx, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	bunchOfShows, err := u.showRepository.FindManyByEventID(ctx, req.EventID, nil)
+	bunchOfShows, err := u.showRepository.FindManyByEventID(ctx, req.EventID)
+	if err != nil {
+		return GetManyShowResponse{}, err
+	}
+
+	bunchOfShows, err = u.translateShows(ctx, bunchOfShows)
 	if err != nil {
 		return GetManyShowResponse{}, err
 	}
@@ -194,20 +301,24 @@ func (u *eventUseCase) GetManyShow(ctx context.Context, req GetManyShowRequest)
 	}
 
 	for k, v := range bunchOfShows {
-		location, err := u.locationRepository.FindByShowID(ctx, v.ID, nil)
+		location, err := u.findShowLocation(ctx, v)
 		if err != nil {
 			return GetManyShowResponse{}, err
 		}
 
-		lr := &LocationResponse{
-			Country:          location.Country,
-			City:             location.City,
-			FormattedAddress: location.FormattedAddress,
-			Latitude:         location.Latitude,
-			Longitude:        location.Longitude,
+		var lr *LocationResponse
+		if location != nil {
+			lr = &LocationResponse{
+				Country:          location.Country,
+				City:             location.City,
+				FormattedAddress: location.FormattedAddress,
+				Latitude:         location.Latitude,
+				Longitude:        location.Longitude,
+			}
 		}
 		sr := ShowResponse{
 			ID:       v.ID,
+			VenueID:  v.VenueID,
 			Venue:    v.Venue,
 			Type:     v.Type,
 			Location: lr,
@@ -221,119 +332,649 @@ func (u *eventUseCase) GetManyShow(ctx context.Context, req GetManyShowRequest)
 	return resp, nil
 }
 
+// GetManyNearbyShow implements EventUseCase.
+func (u *eventUseCase) GetManyNearbyShow(ctx context.Context, req GetManyNearbyShowRequest) (GetManyNearbyShowResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	nearbyShows, err := u.showRepository.FindManyNearby(ctx, req.Latitude, req.Longitude, req.Radius, time.Now(), offset, limit)
+	if err != nil {
+		return GetManyNearbyShowResponse{}, err
+	}
+
+	nearbyShows, err = u.translateNearbyShows(ctx, nearbyShows)
+	if err != nil {
+		return GetManyNearbyShowResponse{}, err
+	}
+
+	resp := GetManyNearbyShowResponse{
+		Shows: make([]NearbyShowResponse, len(nearbyShows)),
+	}
+
+	for k, ns := range nearbyShows {
+		resp.Shows[k].PopulateFromEntity(ns)
+	}
+
+	return resp, nil
+}
+
+// translateEvents translates the events into the locale of the context, an event without a translation keeps its own
+// name and description.
+func (u *eventUseCase) translateEvents(ctx context.Context, events []Event) ([]Event, error) {
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	eventIDs := make([]string, len(events))
+	for k, v := range events {
+		eventIDs[k] = v.ID
+	}
+
+	translations, err := u.eventTranslationRepository.FindManyByEventIDs(ctx, eventIDs, i18n.FromContext(ctx).String())
+	if err != nil {
+		return nil, err
+	}
+
+	byEventID := make(map[string]EventTranslation)
+	for _, v := range translations {
+		byEventID[v.EventID] = v
+	}
+
+	translated := make([]Event, len(events))
+	for k, v := range events {
+		translated[k] = v.Translate(byEventID[v.ID])
+	}
+
+	return translated, nil
+}
+
+// translateShows translates the shows into the locale of the context, a show without a translation keeps its own
+// venue.
+func (u *eventUseCase) translateShows(ctx context.Context, shows []Show) ([]Show, error) {
+	if len(shows) == 0 {
+		return shows, nil
+	}
+
+	showIDs := make([]string, len(shows))
+	for k, v := range shows {
+		showIDs[k] = v.ID
+	}
+
+	translations, err := u.showTranslationRepository.FindManyByShowIDs(ctx, showIDs, i18n.FromContext(ctx).String())
+	if err != nil {
+		return nil, err
+	}
+
+	byShowID := make(map[string]ShowTranslation)
+	for _, v := range translations {
+		byShowID[v.ShowID] = v
+	}
+
+	translated := make([]Show, len(shows))
+	for k, v := range shows {
+		translated[k] = v.Translate(byShowID[v.ID])
+	}
+
+	return translated, nil
+}
+
+// translateNearbyShows translates both the shows and the names of their events into the locale of the context.
+func (u *eventUseCase) translateNearbyShows(ctx context.Context, nearbyShows []NearbyShow) ([]NearbyShow, error) {
+	shows := make([]Show, len(nearbyShows))
+	events := make([]Event, len(nearbyShows))
+	for k, v := range nearbyShows {
+		shows[k] = v.Show
+		events[k] = Event{ID: v.Show.EventID, Name: v.EventName}
+	}
+
+	shows, err := u.translateShows(ctx, shows)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err = u.translateEvents(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	translated := make([]NearbyShow, len(nearbyShows))
+	for k, v := range nearbyShows {
+		v.Show = shows[k]
+		v.EventName = events[k].Name
+		translated[k] = v
+	}
+
+	return translated, nil
+}
+
+// findShowLocation resolves the location of a show from its venue. Shows which were created before the venue registry
+// fall back to their own location, online shows have none.
+func (u *eventUseCase) findShowLocation(ctx context.Context, s Show) (*Location, error) {
+	if s.VenueID != nil {
+		v, err := u.venueRepository.FindByID(ctx, *s.VenueID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Location{
+			EventID:          s.EventID,
+			ShowID:           s.ID,
+			Country:          v.Country,
+			City:             v.City,
+			FormattedAddress: v.FormattedAddress,
+			Latitude:         v.Latitude,
+			Longitude:        v.Longitude,
+		}, nil
+	}
+
+	location, err := u.locationRepository.FindByShowID(ctx, s.ID)
+	if err != nil {
+		if s.Type == ShowTypeOnline && errors.MatchStatus(err, status.NOT_FOUND) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &location, nil
+}
+
 // GetManyShowTickets implements EventUseCase.
 func (u *eventUseCase) GetManyShowTickets(ctx context.Context, req GetManyShowTicketsRequest) (GetManyShowTicketsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	ticketStocks, err := u.ticketStockRepository.FindManyByShowID(ctx, req.ShowID, nil)
+	ticketStocks, err := u.ticketStockRepository.FindManyByShowID(ctx, req.ShowID)
 	if err != nil {
 		return GetManyShowTicketsResponse{}, err
 	}
 
+	priceRules, err := u.priceRuleRepository.FindManyByShowID(ctx, req.ShowID)
+	if err != nil {
+		return GetManyShowTicketsResponse{}, err
+	}
+
+	priceRulesByTicketStock := make(map[string][]ticket.PriceRule)
+	for _, pr := range priceRules {
+		priceRulesByTicketStock[pr.TicketStockID] = append(priceRulesByTicketStock[pr.TicketStockID], pr)
+	}
+
 	resp := GetManyShowTicketsResponse{
 		ShowTickets: make([]ShowTicketResponse, len(ticketStocks)),
 	}
 
+	now := time.Now()
 	for k, ts := range ticketStocks {
 		if ts.EventID != req.EventID {
 			return GetManyShowTicketsResponse{}, err
 		}
 
-		stock := ts.Allocation - ts.Acquired
-		st := ShowTicketResponse{
-			ID:    ts.ID,
-			Tier:  ts.Tier,
-			Stock: stock,
-			Price: ts.Price,
-		}
+		resp.ShowTickets[k].PopulateFromEntity(ts, ts.Quote(priceRulesByTicketStock[ts.ID], now))
+	}
 
-		resp.ShowTickets[k] = st
+	return resp, nil
+}
+
+// CreatePriceQuote implements EventUseCase. The quoted price is honoured by the order flow until the quote expires.
+func (u *eventUseCase) CreatePriceQuote(ctx context.Context, req CreatePriceQuoteRequest) (PriceQuoteResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return PriceQuoteResponse{}, err
+	}
+
+	ts, err := u.ticketStockRepository.FindByID(ctx, req.TicketStockID)
+	if err != nil {
+		return PriceQuoteResponse{}, err
+	}
+
+	if ts.EventID != req.EventID || ts.ShowID != req.ShowID {
+		return PriceQuoteResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), req.TicketStockID))
+	}
+
+	priceRules, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+	if err != nil {
+		return PriceQuoteResponse{}, err
+	}
+
+	now := time.Now()
+	q := ts.Quote(priceRules, now)
+
+	pq := ticket.PriceQuote{
+		ID:            util.GenerateTimestampWithPrefix("QUOTE"),
+		EventID:       ts.EventID,
+		ShowID:        ts.ShowID,
+		TicketStockID: ts.ID,
+		CustomerID:    acc.ID,
+		Price:         q.Price,
+		Rule:          q.Rule,
+		ExpiresAt:     now.Add(ticket.PriceQuoteDuration),
+		CreatedAt:     now,
 	}
 
+	if err := u.priceQuoteRepository.Save(ctx, pq); err != nil {
+		return PriceQuoteResponse{}, err
+	}
+
+	resp := PriceQuoteResponse{}
+	resp.PopulateFromEntity(pq)
+
 	return resp, nil
 }
 
-// OnOrderPaid implements EventUseCase.
-func (u *eventUseCase) OnOrderPaid(ctx context.Context, oe OrderPaidEvent) error {
+// GetPriceQuote implements EventUseCase.
+func (u *eventUseCase) GetPriceQuote(ctx context.Context, req GetPriceQuoteRequest) (PriceQuoteResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	tx, err := u.eventRepository.BeginTx(ctx)
+	acc, err := session.GetAccountFromCtx(ctx)
 	if err != nil {
-		return err
+		return PriceQuoteResponse{}, err
 	}
-	if len(oe.Items) < 1 {
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "invalid items")
+
+	pq, err := u.priceQuoteRepository.FindByID(ctx, req.ID)
+	if err != nil {
+		return PriceQuoteResponse{}, err
 	}
-	orderItem := oe.Items[0]
 
-	e, err := u.eventRepository.FindByID(ctx, orderItem.EventID, tx)
+	if pq.CustomerID != acc.ID {
+		return PriceQuoteResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket price quote"), req.ID))
+	}
+
+	resp := PriceQuoteResponse{}
+	resp.PopulateFromEntity(pq)
+
+	return resp, nil
+}
+
+// orderItemPrice returns the price of the order item. The price of a valid quote is honoured, otherwise the price which is carried by the order item is used.
+func (u *eventUseCase) orderItemPrice(ctx context.Context, oe OrderPaidEvent, item Item) money.Money {
+	if item.PriceQuoteID == nil {
+		return item.Price
+	}
+
+	pq, err := u.priceQuoteRepository.FindByID(ctx, *item.PriceQuoteID)
 	if err != nil {
-		u.eventRepository.Rollback(ctx, tx)
-		return err
+		u.logger.WithContext(ctx).WithError(err).WithField("order_id", oe.ID).Warn("price quote of the order item could not be found")
+		return item.Price
+	}
+
+	if pq.TicketStockID != item.TicketStockID || pq.CustomerID != oe.CustomerID || oe.CreatedAt.After(pq.ExpiresAt) {
+		u.logger.WithContext(ctx).WithField("order_id", oe.ID).WithField("price_quote_id", pq.ID).Warn("price quote of the order item is not valid for the order")
+		return item.Price
+	}
+
+	return pq.Price
+}
+
+// publishOversell reports the order item which could not be fulfilled because the ticket stock sold out, the order
+// service refunds the order.
+func (u *eventUseCase) publishOversell(ctx context.Context, oe OrderPaidEvent, orderItem Item, available int64, now time.Time) {
+	ov := OversellEvent{
+		OrderID:       oe.ID,
+		CustomerID:    oe.CustomerID,
+		EventID:       orderItem.EventID,
+		ShowID:        orderItem.ShowID,
+		TicketStockID: orderItem.TicketStockID,
+		Tier:          orderItem.Tier,
+		Quantity:      orderItem.Quantity,
+		Available:     available,
+		CreatedAt:     now,
 	}
 
-	s, err := u.showRepository.FindByID(ctx, orderItem.ShowID, tx)
+	ovBuff, _ := json.Marshal(ov)
+
+	if err := u.publisher.Publish(ctx, OversellTopic, oe.ID, nil, ovBuff); err != nil {
+		u.logger.WithContext(ctx).WithError(err).Error()
+	}
+}
+
+// consumeWaitlistEntry takes the customer off the waitlist of the ticket stock once the customer has acquired its
+// tickets. The tickets which are offered to the customer are used up by the order, the offered tickets which are not
+// ordered are back on the general sale. It returns the number of tickets which were reserved for the customer.
+func (u *eventUseCase) consumeWaitlistEntry(ctx context.Context, customerID int64, ticketStockID string, now time.Time) (int64, error) {
+	we, err := u.waitlistEntryRepository.FindActiveByCustomerIDForUpdate(ctx, customerID, ticketStockID)
 	if err != nil {
-		u.eventRepository.Rollback(ctx, tx)
-		return err
+		if errors.MatchStatus(err, status.NOT_FOUND) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var reserved int64
+	if we.Status == waitlist.WaitlistEntryStatusOffered {
+		reserved = we.Quantity
 	}
 
-	loc, err := u.locationRepository.FindByShowID(ctx, orderItem.ShowID, tx)
+	we.Status = waitlist.WaitlistEntryStatusPurchased
+	we.UpdatedAt = now
+
+	if err := u.waitlistEntryRepository.Update(ctx, we); err != nil {
+		return 0, err
+	}
+
+	return reserved, nil
+}
+
+// checkPromoLimits checks that the lapsed promo redemption still fits in the limits of its code, the redemption no
+// longer counts against them once it has been released or has expired. The code is locked until the transaction
+// ends, which serializes the check with the other redemptions of the code.
+func (u *eventUseCase) checkPromoLimits(ctx context.Context, pr promo.PromoRedemption, now time.Time) error {
+	pc, err := u.promoCodeRepository.FindByCodeForUpdate(ctx, pr.EventID, pr.Code)
 	if err != nil {
-		u.eventRepository.Rollback(ctx, tx)
 		return err
 	}
 
-	ts, err := u.ticketStockRepository.FindByIDForUpdate(ctx, orderItem.TicketStockID, tx)
+	if pc.UsageLimit != nil {
+		redeemed, err := u.promoRedemptionRepository.SumQuantity(ctx, pc.ID, nil, now)
+		if err != nil {
+			return err
+		}
+
+		if redeemed+pr.Quantity > *pc.UsageLimit {
+			return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeUsageLimit, pc.Code))
+		}
+	}
+
+	if pc.PerCustomerLimit != nil {
+		redeemed, err := u.promoRedemptionRepository.SumQuantity(ctx, pc.ID, &pr.CustomerID, now)
+		if err != nil {
+			return err
+		}
+
+		if redeemed+pr.Quantity > *pc.PerCustomerLimit {
+			return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeCustomerLimit, pc.Code))
+		}
+	}
+
+	return nil
+}
+
+// confirmPromoRedemption confirms the promo redemption which is carried by the order. The discount which has been paid
+// is recorded as is next to the reserved one rather than rejected since the order has already been paid, a mismatch
+// between them is counted on the promo code for the admins.
+// A lapsed reservation is only confirmed while the code has not reached its limits in the meantime.
+func (u *eventUseCase) confirmPromoRedemption(ctx context.Context, oe OrderPaidEvent, now time.Time) error {
+	if oe.PromoRedemptionID == nil {
+		return nil
+	}
+
+	pr, err := u.promoRedemptionRepository.FindByIDForUpdate(ctx, *oe.PromoRedemptionID)
 	if err != nil {
-		u.eventRepository.Rollback(ctx, tx)
 		return err
 	}
 
-	now := time.Now()
-	ts.Acquired = ts.Acquired + orderItem.Quantity
-	ts.LastStockUpdate = now
+	if pr.CustomerID != oe.CustomerID {
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.PromoRedemptionNotOwned, pr.ID, oe.ID))
+	}
 
-	if err := u.ticketStockRepository.Update(ctx, orderItem.TicketStockID, ts, tx); err != nil {
-		u.eventRepository.Rollback(ctx, tx)
-		return err
+	if pr.Status == promo.PromoRedemptionStatusConfirmed {
+		return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoRedemptionConfirmed, pr.ID))
+	}
+
+	logger := u.logger.WithContext(ctx).WithField("order_id", oe.ID).WithField("promo_redemption_id", pr.ID)
+	if pr.Status == promo.PromoRedemptionStatusReleased || !now.Before(pr.ExpiresAt) {
+		if err := u.checkPromoLimits(ctx, pr, now); err != nil {
+			return err
+		}
+		logger.Warn("promo redemption of the order is no longer reserved")
+	}
+	if !oe.Discount.Equal(pr.Discount) {
+		logger.WithField("discount", oe.Discount.String()).WithField("reserved_discount", pr.Discount.String()).Warn("discount of the order differs from the promo redemption")
+	}
+
+	pr.Status = promo.PromoRedemptionStatusConfirmed
+	pr.OrderID = &oe.ID
+	pr.PaidDiscount = &oe.Discount
+	pr.UpdatedAt = now
+
+	return u.promoRedemptionRepository.Update(ctx, pr.ID, pr)
+}
+
+// OnOrderPaid implements EventUseCase.
+func (u *eventUseCase) OnOrderPaid(ctx context.Context, oe OrderPaidEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if len(oe.Items) < 1 {
+		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "invalid items")
+	}
+	orderItem := oe.Items[0]
+
+	if len(orderItem.SeatIDs) > 0 && int64(len(orderItem.SeatIDs)) != orderItem.Quantity {
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.OrderSeatCount, oe.ID, len(orderItem.SeatIDs), orderItem.Quantity))
 	}
 
-	aq := ticket.AcquiredTicket{
-		Number:               util.GenerateUniqueID(util.UppercaseNumeric, 20),
-		EventID:              e.ID,
-		ShowID:               s.ID,
-		Tier:                 ts.Tier,
-		TicketStockID:        ts.ID,
-		EventName:            e.Name,
-		ShowVenue:            s.Venue,
-		ShowType:             s.Type,
-		ShowCountry:          loc.Country,
-		ShowCity:             loc.City,
-		ShowFormattedAddress: loc.FormattedAddress,
-		ShowTime:             s.Time,
-		CustomerName:         oe.CustomerName,
-		CustomerEmail:        oe.CustomerEmail,
-		CustomerID:           oe.CustomerID,
-		OrderID:              oe.ID,
-		CreatedAt:            now,
-	}
-
-	aqID, err := u.acquiredTicketRepository.Save(ctx, aq, tx)
+	var e Event
+	var s Show
+	var ts ticket.TicketStock
+	var acquiredTickets []ticket.AcquiredTicket
+	var previousAvailable int64
+	now := time.Now()
+
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		e, err = u.eventRepository.FindByID(ctx, orderItem.EventID)
+		if err != nil {
+			return err
+		}
+
+		s, err = u.showRepository.FindByID(ctx, orderItem.ShowID)
+		if err != nil {
+			return err
+		}
+
+		loc, err := u.findShowLocation(ctx, s)
+		if err != nil {
+			return err
+		}
+		if loc == nil {
+			loc = &Location{}
+		}
+
+		reserved, err := u.consumeWaitlistEntry(ctx, oe.CustomerID, orderItem.TicketStockID, now)
+		if err != nil {
+			return err
+		}
+
+		// the stock is not read beforehand, the conditional update is what keeps the tickets within the allocation
+		ts, err = u.ticketStockRepository.Acquire(ctx, orderItem.TicketStockID, orderItem.Quantity, reserved, now)
+		if err != nil {
+			return err
+		}
+
+		// the amounts of an order which carry no currency are in the one of its ticket stock
+		oe = oe.orDefaultCurrency(ts.Price.Currency)
+		orderItem = oe.Items[0]
+
+		previous := ts
+		previous.Acquired = previous.Acquired - orderItem.Quantity
+		previous.Reserved = previous.Reserved + reserved
+		previousAvailable = previous.Available() + reserved
+
+		priceRules, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		price := u.orderItemPrice(ctx, oe, orderItem)
+		if price.Currency != ts.Price.Currency {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.OrderPriceCurrency, oe.ID, ts.Price.Currency))
+		}
+		previousQuote := previous.Quote(priceRules, now)
+
+		if currentQuote := ts.Quote(priceRules, now); !currentQuote.Price.Equal(previousQuote.Price) {
+			pc := ticket.PriceChange{
+				TicketStockID: ts.ID,
+				Price:         currentQuote.Price,
+				Rule:          currentQuote.Rule,
+				EffectiveFrom: now,
+				CreatedAt:     now,
+			}
+
+			if err := u.priceChangeRepository.Save(ctx, pc); err != nil {
+				return err
+			}
+		}
+
+		if err := u.confirmPromoRedemption(ctx, oe, now); err != nil {
+			return err
+		}
+
+		aq := ticket.AcquiredTicket{
+			EventID:              e.ID,
+			ShowID:               s.ID,
+			Tier:                 ts.Tier,
+			TicketStockID:        ts.ID,
+			EventName:            e.Name,
+			ShowVenue:            s.Venue,
+			ShowType:             s.Type,
+			ShowCountry:          loc.Country,
+			ShowCity:             loc.City,
+			ShowFormattedAddress: loc.FormattedAddress,
+			ShowTime:             s.Time,
+			CustomerName:         oe.CustomerName,
+			CustomerEmail:        oe.CustomerEmail,
+			CustomerID:           oe.CustomerID,
+			OrderID:              oe.ID,
+			Quantity:             orderItem.Quantity,
+			Price:                price,
+			CreatedAt:            now,
+		}
+
+		// a ticket without a seat stands for the whole quantity of the item, a seat is a ticket of its own
+		acquiredTickets = []ticket.AcquiredTicket{aq}
+		if len(orderItem.SeatIDs) > 0 {
+			soldSeats, err := u.showSeatRepository.MarkSold(ctx, e.ID, s.ID, orderItem.SeatIDs, oe.CustomerID, oe.ID, now)
+			if err != nil {
+				return err
+			}
+
+			if len(soldSeats) != len(orderItem.SeatIDs) {
+				return errors.New(http.StatusConflict, status.SEAT_UNAVAILABLE, i18n.Message(ctx, i18n.OrderSeatsUnavailable, oe.ID))
+			}
+
+			acquiredTickets = make([]ticket.AcquiredTicket, len(soldSeats))
+			for k, ss := range soldSeats {
+				if ss.TicketStockID != orderItem.TicketStockID {
+					return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.OrderSeatStock, ss.SeatID, oe.ID, orderItem.TicketStockID))
+				}
+
+				seatTicket := aq
+				seatTicket.Tier = ss.Tier
+				seatTicket.TicketStockID = ss.TicketStockID
+				seatTicket.SeatID = &ss.SeatID
+				seatTicket.SeatSection = &ss.Section
+				seatTicket.SeatRow = &ss.Row
+				seatTicket.SeatNumber = &ss.Number
+				seatTicket.Quantity = 1
+				acquiredTickets[k] = seatTicket
+			}
+		}
+
+		for k := range acquiredTickets {
+			acquiredTickets[k].Number = util.GenerateUniqueID(util.UppercaseNumeric, 20)
+			acquiredTickets[k].Sequence = int64(k + 1)
+		}
+
+		aqIDs, err := u.acquiredTicketRepository.SaveMany(ctx, acquiredTickets)
+		if err != nil {
+			return err
+		}
+
+		for k := range acquiredTickets {
+			acquiredTickets[k].ID = aqIDs[k]
+		}
+
+		return nil
+	})
 	if err != nil {
-		u.eventRepository.Rollback(ctx, tx)
+		if errors.MatchStatus(err, status.SOLD_OUT) {
+			var available int64
+			if current, err := u.ticketStockRepository.FindByID(ctx, orderItem.TicketStockID); err == nil {
+				available = current.Available()
+			}
+			u.publishOversell(ctx, oe, orderItem, available, now)
+		}
 		return err
 	}
 
-	u.eventRepository.CommitTx(ctx, tx)
+	for _, aq := range acquiredTickets {
+		aqBuff, _ := json.Marshal(aq)
+
+		u.publisher.Publish(ctx, "acquire-ticket", aq.Number, nil, aqBuff)
+	}
+
+	if follow.IsLowStock(ts.Allocation, previousAvailable, ts.Available()) {
+		if err := u.followNotifier.NotifyLowStock(ctx, e.ID, s.ID, ts.ID, ts.Tier, ts.Available()); err != nil {
+			u.logger.WithContext(ctx).WithError(err).Error()
+		}
+	}
+
+	return nil
+}
 
-	aq.ID = aqID
+// OnOrderRefunded implements EventUseCase. The tickets of the order which are not returned yet go back to their ticket
+// stock along with their seats, so a redelivered event returns nothing. The returned tickets are offered to the
+// waitlist once they are back, an offer which fails is made again by the offer scheduler.
+func (u *eventUseCase) OnOrderRefunded(ctx context.Context, oe OrderRefundedEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var returnedStockIDs []string
+	now := time.Now()
 
-	aqBuff, _ := json.Marshal(aq)
+	err := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		returnedStockIDs = nil
+
+		for _, orderItem := range oe.Items {
+			returnedTickets, err := u.acquiredTicketRepository.ReturnManyByOrderID(ctx, oe.ID, orderItem.TicketStockID, now)
+			if err != nil {
+				return err
+			}
+
+			if len(returnedTickets) < 1 {
+				continue
+			}
+
+			var quantity int64
+			seatIDs := make([]string, 0)
+			for _, aq := range returnedTickets {
+				quantity = quantity + aq.Quantity
+				if aq.SeatID != nil {
+					seatIDs = append(seatIDs, *aq.SeatID)
+				}
+			}
+
+			if len(seatIDs) > 0 {
+				aq := returnedTickets[0]
+				if err := u.showSeatRepository.ReleaseSold(ctx, aq.EventID, aq.ShowID, seatIDs, oe.ID, now); err != nil {
+					return err
+				}
+			}
+
+			if _, err := u.ticketStockRepository.Return(ctx, orderItem.TicketStockID, quantity, now); err != nil {
+				return err
+			}
+
+			returnedStockIDs = append(returnedStockIDs, orderItem.TicketStockID)
+		}
 
-	u.publisher.Publish(ctx, "acquire-ticket", aq.Number, nil, aqBuff)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ticketStockID := range returnedStockIDs {
+		if err := u.waitlistOfferer.OfferReturnedStock(ctx, ticketStockID); err != nil {
+			u.logger.WithContext(ctx).WithError(err).WithField("ticket_stock_id", ticketStockID).Error()
+		}
+	}
 
 	return nil
 }
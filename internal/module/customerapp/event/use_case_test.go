@@ -0,0 +1,617 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ck "github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/event"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/follow"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/promo"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/waitlist"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the order flow is not expected to call panics.
+
+// ticketStockStore is an in-memory stand-in of the ticket stock table, Acquire has the same condition as the
+// conditional update of the repository and is atomic the same way.
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+
+	mu     sync.Mutex
+	stocks map[string]ticket.TicketStock
+}
+
+func (s *ticketStockStore) find(ID string) (ticket.TicketStock, error) {
+	ts, ok := s.stocks[ID]
+	if !ok {
+		return ticket.TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return ts, nil
+}
+
+func (s *ticketStockStore) FindByID(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.find(ID)
+}
+
+func (s *ticketStockStore) Acquire(ctx context.Context, ID string, quantity int64, reserved int64, lastStockUpdate time.Time) (ticket.TicketStock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.find(ID)
+	if err != nil {
+		return ticket.TicketStock{}, err
+	}
+
+	if ts.Acquired+ts.Held+ts.Reserved-reserved+quantity > ts.Allocation {
+		return ticket.TicketStock{}, errors.New(http.StatusConflict, status.SOLD_OUT, "")
+	}
+
+	ts.Acquired = ts.Acquired + quantity
+	ts.Reserved = ts.Reserved - reserved
+	ts.LastStockUpdate = lastStockUpdate
+	s.stocks[ID] = ts
+
+	return ts, nil
+}
+
+func (s *ticketStockStore) Return(ctx context.Context, ID string, quantity int64, lastStockUpdate time.Time) (ticket.TicketStock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.find(ID)
+	if err != nil {
+		return ticket.TicketStock{}, err
+	}
+
+	ts.Acquired = ts.Acquired - quantity
+	ts.LastStockUpdate = lastStockUpdate
+	s.stocks[ID] = ts
+
+	return ts, nil
+}
+
+type acquiredTicketStore struct {
+	ticket.AcquiredTicketRepository
+
+	mu       sync.Mutex
+	tickets  []ticket.AcquiredTicket
+	returned map[int64]time.Time
+}
+
+func (s *acquiredTicketStore) SaveMany(ctx context.Context, aqs []ticket.AcquiredTicket) ([]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	IDs := make([]int64, len(aqs))
+	for k, aq := range aqs {
+		s.tickets = append(s.tickets, aq)
+		IDs[k] = int64(len(s.tickets))
+	}
+
+	return IDs, nil
+}
+
+func (s *acquiredTicketStore) ReturnManyByOrderID(ctx context.Context, orderID, ticketStockID string, returnedAt time.Time) ([]ticket.AcquiredTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.returned == nil {
+		s.returned = make(map[int64]time.Time)
+	}
+
+	data := make([]ticket.AcquiredTicket, 0)
+	for k, aq := range s.tickets {
+		ID := int64(k + 1)
+		if _, ok := s.returned[ID]; ok || aq.OrderID != orderID || aq.TicketStockID != ticketStockID {
+			continue
+		}
+
+		s.returned[ID] = returnedAt
+		aq.ID = ID
+		data = append(data, aq)
+	}
+
+	return data, nil
+}
+
+type eventStore struct {
+	event.EventRepository
+	e event.Event
+}
+
+func (s eventStore) FindByID(ctx context.Context, ID string) (event.Event, error) {
+	return s.e, nil
+}
+
+type showStore struct {
+	event.ShowRepository
+	s event.Show
+}
+
+func (s showStore) FindByID(ctx context.Context, ID string) (event.Show, error) {
+	return s.s, nil
+}
+
+type locationStore struct {
+	event.LocationRepository
+}
+
+func (locationStore) FindByShowID(ctx context.Context, showID string) (event.Location, error) {
+	return event.Location{ShowID: showID, Country: "Indonesia", City: "Jakarta"}, nil
+}
+
+type waitlistStore struct {
+	waitlist.WaitlistEntryRepository
+}
+
+func (waitlistStore) FindActiveByCustomerIDForUpdate(ctx context.Context, customerID int64, ticketStockID string) (waitlist.WaitlistEntry, error) {
+	return waitlist.WaitlistEntry{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+}
+
+type priceRuleStore struct {
+	ticket.PriceRuleRepository
+}
+
+func (priceRuleStore) FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]ticket.PriceRule, error) {
+	return nil, nil
+}
+
+type notifier struct {
+	follow.Notifier
+}
+
+func (notifier) NotifyLowStock(ctx context.Context, eventID, showID, ticketStockID, tier string, available int64) error {
+	return nil
+}
+
+type publisher struct {
+	mu       sync.Mutex
+	messages map[string][][]byte
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, key string, headers pubsub.MessageHeaders, message []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages[topic] = append(p.messages[topic], message)
+
+	return nil
+}
+
+func (p *publisher) Close() error {
+	return nil
+}
+
+type offerer struct {
+	waitlist.Offerer
+
+	mu             sync.Mutex
+	ticketStockIDs []string
+}
+
+func (o *offerer) OfferReturnedStock(ctx context.Context, ticketStockID string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.ticketStockIDs = append(o.ticketStockIDs, ticketStockID)
+
+	return nil
+}
+
+type promoCodeStore struct {
+	promo.PromoCodeRepository
+	pc promo.PromoCode
+}
+
+func (s promoCodeStore) FindByCodeForUpdate(ctx context.Context, eventID, code string) (promo.PromoCode, error) {
+	return s.pc, nil
+}
+
+// promoRedemptionStore is an in-memory stand-in of the promo redemption table, SumQuantity counts the same
+// redemptions as the query of the repository.
+type promoRedemptionStore struct {
+	promo.PromoRedemptionRepository
+
+	mu          sync.Mutex
+	redemptions map[string]promo.PromoRedemption
+}
+
+func (s *promoRedemptionStore) FindByIDForUpdate(ctx context.Context, ID string) (promo.PromoRedemption, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pr, ok := s.redemptions[ID]
+	if !ok {
+		return promo.PromoRedemption{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return pr, nil
+}
+
+func (s *promoRedemptionStore) SumQuantity(ctx context.Context, promoCodeID string, customerID *int64, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sum int64
+	for _, pr := range s.redemptions {
+		if pr.PromoCodeID != promoCodeID || (customerID != nil && pr.CustomerID != *customerID) {
+			continue
+		}
+
+		if pr.Status == promo.PromoRedemptionStatusConfirmed || (pr.Status == promo.PromoRedemptionStatusReserved && pr.ExpiresAt.After(now)) {
+			sum = sum + pr.Quantity
+		}
+	}
+
+	return sum, nil
+}
+
+func (s *promoRedemptionStore) Update(ctx context.Context, ID string, pr promo.PromoRedemption) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.redemptions[ID] = pr
+
+	return nil
+}
+
+// txManager runs fn right away, the writes of the order flow which matter here are atomic on their own.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestEventUseCaseOnOrderPaid(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	price := money.MustParse("100000", "IDR")
+
+	newUseCase := func(stocks *ticketStockStore, tickets *acquiredTicketStore, pub *publisher) event.EventUseCase {
+		return event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:                   logger,
+			Location:                 time.UTC,
+			Timeout:                  10 * time.Second,
+			EventRepository:          eventStore{e: event.Event{ID: "EVENT1", Name: "Test"}},
+			ShowRepository:           showStore{s: event.Show{EventID: "EVENT1", ID: "SHOW1", Venue: "Test", Type: "LIVE", Time: time.Now()}},
+			LocationRepository:       locationStore{},
+			TicketStockRepository:    stocks,
+			AcquiredTicketRepository: tickets,
+			PriceRuleRepository:      priceRuleStore{},
+			WaitlistEntryRepository:  waitlistStore{},
+			Publisher:                pub,
+			FollowNotifier:           notifier{},
+			TxManager:                txManager{},
+		})
+	}
+
+	t.Run("concurrent orders never acquire more tickets than the allocation", func(t *testing.T) {
+		const allocation, orders = 10, 50
+
+		stocks := &ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {ID: "TS1", Tier: "CAT1", Allocation: allocation, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+		}}
+		tickets := &acquiredTicketStore{}
+		pub := &publisher{messages: make(map[string][][]byte)}
+		uc := newUseCase(stocks, tickets, pub)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		acquired, soldOut := 0, 0
+
+		for i := 0; i < orders; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				err := uc.OnOrderPaid(context.Background(), event.OrderPaidEvent{
+					ID:         fmt.Sprintf("ORDER%d", i),
+					CustomerID: int64(i),
+					Items: []event.Item{
+						{TicketStockID: "TS1", ShowID: "SHOW1", EventID: "EVENT1", Tier: "CAT1", Price: price, Quantity: 1},
+					},
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch {
+				case err == nil:
+					acquired++
+				case errors.MatchStatus(err, status.SOLD_OUT):
+					soldOut++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		ts, err := stocks.FindByID(context.Background(), "TS1")
+		require.NoError(t, err)
+
+		assert.Equal(t, allocation, acquired)
+		assert.Equal(t, orders-allocation, soldOut)
+		assert.Equal(t, int64(allocation), ts.Acquired)
+		assert.Len(t, tickets.tickets, allocation)
+		assert.Len(t, pub.messages["acquire-ticket"], allocation)
+		assert.Len(t, pub.messages[event.OversellTopic], orders-allocation)
+	})
+
+	t.Run("an order without seats issues a ticket of its quantity", func(t *testing.T) {
+		stocks := &ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {ID: "TS1", Tier: "CAT1", Allocation: 10, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+		}}
+		tickets := &acquiredTicketStore{}
+		pub := &publisher{messages: make(map[string][][]byte)}
+		uc := newUseCase(stocks, tickets, pub)
+
+		err := uc.OnOrderPaid(context.Background(), event.OrderPaidEvent{
+			ID:         "ORDER1",
+			CustomerID: 1,
+			Items: []event.Item{
+				{TicketStockID: "TS1", ShowID: "SHOW1", EventID: "EVENT1", Tier: "CAT1", Price: price, Quantity: 3},
+			},
+		})
+		require.NoError(t, err)
+
+		ts, err := stocks.FindByID(context.Background(), "TS1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), ts.Acquired)
+
+		require.Len(t, tickets.tickets, 1)
+		assert.Equal(t, int64(3), tickets.tickets[0].Quantity)
+		assert.True(t, price.Equal(tickets.tickets[0].Price))
+	})
+
+	t.Run("a legacy order paid event of bare amounts is decoded in the currency of the ticket stock", func(t *testing.T) {
+		stocks := &ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {ID: "TS1", Tier: "CAT1", Allocation: 10, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+		}}
+		tickets := &acquiredTicketStore{}
+		pub := &publisher{messages: make(map[string][][]byte)}
+		handler := event.OrderPaidEventHandler{EventUseCase: newUseCase(stocks, tickets, pub)}
+
+		// the payload of the order service before the amounts had a currency
+		payload := `{"ID":"ORDER1","PaymentMethod":"VA","Status":"PAID","CustomerID":1,"CustomerName":"Test",` +
+			`"CustomerEmail":"test@example.com","TaxPercentage":11,"ServiceChargePercentage":0,"DiscountPercentage":0,` +
+			`"ServiceCharge":0,"Tax":22000,"Discount":0,"Subtotal":200000,"TotalAmount":222000,` +
+			`"Items":[{"ID":1,"OrderID":"ORDER1","TicketStockID":"TS1","ShowID":"SHOW1","EventID":"EVENT1",` +
+			`"EventName":"Test","ShowVenue":"Test","Tier":"CAT1","Price":100000,"Quantity":2}],` +
+			`"CreatedAt":"2024-01-01T00:00:00Z","UpdatedAt":"2024-01-01T00:00:00Z"}`
+
+		err := handler.Handle(context.Background(), &ck.Message{Value: []byte(payload)})
+		require.NoError(t, err)
+
+		require.Len(t, tickets.tickets, 1)
+		assert.Equal(t, int64(2), tickets.tickets[0].Quantity)
+		assert.True(t, price.Equal(tickets.tickets[0].Price))
+		assert.Len(t, pub.messages["acquire-ticket"], 1)
+	})
+
+	t.Run("an order which does not fit in the remaining tickets is oversold as a whole", func(t *testing.T) {
+		stocks := &ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {ID: "TS1", Tier: "CAT1", Allocation: 3, Acquired: 2, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+		}}
+		tickets := &acquiredTicketStore{}
+		pub := &publisher{messages: make(map[string][][]byte)}
+		uc := newUseCase(stocks, tickets, pub)
+
+		err := uc.OnOrderPaid(context.Background(), event.OrderPaidEvent{
+			ID:         "ORDER1",
+			CustomerID: 1,
+			Items: []event.Item{
+				{TicketStockID: "TS1", ShowID: "SHOW1", EventID: "EVENT1", Tier: "CAT1", Price: price, Quantity: 2},
+			},
+		})
+
+		assert.True(t, errors.MatchStatus(err, status.SOLD_OUT))
+		assert.Empty(t, tickets.tickets)
+		require.Len(t, pub.messages[event.OversellTopic], 1)
+
+		var ov event.OversellEvent
+		require.NoError(t, json.Unmarshal(pub.messages[event.OversellTopic][0], &ov))
+		assert.Equal(t, "ORDER1", ov.OrderID)
+		assert.Equal(t, int64(2), ov.Quantity)
+		assert.Equal(t, int64(1), ov.Available)
+	})
+}
+
+func TestEventUseCaseOnOrderPaidPromoRedemption(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	now := time.Now()
+	price := money.MustParse("100000", "IDR")
+	usageLimit := int64(3)
+
+	newUseCase := func(redemptions *promoRedemptionStore, tickets *acquiredTicketStore) event.EventUseCase {
+		return event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:             logger,
+			Location:           time.UTC,
+			Timeout:            10 * time.Second,
+			EventRepository:    eventStore{e: event.Event{ID: "EVENT1", Name: "Test"}},
+			ShowRepository:     showStore{s: event.Show{EventID: "EVENT1", ID: "SHOW1", Venue: "Test", Type: "LIVE", Time: now}},
+			LocationRepository: locationStore{},
+			TicketStockRepository: &ticketStockStore{stocks: map[string]ticket.TicketStock{
+				"TS1": {ID: "TS1", Tier: "CAT1", Allocation: 10, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+			}},
+			AcquiredTicketRepository:  tickets,
+			PriceRuleRepository:       priceRuleStore{},
+			WaitlistEntryRepository:   waitlistStore{},
+			PromoCodeRepository:       promoCodeStore{pc: promo.PromoCode{ID: "PROMO1", EventID: "EVENT1", Code: "SAVE10", UsageLimit: &usageLimit}},
+			PromoRedemptionRepository: redemptions,
+			Publisher:                 &publisher{messages: make(map[string][][]byte)},
+			FollowNotifier:            notifier{},
+			TxManager:                 txManager{},
+		})
+	}
+
+	// redemption returns a redemption of the code by the customer at a discount of 10000 a ticket
+	redemption := func(ID string, customerID, quantity int64, redemptionStatus string, expiresAt time.Time) promo.PromoRedemption {
+		return promo.PromoRedemption{
+			ID: ID, PromoCodeID: "PROMO1", Code: "SAVE10", EventID: "EVENT1", ShowID: "SHOW1", TicketStockID: "TS1",
+			CustomerID: customerID, Quantity: quantity, Price: price, Discount: money.MustParse("10000", "IDR").Mul(quantity),
+			Status: redemptionStatus, ExpiresAt: expiresAt,
+		}
+	}
+
+	// pay pays an order of the customer which carries the given redemption and discount
+	pay := func(uc event.EventUseCase, customerID int64, redemptionID string, discount money.Money) error {
+		return uc.OnOrderPaid(context.Background(), event.OrderPaidEvent{
+			ID:                "ORDER1",
+			CustomerID:        customerID,
+			Discount:          discount,
+			PromoRedemptionID: &redemptionID,
+			Items: []event.Item{
+				{TicketStockID: "TS1", ShowID: "SHOW1", EventID: "EVENT1", Tier: "CAT1", Price: price, Quantity: 2},
+			},
+		})
+	}
+
+	t.Run("a paid discount which differs from the reserved one is confirmed and recorded next to it", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: map[string]promo.PromoRedemption{
+			"REDEEM1": redemption("REDEEM1", 1, 2, promo.PromoRedemptionStatusReserved, now.Add(time.Minute)),
+		}}
+		tickets := &acquiredTicketStore{}
+
+		err := pay(newUseCase(redemptions, tickets), 1, "REDEEM1", money.MustParse("15000", "IDR"))
+		require.NoError(t, err)
+
+		pr := redemptions.redemptions["REDEEM1"]
+		assert.Equal(t, promo.PromoRedemptionStatusConfirmed, pr.Status)
+		require.NotNil(t, pr.OrderID)
+		assert.Equal(t, "ORDER1", *pr.OrderID)
+		assert.True(t, money.MustParse("20000", "IDR").Equal(pr.Discount))
+		require.NotNil(t, pr.PaidDiscount)
+		assert.True(t, money.MustParse("15000", "IDR").Equal(*pr.PaidDiscount))
+		assert.Len(t, tickets.tickets, 1)
+	})
+
+	t.Run("a lapsed reservation is confirmed while the code is still within its limits", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: map[string]promo.PromoRedemption{
+			"REDEEM1": redemption("REDEEM1", 1, 2, promo.PromoRedemptionStatusReleased, now.Add(time.Minute)),
+			"REDEEM2": redemption("REDEEM2", 2, 1, promo.PromoRedemptionStatusConfirmed, now.Add(-time.Hour)),
+		}}
+		tickets := &acquiredTicketStore{}
+
+		err := pay(newUseCase(redemptions, tickets), 1, "REDEEM1", money.MustParse("20000", "IDR"))
+		require.NoError(t, err)
+
+		assert.Equal(t, promo.PromoRedemptionStatusConfirmed, redemptions.redemptions["REDEEM1"].Status)
+		assert.Len(t, tickets.tickets, 1)
+	})
+
+	t.Run("a lapsed reservation is rejected once the code has reached its limits in the meantime", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: map[string]promo.PromoRedemption{
+			"REDEEM1": redemption("REDEEM1", 1, 2, promo.PromoRedemptionStatusReserved, now.Add(-time.Minute)),
+			"REDEEM2": redemption("REDEEM2", 2, 2, promo.PromoRedemptionStatusConfirmed, now.Add(-time.Hour)),
+		}}
+		tickets := &acquiredTicketStore{}
+
+		err := pay(newUseCase(redemptions, tickets), 1, "REDEEM1", money.MustParse("20000", "IDR"))
+		assert.True(t, errors.MatchStatus(err, status.PROMO_CODE_UNAVAILABLE))
+
+		assert.Equal(t, promo.PromoRedemptionStatusReserved, redemptions.redemptions["REDEEM1"].Status)
+		assert.Empty(t, tickets.tickets)
+	})
+
+	t.Run("a redemption is confirmed once only and for its own customer only", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: map[string]promo.PromoRedemption{
+			"REDEEM1": redemption("REDEEM1", 1, 2, promo.PromoRedemptionStatusConfirmed, now.Add(-time.Hour)),
+			"REDEEM2": redemption("REDEEM2", 2, 2, promo.PromoRedemptionStatusReserved, now.Add(time.Minute)),
+		}}
+		tickets := &acquiredTicketStore{}
+		uc := newUseCase(redemptions, tickets)
+
+		err := pay(uc, 1, "REDEEM1", money.MustParse("20000", "IDR"))
+		assert.True(t, errors.MatchStatus(err, status.PROMO_CODE_UNAVAILABLE))
+
+		err = pay(uc, 1, "REDEEM2", money.MustParse("20000", "IDR"))
+		assert.True(t, errors.MatchStatus(err, status.BAD_REQUEST))
+
+		assert.Equal(t, promo.PromoRedemptionStatusReserved, redemptions.redemptions["REDEEM2"].Status)
+		assert.Empty(t, tickets.tickets)
+	})
+}
+
+func TestEventUseCaseOnOrderRefunded(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	price := money.MustParse("100000", "IDR")
+
+	t.Run("a refunded order returns its tickets to the ticket stock and offers them to the waitlist once", func(t *testing.T) {
+		stocks := &ticketStockStore{stocks: map[string]ticket.TicketStock{
+			"TS1": {ID: "TS1", Tier: "CAT1", Allocation: 10, Price: price, ShowID: "SHOW1", EventID: "EVENT1"},
+		}}
+		tickets := &acquiredTicketStore{}
+		pub := &publisher{messages: make(map[string][][]byte)}
+		o := &offerer{}
+		uc := event.NewEventUseCase(event.EventUseCaseProperty{
+			Logger:                   logger,
+			Location:                 time.UTC,
+			Timeout:                  10 * time.Second,
+			EventRepository:          eventStore{e: event.Event{ID: "EVENT1", Name: "Test"}},
+			ShowRepository:           showStore{s: event.Show{EventID: "EVENT1", ID: "SHOW1", Venue: "Test", Type: "LIVE", Time: time.Now()}},
+			LocationRepository:       locationStore{},
+			TicketStockRepository:    stocks,
+			AcquiredTicketRepository: tickets,
+			PriceRuleRepository:      priceRuleStore{},
+			WaitlistEntryRepository:  waitlistStore{},
+			Publisher:                pub,
+			FollowNotifier:           notifier{},
+			WaitlistOfferer:          o,
+			TxManager:                txManager{},
+		})
+
+		items := []event.Item{
+			{TicketStockID: "TS1", ShowID: "SHOW1", EventID: "EVENT1", Tier: "CAT1", Price: price, Quantity: 3},
+		}
+		for _, ID := range []string{"ORDER1", "ORDER2"} {
+			err := uc.OnOrderPaid(context.Background(), event.OrderPaidEvent{ID: ID, CustomerID: 1, Items: items})
+			require.NoError(t, err)
+		}
+
+		refund := event.OrderRefundedEvent{ID: "ORDER1", Status: "REFUNDED", CustomerID: 1, Items: items}
+		require.NoError(t, uc.OnOrderRefunded(context.Background(), refund))
+
+		ts, err := stocks.FindByID(context.Background(), "TS1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), ts.Acquired)
+		assert.Equal(t, []string{"TS1"}, o.ticketStockIDs)
+
+		// a redelivered event finds the tickets of the order returned already
+		require.NoError(t, uc.OnOrderRefunded(context.Background(), refund))
+
+		ts, err = stocks.FindByID(context.Background(), "TS1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), ts.Acquired)
+		assert.Equal(t, []string{"TS1"}, o.ticketStockIDs)
+		assert.Len(t, tickets.returned, 1)
+	})
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type VenueRepository interface {
+	FindByID(ctx context.Context, ID string) (Venue, error)
+}
+
+type venueRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewVenueRepository(logger *logrus.Logger, db *pgxpool.Pool) VenueRepository {
+	return &venueRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByID implements VenueRepository.
+func (r *venueRepository) FindByID(ctx context.Context, ID string) (Venue, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, name, country, city, formatted_address, latitude, longitude, capacity, timezone
+		FROM venue
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data Venue
+	err := row.Scan(
+		&data.ID, &data.Name, &data.Country, &data.City, &data.FormattedAddress, &data.Latitude, &data.Longitude, &data.Capacity, &data.Timezone,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Venue{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("venue"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Venue{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting venue's prorperties")
+	}
+
+	return data, nil
+}
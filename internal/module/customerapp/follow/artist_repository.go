@@ -0,0 +1,58 @@
+package follow
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ArtistRepository interface {
+	FindByIDOrSlug(ctx context.Context, IDOrSlug string) (Artist, error)
+}
+
+type artistRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewArtistRepository(logger *logrus.Logger, db *pgxpool.Pool) ArtistRepository {
+	return &artistRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByIDOrSlug implements ArtistRepository.
+func (r *artistRepository) FindByIDOrSlug(ctx context.Context, IDOrSlug string) (Artist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, slug, name
+		FROM artist
+		WHERE
+			id = $1 OR slug = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, IDOrSlug)
+
+	var data Artist
+	err := row.Scan(&data.ID, &data.Slug, &data.Name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Artist{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("artist"), IDOrSlug))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Artist{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting artist's prorperties")
+	}
+
+	return data, nil
+}
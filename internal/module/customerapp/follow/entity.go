@@ -0,0 +1,87 @@
+package follow
+
+import "time"
+
+const (
+	TargetTypeEvent  string = "EVENT"
+	TargetTypeArtist string = "ARTIST"
+)
+
+const EventStatusActive string = "ACTIVE"
+
+const (
+	NotificationEventOnSale string = "EVENT_ON_SALE"
+	NotificationShowAdded   string = "SHOW_ADDED"
+	NotificationLowStock    string = "LOW_STOCK"
+)
+
+// LowStockPercentage is the share of the allocation of a ticket stock at or below which the stock is low.
+const LowStockPercentage int64 = 10
+
+// NotificationChunkSize is the number of followers of a notification which are published in a single message, the
+// followers of a popular event or artist are split so every message stays within the message limit of the broker.
+const NotificationChunkSize = 1000
+
+// OnSaleCheckInterval is how often the events which went on sale are looked up to notify their followers.
+const OnSaleCheckInterval = time.Minute
+
+type Follow struct {
+	CustomerID int64
+	TargetType string
+	TargetID   string
+	CreatedAt  time.Time
+}
+
+type Event struct {
+	ID     string
+	Name   string
+	Status string
+}
+
+type Artist struct {
+	ID   string
+	Slug string
+	Name string
+}
+
+type FollowedEvent struct {
+	ID          string
+	Name        string
+	Description string
+	Status      string
+	FollowedAt  time.Time
+}
+
+type FollowedArtist struct {
+	ID         string
+	Slug       string
+	Name       string
+	ImageURL   string
+	FollowedAt time.Time
+}
+
+// Notification is published to the notification service which alerts the followers of an event, the followers of
+// the artists of the event are included. The followers are published in parts of at most NotificationChunkSize
+// followers which share the id of the notification.
+type Notification struct {
+	ID            string
+	Kind          string
+	EventID       string
+	EventName     string
+	ShowIDs       []string
+	TicketStockID string
+	Tier          string
+	Available     int64
+	FollowerIDs   []int64
+	Part          int
+	Parts         int
+	CreatedAt     time.Time
+}
+
+// IsLowStock reports whether the available tickets of a ticket stock crossed the low stock threshold by going from
+// previous to current, the followers are notified only once per crossing.
+func IsLowStock(allocation, previous, current int64) bool {
+	threshold := allocation * LowStockPercentage / 100
+
+	return previous > threshold && current <= threshold
+}
@@ -0,0 +1,103 @@
+package follow
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type EventRepository interface {
+	FindByID(ctx context.Context, ID string) (Event, error)
+	FindManyOnSale(ctx context.Context, since, until time.Time) ([]Event, error)
+}
+
+type eventRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewEventRepository(logger *logrus.Logger, db *pgxpool.Pool) EventRepository {
+	return &eventRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByID implements EventRepository.
+func (r *eventRepository) FindByID(ctx context.Context, ID string) (Event, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, name, status
+		FROM event
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data Event
+	err := row.Scan(&data.ID, &data.Name, &data.Status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Event{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return Event{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting event's prorperties")
+	}
+
+	return data, nil
+}
+
+// FindManyOnSale implements EventRepository. It returns the active events whose sale started within the given range
+// and whose followers have not been notified yet.
+func (r *eventRepository) FindManyOnSale(ctx context.Context, since, until time.Time) ([]Event, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			e.id, e.name, e.status
+		FROM event e
+		JOIN order_rule_range_date ord ON ord.event_id = e.id
+		WHERE
+			e.status = 'ACTIVE'
+			AND ord.start_date > $1
+			AND ord.start_date <= $2
+			AND NOT EXISTS (
+				SELECT 1 FROM follow_notification fn
+				WHERE fn.kind = 'EVENT_ON_SALE' AND fn.ref_id = e.id
+			)
+		ORDER BY ord.start_date ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, since, until)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]Event, 0)
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.ID, &e.Name, &e.Status); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of event's prorperties")
+		}
+
+		data = append(data, e)
+	}
+
+	return data, nil
+}
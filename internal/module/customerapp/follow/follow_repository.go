@@ -0,0 +1,251 @@
+package follow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type FollowRepository interface {
+	Save(ctx context.Context, f Follow) error
+	Delete(ctx context.Context, customerID int64, targetType, targetID string) error
+	FindManyEvent(ctx context.Context, customerID int64, offset, limit int) ([]FollowedEvent, error)
+	CountEvent(ctx context.Context, customerID int64) (int64, error)
+	FindManyArtist(ctx context.Context, customerID int64, offset, limit int) ([]FollowedArtist, error)
+	CountArtist(ctx context.Context, customerID int64) (int64, error)
+	FindManyFollowerIDByEventID(ctx context.Context, eventID string) ([]int64, error)
+}
+
+type followRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewFollowRepository(logger *logrus.Logger, db *pgxpool.Pool) FollowRepository {
+	return &followRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements FollowRepository. Following a target which is already followed is a no-op.
+func (r *followRepository) Save(ctx context.Context, f Follow) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO follow
+		(
+			customer_id, target_type, target_id, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4
+		)
+		ON CONFLICT (customer_id, target_type, target_id) DO NOTHING
+	`
+
+	_, err := cmd.ExecContext(ctx, query, f.CustomerID, f.TargetType, f.TargetID, f.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving follow's prorperties")
+	}
+
+	return nil
+}
+
+// Delete implements FollowRepository. Unfollowing a target which is not followed is a no-op.
+func (r *followRepository) Delete(ctx context.Context, customerID int64, targetType, targetID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM follow
+		WHERE
+			customer_id = $1
+			AND target_type = $2
+			AND target_id = $3
+	`
+
+	_, err := cmd.ExecContext(ctx, query, customerID, targetType, targetID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting follow's prorperties")
+	}
+
+	return nil
+}
+
+// FindManyEvent implements FollowRepository. The events are ordered by the latest follow.
+func (r *followRepository) FindManyEvent(ctx context.Context, customerID int64, offset int, limit int) ([]FollowedEvent, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			e.id, e.name, e.description, e.status, f.created_at
+		FROM follow f
+		JOIN event e ON e.id = f.target_id
+		WHERE
+			f.customer_id = $1
+			AND f.target_type = 'EVENT'
+		ORDER BY f.created_at DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, customerID, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of followed event's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]FollowedEvent, 0)
+	for rows.Next() {
+		var e FollowedEvent
+		err := rows.Scan(&e.ID, &e.Name, &e.Description, &e.Status, &e.FollowedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of followed event's prorperties")
+		}
+
+		data = append(data, e)
+	}
+
+	return data, nil
+}
+
+// CountEvent implements FollowRepository.
+func (r *followRepository) CountEvent(ctx context.Context, customerID int64) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			count(f.target_id)
+		FROM follow f
+		JOIN event e ON e.id = f.target_id
+		WHERE
+			f.customer_id = $1
+			AND f.target_type = 'EVENT'
+	`
+
+	var count int64
+	if err := cmd.QueryRowContext(ctx, query, customerID).Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting followed event's prorperties")
+	}
+
+	return count, nil
+}
+
+// FindManyArtist implements FollowRepository. The artists are ordered by the latest follow.
+func (r *followRepository) FindManyArtist(ctx context.Context, customerID int64, offset int, limit int) ([]FollowedArtist, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			a.id, a.slug, a.name, a.image_url, f.created_at
+		FROM follow f
+		JOIN artist a ON a.id = f.target_id
+		WHERE
+			f.customer_id = $1
+			AND f.target_type = 'ARTIST'
+		ORDER BY f.created_at DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, customerID, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of followed artist's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]FollowedArtist, 0)
+	for rows.Next() {
+		var a FollowedArtist
+		err := rows.Scan(&a.ID, &a.Slug, &a.Name, &a.ImageURL, &a.FollowedAt)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of followed artist's prorperties")
+		}
+
+		data = append(data, a)
+	}
+
+	return data, nil
+}
+
+// CountArtist implements FollowRepository.
+func (r *followRepository) CountArtist(ctx context.Context, customerID int64) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			count(f.target_id)
+		FROM follow f
+		JOIN artist a ON a.id = f.target_id
+		WHERE
+			f.customer_id = $1
+			AND f.target_type = 'ARTIST'
+	`
+
+	var count int64
+	if err := cmd.QueryRowContext(ctx, query, customerID).Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting followed artist's prorperties")
+	}
+
+	return count, nil
+}
+
+// FindManyFollowerIDByEventID implements FollowRepository. The followers of the artists of the event are included,
+// every follower is returned once.
+func (r *followRepository) FindManyFollowerIDByEventID(ctx context.Context, eventID string) ([]int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			f.customer_id
+		FROM follow f
+		WHERE
+			f.target_type = 'EVENT'
+			AND f.target_id = $1
+		UNION
+		SELECT
+			f.customer_id
+		FROM follow f
+		JOIN event_artist ea ON ea.artist_id = f.target_id
+		WHERE
+			f.target_type = 'ARTIST'
+			AND ea.event_id = $1
+		ORDER BY customer_id ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, eventID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of follower's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]int64, 0)
+	for rows.Next() {
+		var customerID int64
+		if err := rows.Scan(&customerID); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of follower's prorperties")
+		}
+
+		data = append(data, customerID)
+	}
+
+	return data, nil
+}
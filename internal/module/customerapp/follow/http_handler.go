@@ -0,0 +1,220 @@
+package follow
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.CustomerSession
+	Validate          *validator.Validate
+	FollowUseCase     FollowUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, customerSession *middleware.CustomerSession, validate *validator.Validate, followUseCase FollowUseCase) {
+	handler := &HTTPHandler{
+		Validate:      validate,
+		FollowUseCase: followUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/follow", publicMiddleware.SetRouteChain(handler.FollowEvent, customerSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/follow", publicMiddleware.SetRouteChain(handler.UnfollowEvent, customerSession.Verify)).Methods(http.MethodDelete)
+	router.HandleFunc("/tm-event/v1/customerapp/artists/{artist}/follow", publicMiddleware.SetRouteChain(handler.FollowArtist, customerSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/customerapp/artists/{artist}/follow", publicMiddleware.SetRouteChain(handler.UnfollowArtist, customerSession.Verify)).Methods(http.MethodDelete)
+	router.HandleFunc("/tm-event/v1/customerapp/follows/events", publicMiddleware.SetRouteChain(handler.GetManyFollowedEvent, customerSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/customerapp/follows/artists", publicMiddleware.SetRouteChain(handler.GetManyFollowedArtist, customerSession.Verify)).Methods(http.MethodGet)
+}
+
+func (handler HTTPHandler) FollowEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := FollowEventRequest{
+		EventID: vars["eventID"],
+	}
+
+	if err := handler.FollowUseCase.FollowEvent(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("event"), i18n.Noun("followed")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) UnfollowEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := FollowEventRequest{
+		EventID: vars["eventID"],
+	}
+
+	if err := handler.FollowUseCase.UnfollowEvent(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("event"), i18n.Noun("unfollowed")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) FollowArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := FollowArtistRequest{
+		IDOrSlug: vars["artist"],
+	}
+
+	if err := handler.FollowUseCase.FollowArtist(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("artist"), i18n.Noun("followed")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) UnfollowArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := FollowArtistRequest{
+		IDOrSlug: vars["artist"],
+	}
+
+	if err := handler.FollowUseCase.UnfollowArtist(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("artist"), i18n.Noun("unfollowed")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyFollowedEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := GetManyFollowedEventRequest{}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.FollowUseCase.GetManyFollowedEvent(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("followed event")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyFollowedArtist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := GetManyFollowedArtistRequest{}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.FollowUseCase.GetManyFollowedArtist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("followed artist")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
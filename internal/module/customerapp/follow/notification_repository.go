@@ -0,0 +1,80 @@
+package follow
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type NotificationRepository interface {
+	Claim(ctx context.Context, kind, refID string, now time.Time) (bool, error)
+	Release(ctx context.Context, kind, refID string) error
+}
+
+type notificationRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewNotificationRepository(logger *logrus.Logger, db *pgxpool.Pool) NotificationRepository {
+	return &notificationRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Claim implements NotificationRepository. It records the notification of the given kind for the given reference and
+// reports whether it has not been recorded before, so a notification is published once even with several instances
+// of the service.
+func (r *notificationRepository) Claim(ctx context.Context, kind, refID string, now time.Time) (bool, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO follow_notification
+		(
+			kind, ref_id, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3
+		)
+		ON CONFLICT (kind, ref_id) DO NOTHING
+	`
+
+	result, err := cmd.ExecContext(ctx, query, kind, refID, now)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return false, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving follow notification's prorperties")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return false, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving follow notification's prorperties")
+	}
+
+	return affected > 0, nil
+}
+
+// Release implements NotificationRepository. It forgets the claimed notification of the given kind for the given
+// reference, e.g. when it could not be published, so it can be claimed again.
+func (r *notificationRepository) Release(ctx context.Context, kind, refID string) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		DELETE FROM follow_notification WHERE kind = $1 AND ref_id = $2
+	`
+
+	if _, err := cmd.ExecContext(ctx, query, kind, refID); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while deleting follow notification's prorperties")
+	}
+
+	return nil
+}
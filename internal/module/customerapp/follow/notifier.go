@@ -0,0 +1,153 @@
+package follow
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+)
+
+// NotificationTopic is the topic which the notification service consumes to alert the followers.
+const NotificationTopic = "follow-notification"
+
+// OnSaleLookback bounds how long after the start of its sale an event is still announced as on sale, e.g. the events
+// which went on sale long before the service was started are not announced anymore.
+const OnSaleLookback = 24 * time.Hour
+
+type Notifier interface {
+	NotifyEventsOnSale(ctx context.Context, now time.Time) error
+	NotifyShowsAdded(ctx context.Context, eventID string, showIDs []string) error
+	NotifyLowStock(ctx context.Context, eventID, showID, ticketStockID, tier string, available int64) error
+}
+
+type notifier struct {
+	logger                 *logrus.Logger
+	followRepository       FollowRepository
+	eventRepository        EventRepository
+	notificationRepository NotificationRepository
+	publisher              pubsub.Publisher
+}
+
+type NotifierProperty struct {
+	Logger                 *logrus.Logger
+	FollowRepository       FollowRepository
+	EventRepository        EventRepository
+	NotificationRepository NotificationRepository
+	Publisher              pubsub.Publisher
+}
+
+func NewNotifier(props NotifierProperty) Notifier {
+	return &notifier{
+		logger:                 props.Logger,
+		followRepository:       props.FollowRepository,
+		eventRepository:        props.EventRepository,
+		notificationRepository: props.NotificationRepository,
+		publisher:              props.Publisher,
+	}
+}
+
+// publish sends the notification to the followers of its event in parts of at most NotificationChunkSize followers,
+// nothing is published when the event has no followers.
+func (n *notifier) publish(ctx context.Context, notification Notification) error {
+	followerIDs, err := n.followRepository.FindManyFollowerIDByEventID(ctx, notification.EventID)
+	if err != nil {
+		return err
+	}
+
+	if len(followerIDs) < 1 {
+		return nil
+	}
+
+	notification.ID = util.GenerateTimestampWithPrefix("NOTIF")
+	notification.Parts = (len(followerIDs) + NotificationChunkSize - 1) / NotificationChunkSize
+
+	for part := 0; part < notification.Parts; part++ {
+		end := (part + 1) * NotificationChunkSize
+		if end > len(followerIDs) {
+			end = len(followerIDs)
+		}
+
+		notification.Part = part + 1
+		notification.FollowerIDs = followerIDs[part*NotificationChunkSize : end]
+
+		buff, _ := json.Marshal(notification)
+		if err := n.publisher.Publish(ctx, NotificationTopic, notification.EventID, nil, buff); err != nil {
+			n.logger.WithContext(ctx).WithError(err).Error()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NotifyEventsOnSale implements Notifier. Every event is announced once, the announcement is claimed before it is
+// published and the claim is released when it could not be published so it is announced by the next check.
+func (n *notifier) NotifyEventsOnSale(ctx context.Context, now time.Time) error {
+	events, err := n.eventRepository.FindManyOnSale(ctx, now.Add(-OnSaleLookback), now)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		claimed, err := n.notificationRepository.Claim(ctx, NotificationEventOnSale, e.ID, now)
+		if err != nil {
+			return err
+		}
+
+		if !claimed {
+			continue
+		}
+
+		if err := n.publish(ctx, Notification{
+			Kind:      NotificationEventOnSale,
+			EventID:   e.ID,
+			EventName: e.Name,
+			CreatedAt: now,
+		}); err != nil {
+			if err := n.notificationRepository.Release(ctx, NotificationEventOnSale, e.ID); err != nil {
+				n.logger.WithContext(ctx).WithError(err).Error()
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NotifyShowsAdded implements Notifier.
+func (n *notifier) NotifyShowsAdded(ctx context.Context, eventID string, showIDs []string) error {
+	e, err := n.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	return n.publish(ctx, Notification{
+		Kind:      NotificationShowAdded,
+		EventID:   e.ID,
+		EventName: e.Name,
+		ShowIDs:   showIDs,
+		CreatedAt: time.Now(),
+	})
+}
+
+// NotifyLowStock implements Notifier.
+func (n *notifier) NotifyLowStock(ctx context.Context, eventID, showID, ticketStockID, tier string, available int64) error {
+	e, err := n.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	return n.publish(ctx, Notification{
+		Kind:          NotificationLowStock,
+		EventID:       e.ID,
+		EventName:     e.Name,
+		ShowIDs:       []string{showID},
+		TicketStockID: ticketStockID,
+		Tier:          tier,
+		Available:     available,
+		CreatedAt:     time.Now(),
+	})
+}
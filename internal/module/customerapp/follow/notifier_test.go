@@ -0,0 +1,138 @@
+package follow_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/follow"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the notifier is not expected to call panics.
+
+type followStore struct {
+	follow.FollowRepository
+	followerIDs []int64
+}
+
+func (s followStore) FindManyFollowerIDByEventID(ctx context.Context, eventID string) ([]int64, error) {
+	return s.followerIDs, nil
+}
+
+type eventStore struct {
+	follow.EventRepository
+}
+
+func (eventStore) FindByID(ctx context.Context, ID string) (follow.Event, error) {
+	return follow.Event{ID: ID, Name: "Test", Status: follow.EventStatusActive}, nil
+}
+
+func (eventStore) FindManyOnSale(ctx context.Context, since, until time.Time) ([]follow.Event, error) {
+	return []follow.Event{{ID: "EVENT1", Name: "Test", Status: follow.EventStatusActive}}, nil
+}
+
+// notificationStore records the claimed notifications the same way as the follow_notification table does.
+type notificationStore struct {
+	claimed map[string]bool
+}
+
+func (s *notificationStore) Claim(ctx context.Context, kind, refID string, now time.Time) (bool, error) {
+	if s.claimed[kind+refID] {
+		return false, nil
+	}
+
+	s.claimed[kind+refID] = true
+	return true, nil
+}
+
+func (s *notificationStore) Release(ctx context.Context, kind, refID string) error {
+	delete(s.claimed, kind+refID)
+	return nil
+}
+
+type publisher struct {
+	err      error
+	messages [][]byte
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, key string, headers pubsub.MessageHeaders, message []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *publisher) Close() error {
+	return nil
+}
+
+func TestNotifier(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	newNotifier := func(followerIDs []int64, notifications *notificationStore, pub *publisher) follow.Notifier {
+		return follow.NewNotifier(follow.NotifierProperty{
+			Logger:                 logger,
+			FollowRepository:       followStore{followerIDs: followerIDs},
+			EventRepository:        eventStore{},
+			NotificationRepository: notifications,
+			Publisher:              pub,
+		})
+	}
+
+	t.Run("the followers are published in parts of the chunk size", func(t *testing.T) {
+		followerIDs := make([]int64, 2*follow.NotificationChunkSize+1)
+		for k := range followerIDs {
+			followerIDs[k] = int64(k + 1)
+		}
+		pub := &publisher{}
+		n := newNotifier(followerIDs, &notificationStore{claimed: make(map[string]bool)}, pub)
+
+		require.NoError(t, n.NotifyShowsAdded(context.Background(), "EVENT1", []string{"SHOW1"}))
+		require.Len(t, pub.messages, 3)
+
+		var published []int64
+		for k, m := range pub.messages {
+			var notification follow.Notification
+			require.NoError(t, json.Unmarshal(m, &notification))
+
+			assert.Equal(t, k+1, notification.Part)
+			assert.Equal(t, 3, notification.Parts)
+			assert.LessOrEqual(t, len(notification.FollowerIDs), follow.NotificationChunkSize)
+			published = append(published, notification.FollowerIDs...)
+		}
+		assert.Equal(t, followerIDs, published)
+	})
+
+	t.Run("an event on sale is announced once", func(t *testing.T) {
+		pub := &publisher{}
+		n := newNotifier([]int64{1, 2}, &notificationStore{claimed: make(map[string]bool)}, pub)
+
+		require.NoError(t, n.NotifyEventsOnSale(context.Background(), time.Now()))
+		require.NoError(t, n.NotifyEventsOnSale(context.Background(), time.Now()))
+
+		assert.Len(t, pub.messages, 1)
+	})
+
+	t.Run("an announcement which could not be published is announced by the next check", func(t *testing.T) {
+		notifications := &notificationStore{claimed: make(map[string]bool)}
+		pub := &publisher{err: fmt.Errorf("broker is down")}
+		n := newNotifier([]int64{1, 2}, notifications, pub)
+
+		assert.Error(t, n.NotifyEventsOnSale(context.Background(), time.Now()))
+		assert.Empty(t, notifications.claimed)
+
+		pub.err = nil
+		require.NoError(t, n.NotifyEventsOnSale(context.Background(), time.Now()))
+		assert.Len(t, pub.messages, 1)
+	})
+}
@@ -0,0 +1,19 @@
+package follow
+
+type FollowEventRequest struct {
+	EventID string
+}
+
+type FollowArtistRequest struct {
+	IDOrSlug string
+}
+
+type GetManyFollowedEventRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
+
+type GetManyFollowedArtistRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
@@ -0,0 +1,45 @@
+package follow
+
+import "time"
+
+type FollowedEventResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	FollowedAt  time.Time `json:"followed_at"`
+}
+
+func (r *FollowedEventResponse) PopulateFromEntity(e FollowedEvent) {
+	r.ID = e.ID
+	r.Name = e.Name
+	r.Description = e.Description
+	r.Status = e.Status
+	r.FollowedAt = e.FollowedAt
+}
+
+type GetManyFollowedEventResponse struct {
+	Total  int64                   `json:"total"`
+	Events []FollowedEventResponse `json:"events"`
+}
+
+type FollowedArtistResponse struct {
+	ID         string    `json:"id"`
+	Slug       string    `json:"slug"`
+	Name       string    `json:"name"`
+	ImageURL   string    `json:"image_url"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+func (r *FollowedArtistResponse) PopulateFromEntity(a FollowedArtist) {
+	r.ID = a.ID
+	r.Slug = a.Slug
+	r.Name = a.Name
+	r.ImageURL = a.ImageURL
+	r.FollowedAt = a.FollowedAt
+}
+
+type GetManyFollowedArtistResponse struct {
+	Total   int64                    `json:"total"`
+	Artists []FollowedArtistResponse `json:"artists"`
+}
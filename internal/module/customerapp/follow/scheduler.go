@@ -0,0 +1,75 @@
+package follow
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// OnSaleScheduler periodically notifies the followers of the events which went on sale.
+type OnSaleScheduler interface {
+	Start()
+	Stop()
+}
+
+type onSaleScheduler struct {
+	logger   *logrus.Logger
+	notifier Notifier
+	interval time.Duration
+	timeout  time.Duration
+	done     chan struct{}
+	stopped  chan struct{}
+}
+
+type OnSaleSchedulerProperty struct {
+	Logger   *logrus.Logger
+	Notifier Notifier
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+func NewOnSaleScheduler(props OnSaleSchedulerProperty) OnSaleScheduler {
+	return &onSaleScheduler{
+		logger:   props.Logger,
+		notifier: props.Notifier,
+		interval: props.Interval,
+		timeout:  props.Timeout,
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Start implements OnSaleScheduler. The events are checked in the background until the scheduler is stopped.
+func (s *onSaleScheduler) Start() {
+	go func() {
+		defer close(s.stopped)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.done:
+				return
+			case now := <-ticker.C:
+				s.check(now)
+			}
+		}
+	}()
+}
+
+func (s *onSaleScheduler) check(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	if err := s.notifier.NotifyEventsOnSale(ctx, now); err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error()
+	}
+}
+
+// Stop implements OnSaleScheduler. It waits for the running check to finish.
+func (s *onSaleScheduler) Stop() {
+	close(s.done)
+	<-s.stopped
+}
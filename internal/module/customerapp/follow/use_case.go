@@ -0,0 +1,233 @@
+package follow
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type FollowUseCase interface {
+	FollowEvent(ctx context.Context, req FollowEventRequest) error
+	UnfollowEvent(ctx context.Context, req FollowEventRequest) error
+	FollowArtist(ctx context.Context, req FollowArtistRequest) error
+	UnfollowArtist(ctx context.Context, req FollowArtistRequest) error
+	GetManyFollowedEvent(ctx context.Context, req GetManyFollowedEventRequest) (GetManyFollowedEventResponse, error)
+	GetManyFollowedArtist(ctx context.Context, req GetManyFollowedArtistRequest) (GetManyFollowedArtistResponse, error)
+}
+
+type followUseCase struct {
+	logger           *logrus.Logger
+	location         *time.Location
+	timeout          time.Duration
+	followRepository FollowRepository
+	eventRepository  EventRepository
+	artistRepository ArtistRepository
+}
+
+type FollowUseCaseProperty struct {
+	Logger           *logrus.Logger
+	Location         *time.Location
+	Timeout          time.Duration
+	FollowRepository FollowRepository
+	EventRepository  EventRepository
+	ArtistRepository ArtistRepository
+}
+
+func NewFollowUseCase(props FollowUseCaseProperty) FollowUseCase {
+	return &followUseCase{
+		logger:           props.Logger,
+		location:         props.Location,
+		timeout:          props.Timeout,
+		followRepository: props.FollowRepository,
+		eventRepository:  props.EventRepository,
+		artistRepository: props.ArtistRepository,
+	}
+}
+
+// FollowEvent implements FollowUseCase. Only the active events can be followed.
+func (u *followUseCase) FollowEvent(ctx context.Context, req FollowEventRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	e, err := u.eventRepository.FindByID(ctx, req.EventID)
+	if err != nil {
+		return err
+	}
+
+	if e.Status != EventStatusActive {
+		return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("event"), req.EventID))
+	}
+
+	return u.followRepository.Save(ctx, Follow{
+		CustomerID: acc.ID,
+		TargetType: TargetTypeEvent,
+		TargetID:   e.ID,
+		CreatedAt:  time.Now(),
+	})
+}
+
+// UnfollowEvent implements FollowUseCase.
+func (u *followUseCase) UnfollowEvent(ctx context.Context, req FollowEventRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.followRepository.Delete(ctx, acc.ID, TargetTypeEvent, req.EventID)
+}
+
+// FollowArtist implements FollowUseCase.
+func (u *followUseCase) FollowArtist(ctx context.Context, req FollowArtistRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	a, err := u.artistRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return err
+	}
+
+	return u.followRepository.Save(ctx, Follow{
+		CustomerID: acc.ID,
+		TargetType: TargetTypeArtist,
+		TargetID:   a.ID,
+		CreatedAt:  time.Now(),
+	})
+}
+
+// UnfollowArtist implements FollowUseCase.
+func (u *followUseCase) UnfollowArtist(ctx context.Context, req FollowArtistRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	a, err := u.artistRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return err
+	}
+
+	return u.followRepository.Delete(ctx, acc.ID, TargetTypeArtist, a.ID)
+}
+
+// GetManyFollowedEvent implements FollowUseCase.
+func (u *followUseCase) GetManyFollowedEvent(ctx context.Context, req GetManyFollowedEventRequest) (GetManyFollowedEventResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return GetManyFollowedEventResponse{}, err
+	}
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var events []FollowedEvent
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.followRepository.CountEvent(gctx, acc.ID)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.followRepository.FindManyEvent(gctx, acc.ID, offset, limit)
+		if err != nil {
+			return err
+		}
+		events = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyFollowedEventResponse{}, err
+	}
+
+	resp := GetManyFollowedEventResponse{
+		Total:  total,
+		Events: make([]FollowedEventResponse, len(events)),
+	}
+
+	for k, v := range events {
+		resp.Events[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
+
+// GetManyFollowedArtist implements FollowUseCase.
+func (u *followUseCase) GetManyFollowedArtist(ctx context.Context, req GetManyFollowedArtistRequest) (GetManyFollowedArtistResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return GetManyFollowedArtistResponse{}, err
+	}
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var artists []FollowedArtist
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.followRepository.CountArtist(gctx, acc.ID)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.followRepository.FindManyArtist(gctx, acc.ID, offset, limit)
+		if err != nil {
+			return err
+		}
+		artists = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyFollowedArtistResponse{}, err
+	}
+
+	resp := GetManyFollowedArtistResponse{
+		Total:   total,
+		Artists: make([]FollowedArtistResponse, len(artists)),
+	}
+
+	for k, v := range artists {
+		resp.Artists[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package media
+
+const (
+	OwnerTypeEvent  string = "EVENT"
+	OwnerTypeArtist string = "ARTIST"
+)
+
+type Variant struct {
+	MediaID string
+	Name    string
+	Key     string
+	Width   int
+	Height  int
+}
+
+type Media struct {
+	ID        string
+	OwnerType string
+	OwnerID   string
+	Kind      string
+	Width     int
+	Height    int
+	Variants  []Variant
+}
+
+// GroupByOwnerID groups the media by the id of their owner, the order of the media is kept.
+func GroupByOwnerID(bunchOfMedia []Media) map[string][]Media {
+	grouped := make(map[string][]Media)
+	for _, v := range bunchOfMedia {
+		grouped[v.OwnerID] = append(grouped[v.OwnerID], v)
+	}
+
+	return grouped
+}
@@ -0,0 +1,78 @@
+package media
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type MediaRepository interface {
+	FindManyByOwnerIDs(ctx context.Context, ownerType string, ownerIDs []string) ([]Media, error)
+}
+
+type mediaRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewMediaRepository(logger *logrus.Logger, db *pgxpool.Pool) MediaRepository {
+	return &mediaRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindManyByOwnerIDs implements MediaRepository. The media are returned along with their variants, in the order they
+// were uploaded.
+func (r *mediaRepository) FindManyByOwnerIDs(ctx context.Context, ownerType string, ownerIDs []string) ([]Media, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			m.id, m.owner_type, m.owner_id, m.kind, m.width, m.height,
+			v.name, v.storage_key, v.width, v.height
+		FROM media m
+		INNER JOIN media_variant v ON v.media_id = m.id
+		WHERE
+			m.owner_type = $1
+			AND m.owner_id = ANY($2)
+		ORDER BY m.created_at ASC, m.id ASC, v.width DESC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ownerType, ownerIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media's prorperties")
+	}
+	defer rows.Close()
+
+	bunchOfMedia := make([]Media, 0)
+	for rows.Next() {
+		var m Media
+		var v Variant
+		err := rows.Scan(
+			&m.ID, &m.OwnerType, &m.OwnerID, &m.Kind, &m.Width, &m.Height,
+			&v.Name, &v.Key, &v.Width, &v.Height,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of media's prorperties")
+		}
+		v.MediaID = m.ID
+
+		if last := len(bunchOfMedia) - 1; last >= 0 && bunchOfMedia[last].ID == m.ID {
+			bunchOfMedia[last].Variants = append(bunchOfMedia[last].Variants, v)
+			continue
+		}
+
+		m.Variants = []Variant{v}
+		bunchOfMedia = append(bunchOfMedia, m)
+	}
+
+	return bunchOfMedia, nil
+}
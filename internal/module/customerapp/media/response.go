@@ -0,0 +1,44 @@
+package media
+
+import "github.com/tsel-ticketmaster/tm-event/pkg/storage"
+
+type VariantResponse struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type MediaResponse struct {
+	ID       string                     `json:"id"`
+	Kind     string                     `json:"kind"`
+	Width    int                        `json:"width"`
+	Height   int                        `json:"height"`
+	Variants map[string]VariantResponse `json:"variants"`
+}
+
+// PopulateFromEntity populates the response from the media, the urls of its variants are resolved by the storage.
+func (r *MediaResponse) PopulateFromEntity(m Media, store storage.Storage) {
+	r.ID = m.ID
+	r.Kind = m.Kind
+	r.Width = m.Width
+	r.Height = m.Height
+
+	r.Variants = make(map[string]VariantResponse, len(m.Variants))
+	for _, v := range m.Variants {
+		r.Variants[v.Name] = VariantResponse{
+			URL:    store.URL(v.Key),
+			Width:  v.Width,
+			Height: v.Height,
+		}
+	}
+}
+
+// NewMediaResponses returns the responses of the media.
+func NewMediaResponses(bunchOfMedia []Media, store storage.Storage) []MediaResponse {
+	resp := make([]MediaResponse, len(bunchOfMedia))
+	for k, v := range bunchOfMedia {
+		resp[k].PopulateFromEntity(v, store)
+	}
+
+	return resp
+}
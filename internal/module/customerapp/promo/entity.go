@@ -0,0 +1,95 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	PromoCodeTypeDiscount string = "DISCOUNT"
+	PromoCodeTypeAccess   string = "ACCESS"
+
+	PromoCodeStatusActive string = "ACTIVE"
+
+	// PromoRedemptionStatusReserved counts against the limits of the code until it expires or is released.
+	PromoRedemptionStatusReserved  string = "RESERVED"
+	PromoRedemptionStatusConfirmed string = "CONFIRMED"
+	PromoRedemptionStatusReleased  string = "RELEASED"
+
+	// PromoRedemptionDuration is how long a redemption is reserved for a customer before it is released to others.
+	PromoRedemptionDuration time.Duration = 10 * time.Minute
+)
+
+type PromoCode struct {
+	ID                 string
+	EventID            string
+	ShowID             *string
+	Tier               *string
+	Code               string
+	Type               string
+	DiscountPercentage *float64
+	DiscountAmount     *money.Money
+	UsageLimit         *int64
+	PerCustomerLimit   *int64
+	StartsAt           time.Time
+	EndsAt             *time.Time
+	Status             string
+}
+
+// IsValidAt reports whether the code can be redeemed at the given time.
+func (pc PromoCode) IsValidAt(t time.Time) bool {
+	if pc.Status != PromoCodeStatusActive || t.Before(pc.StartsAt) {
+		return false
+	}
+
+	return pc.EndsAt == nil || t.Before(*pc.EndsAt)
+}
+
+// AppliesTo reports whether the code can be redeemed for the given show and tier.
+func (pc PromoCode) AppliesTo(showID, tier string) bool {
+	if pc.ShowID != nil && *pc.ShowID != showID {
+		return false
+	}
+
+	return pc.Tier == nil || *pc.Tier == tier
+}
+
+// Discount returns the discount of the given quantity of tickets at the given price, the discount of a ticket never
+// exceeds its price.
+func (pc PromoCode) Discount(price money.Money, quantity int64) money.Money {
+	discount := money.Zero(price.Currency)
+
+	switch {
+	case pc.DiscountPercentage != nil:
+		discount = price.Percentage(*pc.DiscountPercentage)
+	case pc.DiscountAmount != nil && pc.DiscountAmount.Currency == price.Currency:
+		discount = *pc.DiscountAmount
+	}
+
+	if discount.Amount.GreaterThan(price.Amount) {
+		discount = price
+	}
+
+	return discount.Mul(quantity)
+}
+
+type PromoRedemption struct {
+	ID            string
+	PromoCodeID   string
+	Code          string
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	CustomerID    int64
+	Quantity      int64
+	Price         money.Money
+	Discount      money.Money
+	PaidDiscount  *money.Money
+	EarlyAccess   bool
+	Status        string
+	OrderID       *string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,108 @@
+package promo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.CustomerSession
+	Validate          *validator.Validate
+	PromoUseCase      PromoUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, customerSession *middleware.CustomerSession, validate *validator.Validate, promoUseCase PromoUseCase) {
+	handler := &HTTPHandler{
+		SessionMiddleware: customerSession,
+		Validate:          validate,
+		PromoUseCase:      promoUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/promo-redemptions", publicMiddleware.SetRouteChain(handler.RedeemPromoCode, customerSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/customerapp/promo-redemptions/{promoRedemptionID}", publicMiddleware.SetRouteChain(handler.ReleasePromoRedemption, customerSession.Verify)).Methods(http.MethodDelete)
+}
+
+func (handler HTTPHandler) RedeemPromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := RedeemPromoCodeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.TicketStockID = vars["ticketStockID"]
+	req.Code = strings.ToUpper(strings.TrimSpace(req.Code))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.PromoUseCase.RedeemPromoCode(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("promo code"), i18n.Noun("redeemed")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) ReleasePromoRedemption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := ReleasePromoRedemptionRequest{
+		ID: vars["promoRedemptionID"],
+	}
+
+	if err := handler.PromoUseCase.ReleasePromoRedemption(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("promo redemption"), i18n.Noun("released")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
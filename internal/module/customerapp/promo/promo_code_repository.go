@@ -0,0 +1,94 @@
+package promo
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PromoCodeRepository interface {
+	FindByCodeForUpdate(ctx context.Context, eventID, code string) (PromoCode, error)
+}
+
+type promoCodeRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPromoCodeRepository(logger *logrus.Logger, db *pgxpool.Pool) PromoCodeRepository {
+	return &promoCodeRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// FindByCodeForUpdate implements PromoCodeRepository. The row stays locked until the transaction ends, which
+// serializes the redemptions of the code.
+func (r *promoCodeRepository) FindByCodeForUpdate(ctx context.Context, eventID, code string) (PromoCode, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, tier, code, type, discount_percentage, discount_amount, currency,
+			usage_limit, per_customer_limit, starts_at, ends_at, status
+		FROM promo_code
+		WHERE
+			event_id = $1
+			AND code = $2
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	var pc PromoCode
+	var showID, tier, currency sql.NullString
+	var discountPercentage sql.NullFloat64
+	var discountAmount decimal.NullDecimal
+	var usageLimit, perCustomerLimit sql.NullInt64
+	var endsAt sql.NullTime
+
+	err := cmd.QueryRowContext(ctx, query, eventID, code).Scan(
+		&pc.ID, &pc.EventID, &showID, &tier, &pc.Code, &pc.Type, &discountPercentage, &discountAmount, &currency,
+		&usageLimit, &perCustomerLimit, &pc.StartsAt, &endsAt, &pc.Status,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PromoCode{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByCode, i18n.Noun("promo code"), code))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PromoCode{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting promo code's prorperties")
+	}
+
+	if showID.Valid {
+		pc.ShowID = &showID.String
+	}
+	if tier.Valid {
+		pc.Tier = &tier.String
+	}
+	if discountPercentage.Valid {
+		pc.DiscountPercentage = &discountPercentage.Float64
+	}
+	if discountAmount.Valid {
+		amount := money.New(discountAmount.Decimal, currency.String)
+		pc.DiscountAmount = &amount
+	}
+	if usageLimit.Valid {
+		pc.UsageLimit = &usageLimit.Int64
+	}
+	if perCustomerLimit.Valid {
+		pc.PerCustomerLimit = &perCustomerLimit.Int64
+	}
+	if endsAt.Valid {
+		pc.EndsAt = &endsAt.Time
+	}
+
+	return pc, nil
+}
@@ -0,0 +1,159 @@
+package promo
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PromoRedemptionRepository interface {
+	Save(ctx context.Context, pr PromoRedemption) error
+	FindByIDForUpdate(ctx context.Context, ID string) (PromoRedemption, error)
+	SumQuantity(ctx context.Context, promoCodeID string, customerID *int64, now time.Time) (int64, error)
+	Update(ctx context.Context, ID string, pr PromoRedemption) error
+}
+
+type promoRedemptionRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPromoRedemptionRepository(logger *logrus.Logger, db *pgxpool.Pool) PromoRedemptionRepository {
+	return &promoRedemptionRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements PromoRedemptionRepository.
+func (r *promoRedemptionRepository) Save(ctx context.Context, pr PromoRedemption) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO promo_redemption
+		(
+			id, promo_code_id, code, event_id, show_id, ticket_stock_id, customer_id, quantity, price, currency,
+			discount, early_access, status, expires_at, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query,
+		pr.ID, pr.PromoCodeID, pr.Code, pr.EventID, pr.ShowID, pr.TicketStockID, pr.CustomerID, pr.Quantity, pr.Price.Amount, pr.Price.Currency,
+		pr.Discount.Amount, pr.EarlyAccess, pr.Status, pr.ExpiresAt, pr.CreatedAt, pr.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving promo redemption's prorperties")
+	}
+
+	return nil
+}
+
+// FindByIDForUpdate implements PromoRedemptionRepository.
+func (r *promoRedemptionRepository) FindByIDForUpdate(ctx context.Context, ID string) (PromoRedemption, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, promo_code_id, code, event_id, show_id, ticket_stock_id, customer_id, quantity, price, currency,
+			discount, paid_discount, early_access, status, order_id, expires_at, created_at, updated_at
+		FROM promo_redemption
+		WHERE
+			id = $1
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	var pr PromoRedemption
+	var discount decimal.Decimal
+	var paidDiscount decimal.NullDecimal
+	var orderID sql.NullString
+
+	err := cmd.QueryRowContext(ctx, query, ID).Scan(
+		&pr.ID, &pr.PromoCodeID, &pr.Code, &pr.EventID, &pr.ShowID, &pr.TicketStockID, &pr.CustomerID, &pr.Quantity, &pr.Price.Amount, &pr.Price.Currency,
+		&discount, &paidDiscount, &pr.EarlyAccess, &pr.Status, &orderID, &pr.ExpiresAt, &pr.CreatedAt, &pr.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PromoRedemption{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("promo redemption"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PromoRedemption{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting promo redemption's prorperties")
+	}
+
+	pr.Discount = money.New(discount, pr.Price.Currency)
+	if paidDiscount.Valid {
+		amount := money.New(paidDiscount.Decimal, pr.Price.Currency)
+		pr.PaidDiscount = &amount
+	}
+	if orderID.Valid {
+		pr.OrderID = &orderID.String
+	}
+
+	return pr, nil
+}
+
+// SumQuantity implements PromoRedemptionRepository. It sums the quantity of the confirmed redemptions and of the
+// reserved ones which have not expired yet, either of every customer or of the given one.
+func (r *promoRedemptionRepository) SumQuantity(ctx context.Context, promoCodeID string, customerID *int64, now time.Time) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT COALESCE(SUM(quantity), 0)
+		FROM promo_redemption
+		WHERE
+			promo_code_id = $1
+			AND ($2::BIGINT IS NULL OR customer_id = $2)
+			AND (status = 'CONFIRMED' OR (status = 'RESERVED' AND expires_at > $3))
+	`
+
+	var sum int64
+	if err := cmd.QueryRowContext(ctx, query, promoCodeID, customerID, now).Scan(&sum); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting bunch of promo redemption's prorperties")
+	}
+
+	return sum, nil
+}
+
+// Update implements PromoRedemptionRepository.
+func (r *promoRedemptionRepository) Update(ctx context.Context, ID string, pr PromoRedemption) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE promo_redemption
+		SET
+			status = $1,
+			order_id = $2,
+			paid_discount = $3,
+			updated_at = $4
+		WHERE id = $5
+	`
+
+	var paidDiscount decimal.NullDecimal
+	if pr.PaidDiscount != nil {
+		paidDiscount = decimal.NewNullDecimal(pr.PaidDiscount.Amount)
+	}
+
+	_, err := cmd.ExecContext(ctx, query, pr.Status, pr.OrderID, paidDiscount, pr.UpdatedAt, ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating promo redemption's prorperties")
+	}
+
+	return nil
+}
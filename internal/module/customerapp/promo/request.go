@@ -0,0 +1,13 @@
+package promo
+
+type RedeemPromoCodeRequest struct {
+	EventID       string `json:"-"`
+	ShowID        string `json:"-"`
+	TicketStockID string `json:"-"`
+	Code          string `json:"code" validate:"required"`
+	Quantity      int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+type ReleasePromoRedemptionRequest struct {
+	ID string
+}
@@ -0,0 +1,35 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type PromoRedemptionResponse struct {
+	ID            string      `json:"id"`
+	Code          string      `json:"code"`
+	EventID       string      `json:"event_id"`
+	ShowID        string      `json:"show_id"`
+	TicketStockID string      `json:"ticket_stock_id"`
+	Quantity      int64       `json:"quantity"`
+	Price         money.Money `json:"price"`
+	Discount      money.Money `json:"discount"`
+	EarlyAccess   bool        `json:"early_access"`
+	Status        string      `json:"status"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+}
+
+func (r *PromoRedemptionResponse) PopulateFromEntity(pr PromoRedemption) {
+	r.ID = pr.ID
+	r.Code = pr.Code
+	r.EventID = pr.EventID
+	r.ShowID = pr.ShowID
+	r.TicketStockID = pr.TicketStockID
+	r.Quantity = pr.Quantity
+	r.Price = pr.Price
+	r.Discount = pr.Discount
+	r.EarlyAccess = pr.EarlyAccess
+	r.Status = pr.Status
+	r.ExpiresAt = pr.ExpiresAt
+}
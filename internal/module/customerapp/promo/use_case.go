@@ -0,0 +1,196 @@
+package promo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PromoUseCase interface {
+	RedeemPromoCode(ctx context.Context, req RedeemPromoCodeRequest) (PromoRedemptionResponse, error)
+	ReleasePromoRedemption(ctx context.Context, req ReleasePromoRedemptionRequest) error
+}
+
+type promoUseCase struct {
+	logger                       *logrus.Logger
+	location                     *time.Location
+	timeout                      time.Duration
+	promoCodeRepository          PromoCodeRepository
+	promoRedemptionRepository    PromoRedemptionRepository
+	ticketStockRepository        ticket.TicketStockRepository
+	priceRuleRepository          ticket.PriceRuleRepository
+	orderRuleRangeDateRepository order.OrderRuleRangeDateRepository
+	txManager                    postgresql.TxManager
+}
+
+type PromoUseCaseProperty struct {
+	Logger                       *logrus.Logger
+	Location                     *time.Location
+	Timeout                      time.Duration
+	PromoCodeRepository          PromoCodeRepository
+	PromoRedemptionRepository    PromoRedemptionRepository
+	TicketStockRepository        ticket.TicketStockRepository
+	PriceRuleRepository          ticket.PriceRuleRepository
+	OrderRuleRangeDateRepository order.OrderRuleRangeDateRepository
+	TxManager                    postgresql.TxManager
+}
+
+func NewPromoUseCase(props PromoUseCaseProperty) PromoUseCase {
+	return &promoUseCase{
+		logger:                       props.Logger,
+		location:                     props.Location,
+		timeout:                      props.Timeout,
+		promoCodeRepository:          props.PromoCodeRepository,
+		promoRedemptionRepository:    props.PromoRedemptionRepository,
+		ticketStockRepository:        props.TicketStockRepository,
+		priceRuleRepository:          props.PriceRuleRepository,
+		orderRuleRangeDateRepository: props.OrderRuleRangeDateRepository,
+		txManager:                    props.TxManager,
+	}
+}
+
+// RedeemPromoCode implements PromoUseCase. The redemption is reserved for the customer for PromoRedemptionDuration and
+// is confirmed once the order which carries it has been paid. Access codes let the customer buy before the sale starts.
+func (u *promoUseCase) RedeemPromoCode(ctx context.Context, req RedeemPromoCodeRequest) (PromoRedemptionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return PromoRedemptionResponse{}, err
+	}
+
+	ts, err := u.ticketStockRepository.FindByID(ctx, req.TicketStockID)
+	if err != nil {
+		return PromoRedemptionResponse{}, err
+	}
+
+	if ts.EventID != req.EventID || ts.ShowID != req.ShowID {
+		return PromoRedemptionResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), req.TicketStockID))
+	}
+
+	rule, err := u.orderRuleRangeDateRepository.FindByEventID(ctx, ts.EventID)
+	if err != nil {
+		return PromoRedemptionResponse{}, err
+	}
+
+	priceRules, err := u.priceRuleRepository.FindManyByTicketStockID(ctx, ts.ID)
+	if err != nil {
+		return PromoRedemptionResponse{}, err
+	}
+
+	var pr PromoRedemption
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		pc, err := u.promoCodeRepository.FindByCodeForUpdate(ctx, ts.EventID, req.Code)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if !pc.IsValidAt(now) {
+			return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeUnavailable, pc.Code))
+		}
+
+		if !pc.AppliesTo(ts.ShowID, ts.Tier) {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.PromoCodeNotApplicable, pc.Code, ts.ID))
+		}
+
+		earlyAccess := now.Before(rule.StartDate)
+		if earlyAccess && pc.Type != PromoCodeTypeAccess {
+			return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeBeforeSale, pc.Code))
+		}
+
+		if pc.UsageLimit != nil {
+			redeemed, err := u.promoRedemptionRepository.SumQuantity(ctx, pc.ID, nil, now)
+			if err != nil {
+				return err
+			}
+
+			if redeemed+req.Quantity > *pc.UsageLimit {
+				return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeUsageLimit, pc.Code))
+			}
+		}
+
+		if pc.PerCustomerLimit != nil {
+			redeemed, err := u.promoRedemptionRepository.SumQuantity(ctx, pc.ID, &acc.ID, now)
+			if err != nil {
+				return err
+			}
+
+			if redeemed+req.Quantity > *pc.PerCustomerLimit {
+				return errors.New(http.StatusConflict, status.PROMO_CODE_UNAVAILABLE, i18n.Message(ctx, i18n.PromoCodeCustomerLimit, pc.Code))
+			}
+		}
+
+		price := ts.Quote(priceRules, now).Price
+
+		pr = PromoRedemption{
+			ID:            util.GenerateTimestampWithPrefix("REDEEM"),
+			PromoCodeID:   pc.ID,
+			Code:          pc.Code,
+			EventID:       ts.EventID,
+			ShowID:        ts.ShowID,
+			TicketStockID: ts.ID,
+			CustomerID:    acc.ID,
+			Quantity:      req.Quantity,
+			Price:         price,
+			Discount:      pc.Discount(price, req.Quantity),
+			EarlyAccess:   earlyAccess,
+			Status:        PromoRedemptionStatusReserved,
+			ExpiresAt:     now.Add(PromoRedemptionDuration),
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+
+		return u.promoRedemptionRepository.Save(ctx, pr)
+	})
+	if err != nil {
+		return PromoRedemptionResponse{}, err
+	}
+
+	resp := PromoRedemptionResponse{}
+	resp.PopulateFromEntity(pr)
+
+	return resp, nil
+}
+
+// ReleasePromoRedemption implements PromoUseCase.
+func (u *promoUseCase) ReleasePromoRedemption(ctx context.Context, req ReleasePromoRedemptionRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		pr, err := u.promoRedemptionRepository.FindByIDForUpdate(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if pr.CustomerID != acc.ID {
+			return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("promo redemption"), req.ID))
+		}
+
+		if pr.Status != PromoRedemptionStatusReserved {
+			return errors.New(http.StatusBadRequest, status.BAD_REQUEST, i18n.Message(ctx, i18n.PromoRedemptionNotReleasable, req.ID))
+		}
+
+		pr.Status = PromoRedemptionStatusReleased
+		pr.UpdatedAt = time.Now()
+
+		return u.promoRedemptionRepository.Update(ctx, pr.ID, pr)
+	})
+}
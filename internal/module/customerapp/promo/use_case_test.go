@@ -0,0 +1,214 @@
+package promo_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/adminapp/order"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/promo"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the redemption is not expected to call panics.
+
+type promoCodeStore struct {
+	promo.PromoCodeRepository
+	pc promo.PromoCode
+}
+
+func (s promoCodeStore) FindByCodeForUpdate(ctx context.Context, eventID, code string) (promo.PromoCode, error) {
+	if s.pc.EventID != eventID || s.pc.Code != code {
+		return promo.PromoCode{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return s.pc, nil
+}
+
+// promoRedemptionStore is an in-memory stand-in of the promo redemption table, SumQuantity counts the same
+// redemptions as the query of the repository.
+type promoRedemptionStore struct {
+	promo.PromoRedemptionRepository
+
+	mu          sync.Mutex
+	redemptions []promo.PromoRedemption
+}
+
+func (s *promoRedemptionStore) Save(ctx context.Context, pr promo.PromoRedemption) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.redemptions = append(s.redemptions, pr)
+
+	return nil
+}
+
+func (s *promoRedemptionStore) SumQuantity(ctx context.Context, promoCodeID string, customerID *int64, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sum int64
+	for _, pr := range s.redemptions {
+		if pr.PromoCodeID != promoCodeID || (customerID != nil && pr.CustomerID != *customerID) {
+			continue
+		}
+
+		if pr.Status == promo.PromoRedemptionStatusConfirmed || (pr.Status == promo.PromoRedemptionStatusReserved && pr.ExpiresAt.After(now)) {
+			sum = sum + pr.Quantity
+		}
+	}
+
+	return sum, nil
+}
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	ts ticket.TicketStock
+}
+
+func (s ticketStockStore) FindByID(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	if s.ts.ID != ID {
+		return ticket.TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, "")
+	}
+
+	return s.ts, nil
+}
+
+type priceRuleStore struct {
+	ticket.PriceRuleRepository
+}
+
+func (priceRuleStore) FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]ticket.PriceRule, error) {
+	return nil, nil
+}
+
+type orderRuleStore struct {
+	order.OrderRuleRangeDateRepository
+	rule order.OrderRuleRangeDate
+}
+
+func (s orderRuleStore) FindByEventID(ctx context.Context, eventID string) (order.OrderRuleRangeDate, error) {
+	return s.rule, nil
+}
+
+// txManager runs fn right away, the code is not locked since the redemptions of a test are not concurrent.
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestPromoUseCaseRedeemPromoCode(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	now := time.Now()
+	usageLimit, perCustomerLimit := int64(5), int64(2)
+	percentage := 10.0
+
+	pc := promo.PromoCode{
+		ID:                 "PROMO1",
+		EventID:            "EVENT1",
+		Code:               "SAVE10",
+		Type:               promo.PromoCodeTypeDiscount,
+		DiscountPercentage: &percentage,
+		UsageLimit:         &usageLimit,
+		PerCustomerLimit:   &perCustomerLimit,
+		StartsAt:           now.Add(-time.Hour),
+		Status:             promo.PromoCodeStatusActive,
+	}
+
+	newUseCase := func(redemptions *promoRedemptionStore) promo.PromoUseCase {
+		return promo.NewPromoUseCase(promo.PromoUseCaseProperty{
+			Logger:                    logger,
+			Location:                  time.UTC,
+			Timeout:                   10 * time.Second,
+			PromoCodeRepository:       promoCodeStore{pc: pc},
+			PromoRedemptionRepository: redemptions,
+			TicketStockRepository: ticketStockStore{ts: ticket.TicketStock{
+				ID: "TS1", EventID: "EVENT1", ShowID: "SHOW1", Tier: "CAT1", Allocation: 100, Price: money.MustParse("100000", "IDR"),
+			}},
+			PriceRuleRepository:          priceRuleStore{},
+			OrderRuleRangeDateRepository: orderRuleStore{rule: order.OrderRuleRangeDate{EventID: "EVENT1", StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour)}},
+			TxManager:                    txManager{},
+		})
+	}
+
+	redeem := func(uc promo.PromoUseCase, customerID, quantity int64) (promo.PromoRedemptionResponse, error) {
+		ctx := context.WithValue(context.Background(), session.AccountContextKey{}, session.Account{ID: customerID})
+
+		return uc.RedeemPromoCode(ctx, promo.RedeemPromoCodeRequest{
+			EventID: "EVENT1", ShowID: "SHOW1", TicketStockID: "TS1", Code: "SAVE10", Quantity: quantity,
+		})
+	}
+
+	// redemption returns a redemption of the code by the customer in the given status which expires at the given time
+	redemption := func(customerID, quantity int64, redemptionStatus string, expiresAt time.Time) promo.PromoRedemption {
+		return promo.PromoRedemption{PromoCodeID: "PROMO1", CustomerID: customerID, Quantity: quantity, Status: redemptionStatus, ExpiresAt: expiresAt}
+	}
+
+	t.Run("a redemption within the limits is reserved with the discount of its quantity", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{}
+
+		resp, err := redeem(newUseCase(redemptions), 1, 2)
+		require.NoError(t, err)
+
+		require.Len(t, redemptions.redemptions, 1)
+		pr := redemptions.redemptions[0]
+		assert.Equal(t, promo.PromoRedemptionStatusReserved, pr.Status)
+		assert.Equal(t, int64(1), pr.CustomerID)
+		assert.True(t, money.MustParse("20000", "IDR").Equal(pr.Discount))
+		assert.Equal(t, pr.ID, resp.ID)
+	})
+
+	t.Run("a redemption over the limit of a customer is rejected", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: []promo.PromoRedemption{
+			redemption(1, 1, promo.PromoRedemptionStatusConfirmed, now.Add(-time.Hour)),
+		}}
+
+		_, err := redeem(newUseCase(redemptions), 1, 2)
+		assert.True(t, errors.MatchStatus(err, status.PROMO_CODE_UNAVAILABLE))
+		assert.Len(t, redemptions.redemptions, 1)
+
+		// another customer is not held back by the redemptions of the first one
+		_, err = redeem(newUseCase(redemptions), 2, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("a redemption over the usage limit is rejected", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: []promo.PromoRedemption{
+			redemption(1, 2, promo.PromoRedemptionStatusConfirmed, now.Add(-time.Hour)),
+			redemption(2, 2, promo.PromoRedemptionStatusReserved, now.Add(time.Minute)),
+		}}
+
+		_, err := redeem(newUseCase(redemptions), 3, 2)
+		assert.True(t, errors.MatchStatus(err, status.PROMO_CODE_UNAVAILABLE))
+
+		_, err = redeem(newUseCase(redemptions), 3, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("the expired and released reservations no longer count against the limits", func(t *testing.T) {
+		redemptions := &promoRedemptionStore{redemptions: []promo.PromoRedemption{
+			redemption(1, 2, promo.PromoRedemptionStatusReserved, now.Add(-time.Minute)),
+			redemption(1, 2, promo.PromoRedemptionStatusReleased, now.Add(time.Minute)),
+			redemption(2, 2, promo.PromoRedemptionStatusReserved, now.Add(-time.Minute)),
+		}}
+
+		_, err := redeem(newUseCase(redemptions), 1, 2)
+		assert.NoError(t, err)
+	})
+}
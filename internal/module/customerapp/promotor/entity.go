@@ -0,0 +1,21 @@
+package promotor
+
+import "time"
+
+type Promotor struct {
+	ID       string
+	Slug     string
+	Name     string
+	Email    string
+	Phone    string
+	Bio      string
+	ImageURL string
+}
+
+type UpcomingEvent struct {
+	ID           string
+	Name         string
+	Description  string
+	Status       string
+	NextShowTime time.Time
+}
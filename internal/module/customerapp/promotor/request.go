@@ -0,0 +1,11 @@
+package promotor
+
+type GetPromotorRequest struct {
+	IDOrSlug string
+}
+
+type GetManyPromotorUpcomingEventRequest struct {
+	IDOrSlug string `validate:"-"`
+	Page     int    `validate:"required"`
+	Size     int    `validate:"required"`
+}
@@ -0,0 +1,37 @@
+package promotor
+
+import "time"
+
+type PromotorResponse struct {
+	ID       string `json:"id"`
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Phone    string `json:"phone"`
+	Bio      string `json:"bio"`
+	ImageURL string `json:"image_url"`
+}
+
+func (r *PromotorResponse) PopulateFromEntity(p Promotor) {
+	r.ID = p.ID
+	r.Slug = p.Slug
+	r.Name = p.Name
+	r.Email = p.Email
+	r.Phone = p.Phone
+	r.Bio = p.Bio
+	r.ImageURL = p.ImageURL
+}
+
+type UpcomingEventResponse struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Status       string    `json:"status"`
+	NextShowTime time.Time `json:"next_show_time"`
+}
+
+type GetManyPromotorUpcomingEventResponse struct {
+	Total    int64                   `json:"total"`
+	Promotor PromotorResponse        `json:"promotor"`
+	Events   []UpcomingEventResponse `json:"events"`
+}
@@ -0,0 +1,111 @@
+package promotor
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+type PromotorUseCase interface {
+	GetPromotor(ctx context.Context, req GetPromotorRequest) (PromotorResponse, error)
+	GetManyPromotorUpcomingEvent(ctx context.Context, req GetManyPromotorUpcomingEventRequest) (GetManyPromotorUpcomingEventResponse, error)
+}
+
+type promotorUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	promotorRepository PromotorRepository
+}
+
+type PromotorUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	PromotorRepository PromotorRepository
+}
+
+func NewPromotorUseCase(props PromotorUseCaseProperty) PromotorUseCase {
+	return &promotorUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		promotorRepository: props.PromotorRepository,
+	}
+}
+
+// GetPromotor implements PromotorUseCase.
+func (u *promotorUseCase) GetPromotor(ctx context.Context, req GetPromotorRequest) (PromotorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	p, err := u.promotorRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return PromotorResponse{}, err
+	}
+
+	resp := PromotorResponse{}
+	resp.PopulateFromEntity(p)
+
+	return resp, nil
+}
+
+// GetManyPromotorUpcomingEvent implements PromotorUseCase.
+func (u *promotorUseCase) GetManyPromotorUpcomingEvent(ctx context.Context, req GetManyPromotorUpcomingEventRequest) (GetManyPromotorUpcomingEventResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	p, err := u.promotorRepository.FindByIDOrSlug(ctx, req.IDOrSlug)
+	if err != nil {
+		return GetManyPromotorUpcomingEventResponse{}, err
+	}
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+	now := time.Now()
+
+	var events []UpcomingEvent
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.promotorRepository.CountUpcomingEvent(gctx, p.ID, now)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.promotorRepository.FindManyUpcomingEvent(gctx, p.ID, now, offset, limit)
+		if err != nil {
+			return err
+		}
+		events = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyPromotorUpcomingEventResponse{}, err
+	}
+
+	resp := GetManyPromotorUpcomingEventResponse{
+		Total:  total,
+		Events: make([]UpcomingEventResponse, len(events)),
+	}
+	resp.Promotor.PopulateFromEntity(p)
+
+	for k, e := range events {
+		resp.Events[k] = UpcomingEventResponse{
+			ID:           e.ID,
+			Name:         e.Name,
+			Description:  e.Description,
+			Status:       e.Status,
+			NextShowTime: e.NextShowTime,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package seat
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	SeatStatusAvailable   string = "AVAILABLE"
+	SeatStatusHeld        string = "HELD"
+	SeatStatusSold        string = "SOLD"
+	SeatStatusUnavailable string = "UNAVAILABLE"
+
+	// SeatHoldDuration is how long the selected seats are held for a customer before they are released to others.
+	SeatHoldDuration time.Duration = 10 * time.Minute
+)
+
+type ShowSeat struct {
+	EventID       string
+	ShowID        string
+	SeatID        string
+	TicketStockID string
+	Section       string
+	Row           string
+	Number        string
+	Tier          string
+	Price         money.Money
+	Status        string
+	HeldBy        *int64
+	HeldUntil     *time.Time
+	OrderID       *string
+	UpdatedAt     time.Time
+}
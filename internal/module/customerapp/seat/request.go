@@ -0,0 +1,18 @@
+package seat
+
+type GetShowSeatMapRequest struct {
+	EventID string
+	ShowID  string
+}
+
+type HoldSeatsRequest struct {
+	EventID string   `json:"-"`
+	ShowID  string   `json:"-"`
+	SeatIDs []string `json:"seat_ids" validate:"required,min=1,max=10,unique,dive,required"`
+}
+
+type ReleaseSeatsRequest struct {
+	EventID string   `json:"-"`
+	ShowID  string   `json:"-"`
+	SeatIDs []string `json:"seat_ids" validate:"required,min=1,unique,dive,required"`
+}
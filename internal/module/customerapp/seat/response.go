@@ -0,0 +1,93 @@
+package seat
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+type SeatResponse struct {
+	ID            string      `json:"id"`
+	Number        string      `json:"number"`
+	Tier          string      `json:"tier"`
+	TicketStockID string      `json:"ticket_stock_id"`
+	Price         money.Money `json:"price"`
+	Status        string      `json:"status"`
+	HeldUntil     *time.Time  `json:"held_until,omitempty"`
+}
+
+type RowResponse struct {
+	Name  string         `json:"name"`
+	Seats []SeatResponse `json:"seats"`
+}
+
+type SectionResponse struct {
+	Name string        `json:"name"`
+	Rows []RowResponse `json:"rows"`
+}
+
+type ShowSeatMapResponse struct {
+	EventID        string            `json:"event_id"`
+	ShowID         string            `json:"show_id"`
+	TotalSeats     int64             `json:"total_seats"`
+	AvailableSeats int64             `json:"available_seats"`
+	Sections       []SectionResponse `json:"sections"`
+}
+
+// PopulateFromEntity groups the seats by section and row. The status of every seat is seen from the perspective of the given customer.
+func (r *ShowSeatMapResponse) PopulateFromEntity(showSeats []ShowSeat, customerID int64, now time.Time) {
+	r.TotalSeats = int64(len(showSeats))
+	r.Sections = make([]SectionResponse, 0)
+
+	sectionIndex := make(map[string]int)
+	rowIndex := make(map[string]int)
+	for _, ss := range showSeats {
+		sk, ok := sectionIndex[ss.Section]
+		if !ok {
+			r.Sections = append(r.Sections, SectionResponse{Name: ss.Section})
+			sk = len(r.Sections) - 1
+			sectionIndex[ss.Section] = sk
+		}
+
+		section := &r.Sections[sk]
+		rowKey := ss.Section + "|" + ss.Row
+		rk, ok := rowIndex[rowKey]
+		if !ok {
+			section.Rows = append(section.Rows, RowResponse{Name: ss.Row})
+			rk = len(section.Rows) - 1
+			rowIndex[rowKey] = rk
+		}
+
+		sr := SeatResponse{
+			ID:            ss.SeatID,
+			Number:        ss.Number,
+			Tier:          ss.Tier,
+			TicketStockID: ss.TicketStockID,
+			Price:         ss.Price,
+			Status:        SeatStatusUnavailable,
+		}
+
+		switch {
+		case ss.Status == SeatStatusAvailable:
+			sr.Status = SeatStatusAvailable
+		case ss.Status == SeatStatusHeld && ss.HeldBy != nil && *ss.HeldBy == customerID:
+			sr.Status = SeatStatusHeld
+			sr.HeldUntil = ss.HeldUntil
+		case ss.Status == SeatStatusHeld && ss.HeldUntil != nil && ss.HeldUntil.Before(now):
+			sr.Status = SeatStatusAvailable
+		}
+
+		if sr.Status == SeatStatusAvailable {
+			r.AvailableSeats++
+		}
+
+		section.Rows[rk].Seats = append(section.Rows[rk].Seats, sr)
+	}
+}
+
+type HoldSeatsResponse struct {
+	EventID   string         `json:"event_id"`
+	ShowID    string         `json:"show_id"`
+	HeldUntil time.Time      `json:"held_until"`
+	Seats     []SeatResponse `json:"seats"`
+}
@@ -0,0 +1,238 @@
+package seat
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type ShowSeatRepository interface {
+	FindManyByShowID(ctx context.Context, showID string) ([]ShowSeat, error)
+	Hold(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, heldUntil, now time.Time) ([]ShowSeat, error)
+	Release(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, now time.Time) error
+	MarkSold(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, orderID string, now time.Time) ([]ShowSeat, error)
+	ReleaseSold(ctx context.Context, eventID, showID string, seatIDs []string, orderID string, now time.Time) error
+}
+
+type showSeatRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewShowSeatRepository(logger *logrus.Logger, db *pgxpool.Pool) ShowSeatRepository {
+	return &showSeatRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *showSeatRepository) scan(rows postgresql.Rows) ([]ShowSeat, error) {
+	var data = make([]ShowSeat, 0)
+	for rows.Next() {
+		var ss ShowSeat
+		var heldBy sql.NullInt64
+		var heldUntil sql.NullTime
+		var orderID sql.NullString
+
+		err := rows.Scan(
+			&ss.EventID, &ss.ShowID, &ss.SeatID, &ss.TicketStockID, &ss.Section, &ss.Row, &ss.Number, &ss.Tier,
+			&ss.Price.Amount, &ss.Price.Currency, &ss.Status, &heldBy, &heldUntil, &orderID, &ss.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if heldBy.Valid {
+			ss.HeldBy = &heldBy.Int64
+		}
+		if heldUntil.Valid {
+			ss.HeldUntil = &heldUntil.Time
+		}
+		if orderID.Valid {
+			ss.OrderID = &orderID.String
+		}
+
+		data = append(data, ss)
+	}
+
+	return data, rows.Err()
+}
+
+// FindManyByShowID implements ShowSeatRepository.
+func (r *showSeatRepository) FindManyByShowID(ctx context.Context, showID string) ([]ShowSeat, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			event_id, show_id, seat_id, ticket_stock_id, section, "row", "number", tier,
+			price, currency, status, held_by, held_until, order_id, updated_at
+		FROM show_seat
+		WHERE
+			show_id = $1
+		ORDER BY section ASC, "row" ASC, "number" ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, showID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of show seat's prorperties")
+	}
+
+	defer rows.Close()
+
+	data, err := r.scan(rows)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of show seat's prorperties")
+	}
+
+	return data, nil
+}
+
+// Hold implements ShowSeatRepository. Only the seats which are available, held by the same customer or whose hold has expired are held.
+func (r *showSeatRepository) Hold(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, heldUntil, now time.Time) ([]ShowSeat, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE show_seat
+		SET
+			status = 'HELD',
+			held_by = $1,
+			held_until = $2,
+			updated_at = $3
+		WHERE
+			event_id = $4
+			AND show_id = $5
+			AND seat_id = ANY($6)
+			AND (
+				status = 'AVAILABLE'
+				OR (status = 'HELD' AND (held_by = $1 OR held_until < $3))
+			)
+		RETURNING
+			event_id, show_id, seat_id, ticket_stock_id, section, "row", "number", tier,
+			price, currency, status, held_by, held_until, order_id, updated_at
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, customerID, heldUntil, now, eventID, showID, seatIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while holding show seat's prorperties")
+	}
+
+	defer rows.Close()
+
+	data, err := r.scan(rows)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while holding show seat's prorperties")
+	}
+
+	return data, nil
+}
+
+// Release implements ShowSeatRepository.
+func (r *showSeatRepository) Release(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, now time.Time) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE show_seat
+		SET
+			status = 'AVAILABLE',
+			held_by = NULL,
+			held_until = NULL,
+			updated_at = $1
+		WHERE
+			event_id = $2
+			AND show_id = $3
+			AND seat_id = ANY($4)
+			AND status = 'HELD'
+			AND held_by = $5
+	`
+
+	_, err := cmd.ExecContext(ctx, query, now, eventID, showID, seatIDs, customerID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while releasing show seat's prorperties")
+	}
+
+	return nil
+}
+
+// MarkSold implements ShowSeatRepository. The seats must be available or held by the customer who paid the order.
+func (r *showSeatRepository) MarkSold(ctx context.Context, eventID, showID string, seatIDs []string, customerID int64, orderID string, now time.Time) ([]ShowSeat, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE show_seat
+		SET
+			status = 'SOLD',
+			held_by = $1,
+			held_until = NULL,
+			order_id = $2,
+			updated_at = $3
+		WHERE
+			event_id = $4
+			AND show_id = $5
+			AND seat_id = ANY($6)
+			AND (
+				status = 'AVAILABLE'
+				OR (status = 'HELD' AND (held_by = $1 OR held_until < $3))
+			)
+		RETURNING
+			event_id, show_id, seat_id, ticket_stock_id, section, "row", "number", tier,
+			price, currency, status, held_by, held_until, order_id, updated_at
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, customerID, orderID, now, eventID, showID, seatIDs)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while selling show seat's prorperties")
+	}
+
+	defer rows.Close()
+
+	data, err := r.scan(rows)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while selling show seat's prorperties")
+	}
+
+	return data, nil
+}
+
+// ReleaseSold implements ShowSeatRepository. The seats which are sold by the order are available again, the seats which
+// are sold by another order are left as they are.
+func (r *showSeatRepository) ReleaseSold(ctx context.Context, eventID, showID string, seatIDs []string, orderID string, now time.Time) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE show_seat
+		SET
+			status = 'AVAILABLE',
+			held_by = NULL,
+			held_until = NULL,
+			order_id = NULL,
+			updated_at = $1
+		WHERE
+			event_id = $2
+			AND show_id = $3
+			AND seat_id = ANY($4)
+			AND status = 'SOLD'
+			AND order_id = $5
+	`
+
+	_, err := cmd.ExecContext(ctx, query, now, eventID, showID, seatIDs, orderID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while releasing show seat's prorperties")
+	}
+
+	return nil
+}
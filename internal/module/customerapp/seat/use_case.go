@@ -0,0 +1,140 @@
+package seat
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type SeatUseCase interface {
+	GetShowSeatMap(ctx context.Context, req GetShowSeatMapRequest) (ShowSeatMapResponse, error)
+	HoldSeats(ctx context.Context, req HoldSeatsRequest) (HoldSeatsResponse, error)
+	ReleaseSeats(ctx context.Context, req ReleaseSeatsRequest) error
+}
+
+type seatUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	showSeatRepository ShowSeatRepository
+	txManager          postgresql.TxManager
+}
+
+type SeatUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	ShowSeatRepository ShowSeatRepository
+	TxManager          postgresql.TxManager
+}
+
+func NewSeatUseCase(props SeatUseCaseProperty) SeatUseCase {
+	return &seatUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		showSeatRepository: props.ShowSeatRepository,
+		txManager:          props.TxManager,
+	}
+}
+
+// GetShowSeatMap implements SeatUseCase.
+func (u *seatUseCase) GetShowSeatMap(ctx context.Context, req GetShowSeatMapRequest) (ShowSeatMapResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return ShowSeatMapResponse{}, err
+	}
+
+	showSeats, err := u.showSeatRepository.FindManyByShowID(ctx, req.ShowID)
+	if err != nil {
+		return ShowSeatMapResponse{}, err
+	}
+
+	if len(showSeats) < 1 || showSeats[0].EventID != req.EventID {
+		return ShowSeatMapResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.SeatMapOfShowNotFound, req.ShowID))
+	}
+
+	resp := ShowSeatMapResponse{
+		EventID: req.EventID,
+		ShowID:  req.ShowID,
+	}
+	resp.PopulateFromEntity(showSeats, acc.ID, time.Now())
+
+	return resp, nil
+}
+
+// HoldSeats implements SeatUseCase.
+func (u *seatUseCase) HoldSeats(ctx context.Context, req HoldSeatsRequest) (HoldSeatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return HoldSeatsResponse{}, err
+	}
+
+	now := time.Now()
+	heldUntil := now.Add(SeatHoldDuration)
+
+	var showSeats []ShowSeat
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		showSeats, err = u.showSeatRepository.Hold(ctx, req.EventID, req.ShowID, req.SeatIDs, acc.ID, heldUntil, now)
+		if err != nil {
+			return err
+		}
+
+		if len(showSeats) != len(req.SeatIDs) {
+			return errors.New(http.StatusConflict, status.SEAT_UNAVAILABLE, i18n.Message(ctx, i18n.SeatsUnavailable))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return HoldSeatsResponse{}, err
+	}
+
+	resp := HoldSeatsResponse{
+		EventID:   req.EventID,
+		ShowID:    req.ShowID,
+		HeldUntil: heldUntil,
+		Seats:     make([]SeatResponse, len(showSeats)),
+	}
+
+	for k, ss := range showSeats {
+		resp.Seats[k] = SeatResponse{
+			ID:            ss.SeatID,
+			Number:        ss.Number,
+			Tier:          ss.Tier,
+			TicketStockID: ss.TicketStockID,
+			Price:         ss.Price,
+			Status:        ss.Status,
+			HeldUntil:     ss.HeldUntil,
+		}
+	}
+
+	return resp, nil
+}
+
+// ReleaseSeats implements SeatUseCase.
+func (u *seatUseCase) ReleaseSeats(ctx context.Context, req ReleaseSeatsRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.showSeatRepository.Release(ctx, req.EventID, req.ShowID, req.SeatIDs, acc.ID, time.Now())
+}
@@ -0,0 +1,41 @@
+package taxonomy
+
+const (
+	TypeCategory string = "CATEGORY"
+	TypeGenre    string = "GENRE"
+	TypeTag      string = "TAG"
+)
+
+// types maps the path segment of the taxonomy's routes to its type.
+var types = map[string]string{
+	"categories": TypeCategory,
+	"genres":     TypeGenre,
+	"tags":       TypeTag,
+}
+
+// nouns holds the noun of each type which is used in the messages.
+var nouns = map[string]string{
+	TypeCategory: "category",
+	TypeGenre:    "genre",
+	TypeTag:      "tag",
+}
+
+// TypeFromPath returns the type of the taxonomy which is browsed under the given path segment, e.g. "genres".
+func TypeFromPath(path string) (string, bool) {
+	t, ok := types[path]
+	return t, ok
+}
+
+// Noun returns the noun of the type of taxonomy.
+func Noun(taxonomyType string) string {
+	return nouns[taxonomyType]
+}
+
+// Taxonomy is a category, a genre or a tag along with the number of events which are attached to it.
+type Taxonomy struct {
+	ID         string
+	Type       string
+	Slug       string
+	Name       string
+	EventCount int64
+}
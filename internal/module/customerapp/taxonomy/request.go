@@ -0,0 +1,7 @@
+package taxonomy
+
+type GetManyTaxonomyRequest struct {
+	Type string
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
@@ -0,0 +1,22 @@
+package taxonomy
+
+type TaxonomyResponse struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+	EventCount int64  `json:"event_count"`
+}
+
+func (r *TaxonomyResponse) PopulateFromEntity(t Taxonomy) {
+	r.ID = t.ID
+	r.Type = t.Type
+	r.Slug = t.Slug
+	r.Name = t.Name
+	r.EventCount = t.EventCount
+}
+
+type GetManyTaxonomyResponse struct {
+	Total      int64              `json:"total"`
+	Taxonomies []TaxonomyResponse `json:"taxonomies"`
+}
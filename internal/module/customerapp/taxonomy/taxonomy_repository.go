@@ -0,0 +1,88 @@
+package taxonomy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type TaxonomyRepository interface {
+	FindMany(ctx context.Context, taxonomyType string, offset, limit int) ([]Taxonomy, error)
+	Count(ctx context.Context, taxonomyType string) (int64, error)
+}
+
+type taxonomyRepository struct {
+	logger     *logrus.Logger
+	db         *pgxpool.Pool
+	replicaSet *postgresql.ReplicaSet
+}
+
+func NewTaxonomyRepository(logger *logrus.Logger, db *pgxpool.Pool, replicaSet *postgresql.ReplicaSet) TaxonomyRepository {
+	return &taxonomyRepository{
+		logger:     logger,
+		db:         db,
+		replicaSet: replicaSet,
+	}
+}
+
+// FindMany implements TaxonomyRepository. The taxonomies are counted by their active events.
+func (r *taxonomyRepository) FindMany(ctx context.Context, taxonomyType string, offset int, limit int) ([]Taxonomy, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			t.id, t.type, t.slug, t.name, count(e.id)
+		FROM taxonomy t
+		LEFT JOIN event_taxonomy et ON et.taxonomy_id = t.id
+		LEFT JOIN event e ON e.id = et.event_id AND e.status = 'ACTIVE'
+		WHERE
+			t.type = $1
+		GROUP BY t.id, t.type, t.slug, t.name
+		ORDER BY t.name ASC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, taxonomyType, offset, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while getting bunch of %s's prorperties", Noun(taxonomyType)))
+	}
+
+	defer rows.Close()
+
+	var data = make([]Taxonomy, 0)
+	for rows.Next() {
+		var t Taxonomy
+		err := rows.Scan(&t.ID, &t.Type, &t.Slug, &t.Name, &t.EventCount)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while getting bunch of %s's prorperties", Noun(taxonomyType)))
+		}
+
+		data = append(data, t)
+	}
+
+	return data, nil
+}
+
+// Count implements TaxonomyRepository.
+func (r *taxonomyRepository) Count(ctx context.Context, taxonomyType string) (int64, error) {
+	cmd := r.replicaSet.CommandFromContext(ctx, r.db)
+
+	query := `SELECT count(id) FROM taxonomy WHERE type = $1`
+	var count int64
+	row := cmd.QueryRowContext(ctx, query, taxonomyType)
+	if err := row.Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, fmt.Sprintf("an error occurred while counting bunch of %s's prorperties", Noun(taxonomyType)))
+	}
+
+	return count, nil
+}
@@ -0,0 +1,81 @@
+package taxonomy
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+type TaxonomyUseCase interface {
+	GetManyTaxonomy(ctx context.Context, req GetManyTaxonomyRequest) (GetManyTaxonomyResponse, error)
+}
+
+type taxonomyUseCase struct {
+	logger             *logrus.Logger
+	location           *time.Location
+	timeout            time.Duration
+	taxonomyRepository TaxonomyRepository
+}
+
+type TaxonomyUseCaseProperty struct {
+	Logger             *logrus.Logger
+	Location           *time.Location
+	Timeout            time.Duration
+	TaxonomyRepository TaxonomyRepository
+}
+
+func NewTaxonomyUseCase(props TaxonomyUseCaseProperty) TaxonomyUseCase {
+	return &taxonomyUseCase{
+		logger:             props.Logger,
+		location:           props.Location,
+		timeout:            props.Timeout,
+		taxonomyRepository: props.TaxonomyRepository,
+	}
+}
+
+// GetManyTaxonomy implements TaxonomyUseCase.
+func (u *taxonomyUseCase) GetManyTaxonomy(ctx context.Context, req GetManyTaxonomyRequest) (GetManyTaxonomyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var taxonomies []Taxonomy
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.taxonomyRepository.Count(gctx, req.Type)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.taxonomyRepository.FindMany(gctx, req.Type, offset, limit)
+		if err != nil {
+			return err
+		}
+		taxonomies = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyTaxonomyResponse{}, err
+	}
+
+	resp := GetManyTaxonomyResponse{
+		Total:      total,
+		Taxonomies: make([]TaxonomyResponse, len(taxonomies)),
+	}
+
+	for k, v := range taxonomies {
+		resp.Taxonomies[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
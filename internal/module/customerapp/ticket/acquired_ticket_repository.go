@@ -2,26 +2,31 @@ package ticket
 
 import (
 	"context"
-	"database/sql"
 	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type AcquiredTicketRepository interface {
-	Save(ctx context.Context, aq AcquiredTicket, tx *sql.Tx) (int64, error)
-	CountByCustomerID(ctx context.Context, customerID int64, tx *sql.Tx) (int64, error)
-	FindManyByCustomerID(ctx context.Context, customerID int64, offset, limit int, tx *sql.Tx) ([]AcquiredTicket, error)
+	Save(ctx context.Context, aq AcquiredTicket) (int64, error)
+	SaveMany(ctx context.Context, aqs []AcquiredTicket) ([]int64, error)
+	CountByCustomerID(ctx context.Context, customerID int64) (int64, error)
+	FindManyByCustomerID(ctx context.Context, customerID int64, offset, limit int) ([]AcquiredTicket, error)
+	ReturnManyByOrderID(ctx context.Context, orderID, ticketStockID string, returnedAt time.Time) ([]AcquiredTicket, error)
 }
 
 type acquiredTicketRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewAcquiredTicketRepository(logger *logrus.Logger, db *sql.DB) AcquiredTicketRepository {
+func NewAcquiredTicketRepository(logger *logrus.Logger, db *pgxpool.Pool) AcquiredTicketRepository {
 	return &acquiredTicketRepository{
 		logger: logger,
 		db:     db,
@@ -29,64 +34,91 @@ func NewAcquiredTicketRepository(logger *logrus.Logger, db *sql.DB) AcquiredTick
 }
 
 // FindByCustomerID implements AcquiredTicketRepository.
-func (r *acquiredTicketRepository) CountByCustomerID(ctx context.Context, customerID int64, tx *sql.Tx) (int64, error) {
-	var cmd sqlCommand = r.db
+func (r *acquiredTicketRepository) CountByCustomerID(ctx context.Context, customerID int64) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
-	if tx != nil {
-		cmd = tx
-	}
-
-	query := `SELECT count(id) FROM acquired_ticket WHERE customer_id = $1`
-
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting acquired ticket's prorperties")
-	}
-	defer stmt.Close()
+	query := `SELECT count(id) FROM acquired_ticket WHERE customer_id = $1 AND returned_at IS NULL`
 
 	var count int64
-	row := stmt.QueryRowContext(ctx, customerID)
+	row := cmd.QueryRowContext(ctx, query, customerID)
 
-	err = row.Scan(&count)
+	err := row.Scan(&count)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting acquired ticket's prorperties for update")
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting acquired ticket's prorperties for update")
 	}
 	return count, nil
 }
 
 // FindByCustomerID implements AcquiredTicketRepository.
-func (r *acquiredTicketRepository) FindManyByCustomerID(ctx context.Context, customerID int64, offset, limit int, tx *sql.Tx) ([]AcquiredTicket, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *acquiredTicketRepository) FindManyByCustomerID(ctx context.Context, customerID int64, offset, limit int) ([]AcquiredTicket, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
 			id, "number", event_id, show_id, tier, ticket_stock_id, event_name, show_venue, show_type, show_country, show_city,
-			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id
+			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id, sequence, quantity, price, currency,
+			seat_id, seat_section, seat_row, seat_number
 		FROM acquired_ticket
 		WHERE
 			customer_id = $1
+			AND returned_at IS NULL
 		ORDER BY id DESC
 		OFFSET $2
 		LIMIT $3
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	rows, err := cmd.QueryContext(ctx, query, customerID, offset, limit)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of acquired ticket's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of acquired ticket's prorperties")
 	}
-	defer stmt.Close()
 
-	rows, err := stmt.QueryContext(ctx, customerID, offset, limit)
+	defer rows.Close()
+
+	var data = make([]AcquiredTicket, 0)
+	for rows.Next() {
+		var aq AcquiredTicket
+		err := rows.Scan(
+			&aq.ID, &aq.Number, &aq.EventID, &aq.ShowID, &aq.Tier, &aq.TicketStockID,
+			&aq.EventName, &aq.ShowVenue, &aq.ShowType, &aq.ShowCountry, &aq.ShowCity, &aq.ShowFormattedAddress,
+			&aq.ShowTime, &aq.CustomerName, &aq.CustomerEmail, &aq.CustomerID, &aq.CreatedAt, &aq.OrderID, &aq.Sequence, &aq.Quantity, &aq.Price.Amount, &aq.Price.Currency,
+			&aq.SeatID, &aq.SeatSection, &aq.SeatRow, &aq.SeatNumber,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+		}
+
+		data = append(data, aq)
+	}
+
+	return data, nil
+}
+
+// ReturnManyByOrderID implements AcquiredTicketRepository. The tickets of the order are returned once, the tickets
+// which are returned already and the comps which are issued from a hold are not returned.
+func (r *acquiredTicketRepository) ReturnManyByOrderID(ctx context.Context, orderID, ticketStockID string, returnedAt time.Time) ([]AcquiredTicket, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE acquired_ticket
+		SET returned_at = $3
+		WHERE
+			order_id = $1
+			AND ticket_stock_id = $2
+			AND ticket_hold_id IS NULL
+			AND returned_at IS NULL
+		RETURNING
+			id, "number", event_id, show_id, tier, ticket_stock_id, event_name, show_venue, show_type, show_country, show_city,
+			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id, sequence, quantity, price, currency,
+			seat_id, seat_section, seat_row, seat_number
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, orderID, ticketStockID, returnedAt)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of acquired ticket's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while returning bunch of acquired ticket's prorperties")
 	}
 
 	defer rows.Close()
@@ -97,57 +129,142 @@ func (r *acquiredTicketRepository) FindManyByCustomerID(ctx context.Context, cus
 		err := rows.Scan(
 			&aq.ID, &aq.Number, &aq.EventID, &aq.ShowID, &aq.Tier, &aq.TicketStockID,
 			&aq.EventName, &aq.ShowVenue, &aq.ShowType, &aq.ShowCountry, &aq.ShowCity, &aq.ShowFormattedAddress,
-			&aq.ShowTime, &aq.CustomerName, &aq.CustomerEmail, &aq.CustomerID, &aq.CreatedAt, &aq.OrderID,
+			&aq.ShowTime, &aq.CustomerName, &aq.CustomerEmail, &aq.CustomerID, &aq.CreatedAt, &aq.OrderID, &aq.Sequence, &aq.Quantity, &aq.Price.Amount, &aq.Price.Currency,
+			&aq.SeatID, &aq.SeatSection, &aq.SeatRow, &aq.SeatNumber,
 		)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while returning bunch of acquired ticket's prorperties")
 		}
 
 		data = append(data, aq)
 	}
 
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while returning bunch of acquired ticket's prorperties")
+	}
+
 	return data, nil
 }
 
 // Save implements AcquiredTicketRepository.
-func (r *acquiredTicketRepository) Save(ctx context.Context, aq AcquiredTicket, tx *sql.Tx) (int64, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *acquiredTicketRepository) Save(ctx context.Context, aq AcquiredTicket) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO acquired_ticket
 		(
 			"number", event_id, show_id, tier, ticket_stock_id, event_name, show_venue, show_type, show_country, show_city,
-			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id
+			show_formatted_address, show_time, customer_name, customer_email, customer_id, created_at, order_id, sequence, quantity, price, currency,
+			seat_id, seat_section, seat_row, seat_number
 		)
 		VALUES
 		(
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, $19, $20, $21, $22, $23, $24, $25
 		)
 		RETURNING id
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving acquired ticket's prorperties")
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, aq.Number, aq.EventID, aq.ShowID, aq.Tier, aq.TicketStockID,
+	row := cmd.QueryRowContext(ctx, query, aq.Number, aq.EventID, aq.ShowID, aq.Tier, aq.TicketStockID,
 		aq.EventName, aq.ShowVenue, aq.ShowType, aq.ShowCountry, aq.ShowCity, aq.ShowFormattedAddress,
-		aq.ShowTime, aq.CustomerName, aq.CustomerEmail, aq.CustomerID, aq.CreatedAt, aq.OrderID,
+		aq.ShowTime, aq.CustomerName, aq.CustomerEmail, aq.CustomerID, aq.CreatedAt, aq.OrderID, aq.Sequence, aq.Quantity, aq.Price.Amount, aq.Price.Currency,
+		aq.SeatID, aq.SeatSection, aq.SeatRow, aq.SeatNumber,
 	)
 	var ID int64
-	err = row.Scan(&ID)
+	err := row.Scan(&ID)
 	if err != nil {
+		if code, constraint, ok := postgresql.ConstraintViolation(err); ok {
+			switch {
+			case code == postgresql.UniqueViolation && constraint == "acquired_ticket_number_idx":
+				return 0, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketNumberExists, aq.Number))
+			case code == postgresql.UniqueViolation:
+				return 0, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketsIssued, aq.OrderID, aq.TicketStockID))
+			case code == postgresql.ForeignKeyViolation:
+				return 0, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), aq.TicketStockID))
+			}
+		}
+
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return 0, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving acquired ticket's prorperties")
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving acquired ticket's prorperties")
 	}
 
 	return ID, nil
 }
+
+// SaveMany implements AcquiredTicketRepository. The tickets are copied in one round trip, their ids are taken from the
+// sequence up front since a copy does not return them.
+func (r *acquiredTicketRepository) SaveMany(ctx context.Context, aqs []AcquiredTicket) ([]int64, error) {
+	if len(aqs) < 1 {
+		return []int64{}, nil
+	}
+
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `SELECT nextval(pg_get_serial_sequence('acquired_ticket', 'id')) FROM generate_series(1, $1)`
+
+	rows, err := cmd.QueryContext(ctx, query, len(aqs))
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	defer rows.Close()
+
+	IDs := make([]int64, 0, len(aqs))
+	for rows.Next() {
+		var ID int64
+		if err := rows.Scan(&ID); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+		}
+		IDs = append(IDs, ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	if len(IDs) != len(aqs) {
+		r.logger.WithContext(ctx).Errorf("%d ids are taken from the sequence for %d acquired tickets", len(IDs), len(aqs))
+		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	// the connection has to be free of the rows before the copy runs on it
+	rows.Close()
+
+	columns := []string{
+		"id", "number", "event_id", "show_id", "tier", "ticket_stock_id", "event_name", "show_venue", "show_type", "show_country", "show_city",
+		"show_formatted_address", "show_time", "customer_name", "customer_email", "customer_id", "created_at", "order_id", "sequence", "quantity", "price", "currency",
+		"seat_id", "seat_section", "seat_row", "seat_number",
+	}
+
+	data := make([][]interface{}, len(aqs))
+	for k, aq := range aqs {
+		data[k] = []interface{}{IDs[k], aq.Number, aq.EventID, aq.ShowID, aq.Tier, aq.TicketStockID,
+			aq.EventName, aq.ShowVenue, aq.ShowType, aq.ShowCountry, aq.ShowCity, aq.ShowFormattedAddress,
+			aq.ShowTime, aq.CustomerName, aq.CustomerEmail, aq.CustomerID, aq.CreatedAt, aq.OrderID, aq.Sequence, aq.Quantity, aq.Price.Amount, aq.Price.Currency,
+			aq.SeatID, aq.SeatSection, aq.SeatRow, aq.SeatNumber,
+		}
+	}
+
+	if _, err := postgresql.CopyFrom(ctx, r.db, "acquired_ticket", columns, data); err != nil {
+		if code, constraint, ok := postgresql.ConstraintViolation(err); ok {
+			switch {
+			case code == postgresql.UniqueViolation && constraint == "acquired_ticket_number_idx":
+				return nil, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketNumbersExist))
+			case code == postgresql.UniqueViolation:
+				return nil, errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.TicketsIssued, aqs[0].OrderID, aqs[0].TicketStockID))
+			case code == postgresql.ForeignKeyViolation:
+				return nil, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), aqs[0].TicketStockID))
+			}
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving bunch of acquired ticket's prorperties")
+	}
+
+	return IDs, nil
+}
@@ -0,0 +1,97 @@
+package ticket_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+func TestAcquiredTicketRepositorySaveMany(t *testing.T) {
+	db := openTestDatabase(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := ticket.NewAcquiredTicketRepository(logger, db)
+	tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+	ticketStockID := seedTicketStock(t, db, 10)
+	t.Cleanup(func() {
+		db.Exec(context.Background(), `DELETE FROM acquired_ticket WHERE ticket_stock_id = $1`, ticketStockID)
+	})
+
+	var eventID, showID string
+	err := db.QueryRow(context.Background(), `SELECT event_id, show_id FROM ticket_stock WHERE id = $1`, ticketStockID).Scan(&eventID, &showID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	newTickets := func(orderID string, quantity int) []ticket.AcquiredTicket {
+		aqs := make([]ticket.AcquiredTicket, quantity)
+		for k := range aqs {
+			aqs[k] = ticket.AcquiredTicket{
+				Number:        orderID + "-" + string(rune('A'+k)),
+				EventID:       eventID,
+				ShowID:        showID,
+				Tier:          "CAT1",
+				TicketStockID: ticketStockID,
+				EventName:     "Test",
+				ShowVenue:     "Test",
+				ShowType:      "LIVE",
+				ShowTime:      now,
+				CustomerName:  "Test",
+				CustomerEmail: "test@example.com",
+				CustomerID:    1,
+				CreatedAt:     now,
+				OrderID:       orderID,
+				Sequence:      int64(k + 1),
+				Quantity:      1,
+				Price:         money.MustParse("100000", "IDR"),
+			}
+		}
+
+		return aqs
+	}
+
+	t.Run("the tickets are copied within the transaction", func(t *testing.T) {
+		orderID := "ORDTEST" + ticketStockID
+
+		var IDs []int64
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			var err error
+			IDs, err = repo.SaveMany(ctx, newTickets(orderID, 3))
+			return err
+		})
+		require.NoError(t, err)
+		assert.Len(t, IDs, 3)
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(id) FROM acquired_ticket WHERE order_id = $1`, orderID).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("the tickets of an order are issued once", func(t *testing.T) {
+		orderID := "ORDTESTAGAIN" + ticketStockID
+
+		_, err := repo.SaveMany(context.Background(), newTickets(orderID, 2))
+		require.NoError(t, err)
+
+		aqs := newTickets(orderID, 2)
+		for k := range aqs {
+			aqs[k].Number = aqs[k].Number + "-AGAIN"
+		}
+
+		_, err = repo.SaveMany(context.Background(), aqs)
+		assert.True(t, errors.MatchStatus(err, status.ALREADY_EXIST))
+	})
+}
@@ -1,6 +1,20 @@
 package ticket
 
-import "time"
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/pricing"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+const (
+	PriceRuleTypePhase       string = "PHASE"
+	PriceRuleTypeSellThrough string = "SELL_THROUGH"
+	PriceRuleTypeOverride    string = "OVERRIDE"
+)
+
+// PriceQuoteDuration is how long a quoted price is honoured by the order flow.
+const PriceQuoteDuration = 10 * time.Minute
 
 type TicketStock struct {
 	EventID         string
@@ -9,11 +23,19 @@ type TicketStock struct {
 	OnlineFor       *string
 	Tier            string
 	Allocation      int64
-	Price           float64
+	Price           money.Money
 	Acquired        int64
+	Held            int64
+	Reserved        int64
 	LastStockUpdate time.Time
 }
 
+// Available returns the number of tickets which are left for the general sale, the tickets which are held back for
+// comps, sponsors and the box office and the tickets which are reserved for the waitlisted customers are not.
+func (ts TicketStock) Available() int64 {
+	return ts.Allocation - ts.Acquired - ts.Held - ts.Reserved
+}
+
 type AcquiredTicket struct {
 	ID                   int64
 	Number               string
@@ -33,4 +55,75 @@ type AcquiredTicket struct {
 	CustomerID           int64
 	CreatedAt            time.Time
 	OrderID              string
+	Sequence             int64
+	Quantity             int64
+	Price                money.Money
+	SeatID               *string
+	SeatSection          *string
+	SeatRow              *string
+	SeatNumber           *string
+}
+
+type PriceRule struct {
+	ID             string
+	EventID        string
+	ShowID         string
+	TicketStockID  string
+	Type           string
+	Name           string
+	Price          money.Money
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	SoldPercentage *float64
+	CreatedAt      time.Time
+}
+
+// ToPricingRule returns the rule which is evaluated by the pricing engine.
+func (r PriceRule) ToPricingRule() pricing.Rule {
+	switch r.Type {
+	case PriceRuleTypeSellThrough:
+		return pricing.SellThroughRule{Step: r.Name, Amount: r.Price, Percentage: *r.SoldPercentage}
+	case PriceRuleTypeOverride:
+		return pricing.OverrideRule{Reason: r.Name, Amount: r.Price, StartsAt: *r.StartsAt, EndsAt: r.EndsAt}
+	default:
+		return pricing.PhaseRule{Phase: r.Name, Amount: r.Price, StartsAt: *r.StartsAt, EndsAt: r.EndsAt}
+	}
+}
+
+// NewPricingEngine returns the pricing engine of the given rules.
+func NewPricingEngine(rules []PriceRule) *pricing.Engine {
+	pricingRules := make([]pricing.Rule, len(rules))
+	for k, v := range rules {
+		pricingRules[k] = v.ToPricingRule()
+	}
+
+	return pricing.New(pricingRules...)
+}
+
+// Quote returns the price of the ticket stock at the given time.
+func (ts TicketStock) Quote(rules []PriceRule, now time.Time) pricing.Quote {
+	return NewPricingEngine(rules).Quote(pricing.State{Now: now, BasePrice: ts.Price, Allocation: ts.Allocation, Sold: ts.Acquired})
+}
+
+// PriceChange records a change of the price of a ticket stock which takes effect from the given time.
+type PriceChange struct {
+	ID            int64
+	TicketStockID string
+	Price         money.Money
+	Rule          string
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
+
+// PriceQuote is a price of a ticket stock which is guaranteed to a customer until it expires.
+type PriceQuote struct {
+	ID            string
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	CustomerID    int64
+	Price         money.Money
+	Rule          string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
 }
@@ -0,0 +1,52 @@
+package ticket
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PriceChangeRepository interface {
+	Save(ctx context.Context, pc PriceChange) error
+}
+
+type priceChangeRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPriceChangeRepository(logger *logrus.Logger, db *pgxpool.Pool) PriceChangeRepository {
+	return &priceChangeRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements PriceChangeRepository.
+func (r *priceChangeRepository) Save(ctx context.Context, pc PriceChange) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_price_change
+		(
+			ticket_stock_id, price, currency, rule, effective_from, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, pc.TicketStockID, pc.Price.Amount, pc.Price.Currency, pc.Rule, pc.EffectiveFrom, pc.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket price change's prorperties")
+	}
+
+	return nil
+}
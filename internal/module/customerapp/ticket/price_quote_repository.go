@@ -0,0 +1,83 @@
+package ticket
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PriceQuoteRepository interface {
+	Save(ctx context.Context, pq PriceQuote) error
+	FindByID(ctx context.Context, ID string) (PriceQuote, error)
+}
+
+type priceQuoteRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPriceQuoteRepository(logger *logrus.Logger, db *pgxpool.Pool) PriceQuoteRepository {
+	return &priceQuoteRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Save implements PriceQuoteRepository.
+func (r *priceQuoteRepository) Save(ctx context.Context, pq PriceQuote) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO ticket_price_quote
+		(
+			id, event_id, show_id, ticket_stock_id, customer_id, price, currency, rule, expires_at, created_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := cmd.ExecContext(ctx, query, pq.ID, pq.EventID, pq.ShowID, pq.TicketStockID, pq.CustomerID, pq.Price.Amount, pq.Price.Currency, pq.Rule, pq.ExpiresAt, pq.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket price quote's prorperties")
+	}
+
+	return nil
+}
+
+// FindByID implements PriceQuoteRepository.
+func (r *priceQuoteRepository) FindByID(ctx context.Context, ID string) (PriceQuote, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, customer_id, price, currency, rule, expires_at, created_at
+		FROM ticket_price_quote
+		WHERE
+			id = $1
+		LIMIT 1
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data PriceQuote
+	err := row.Scan(&data.ID, &data.EventID, &data.ShowID, &data.TicketStockID, &data.CustomerID, &data.Price.Amount, &data.Price.Currency, &data.Rule, &data.ExpiresAt, &data.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PriceQuote{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket price quote"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return PriceQuote{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket price quote's prorperties")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,123 @@
+package ticket
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type PriceRuleRepository interface {
+	FindManyByShowID(ctx context.Context, showID string) ([]PriceRule, error)
+	FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceRule, error)
+}
+
+type priceRuleRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewPriceRuleRepository(logger *logrus.Logger, db *pgxpool.Pool) PriceRuleRepository {
+	return &priceRuleRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *priceRuleRepository) scan(row interface{ Scan(dest ...any) error }) (PriceRule, error) {
+	var pr PriceRule
+	var startsAt, endsAt sql.NullTime
+	var soldPercentage sql.NullFloat64
+
+	err := row.Scan(&pr.ID, &pr.EventID, &pr.ShowID, &pr.TicketStockID, &pr.Type, &pr.Name, &pr.Price.Amount, &pr.Price.Currency, &startsAt, &endsAt, &soldPercentage, &pr.CreatedAt)
+	if err != nil {
+		return PriceRule{}, err
+	}
+
+	if startsAt.Valid {
+		pr.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		pr.EndsAt = &endsAt.Time
+	}
+	if soldPercentage.Valid {
+		pr.SoldPercentage = &soldPercentage.Float64
+	}
+
+	return pr, nil
+}
+
+// FindManyByShowID implements PriceRuleRepository. The rules are ordered the way the pricing engine has to apply them.
+func (r *priceRuleRepository) FindManyByShowID(ctx context.Context, showID string) ([]PriceRule, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, price, currency, starts_at, ends_at, sold_percentage, created_at
+		FROM ticket_price_rule
+		WHERE
+			show_id = $1
+		ORDER BY starts_at ASC NULLS FIRST, sold_percentage ASC NULLS FIRST, created_at ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, showID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]PriceRule, 0)
+	for rows.Next() {
+		pr, err := r.scan(rows)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+		}
+
+		data = append(data, pr)
+	}
+
+	return data, nil
+}
+
+// FindManyByTicketStockID implements PriceRuleRepository. The rules are ordered the way the pricing engine has to apply them.
+func (r *priceRuleRepository) FindManyByTicketStockID(ctx context.Context, ticketStockID string) ([]PriceRule, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			id, event_id, show_id, ticket_stock_id, type, name, price, currency, starts_at, ends_at, sold_percentage, created_at
+		FROM ticket_price_rule
+		WHERE
+			ticket_stock_id = $1
+		ORDER BY starts_at ASC NULLS FIRST, sold_percentage ASC NULLS FIRST, created_at ASC
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, ticketStockID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]PriceRule, 0)
+	for rows.Next() {
+		pr, err := r.scan(rows)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket price rule's prorperties")
+		}
+
+		data = append(data, pr)
+	}
+
+	return data, nil
+}
@@ -4,135 +4,225 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
 type TicketStockRepository interface {
-	FindManyByShowID(ctx context.Context, showID string, tx *sql.Tx) ([]TicketStock, error)
-	FindByIDForUpdate(ctx context.Context, ID string, tx *sql.Tx) (TicketStock, error)
-	Update(ctx context.Context, ID string, ts TicketStock, tx *sql.Tx) error
-}
-
-type sqlCommand interface {
-	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
-	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
-	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	FindManyByShowID(ctx context.Context, showID string) ([]TicketStock, error)
+	FindByID(ctx context.Context, ID string) (TicketStock, error)
+	FindByIDForUpdate(ctx context.Context, ID string) (TicketStock, error)
+	Update(ctx context.Context, ID string, ts TicketStock) error
+	Acquire(ctx context.Context, ID string, quantity int64, reserved int64, lastStockUpdate time.Time) (TicketStock, error)
+	Return(ctx context.Context, ID string, quantity int64, lastStockUpdate time.Time) (TicketStock, error)
 }
 
 type ticketStockRepository struct {
 	logger *logrus.Logger
-	db     *sql.DB
+	db     *pgxpool.Pool
 }
 
-func NewTicketStockRepository(logger *logrus.Logger, db *sql.DB) TicketStockRepository {
+func NewTicketStockRepository(logger *logrus.Logger, db *pgxpool.Pool) TicketStockRepository {
 	return &ticketStockRepository{
 		logger: logger,
 		db:     db,
 	}
 }
 
-// FindByIDForUpdate implements TicketStockRepository.
-func (r *ticketStockRepository) FindByIDForUpdate(ctx context.Context, ID string, tx *sql.Tx) (TicketStock, error) {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+// FindByID implements TicketStockRepository.
+func (r *ticketStockRepository) FindByID(ctx context.Context, ID string) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		SELECT 
-			id, tier, allocation, price, acquired, last_stock_update, online_for, show_id, event_id
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
 		FROM ticket_stock
 		WHERE
 			id = $1
-		FOR UPDATE
+		LIMIT 1
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	row := cmd.QueryRowContext(ctx, query, ID)
+
+	var data TicketStock
+	var onlineFor sql.NullString
+
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), ID))
+		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return TicketStock{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties for update")
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties")
 	}
-	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, ID)
+	if onlineFor.Valid {
+		data.OnlineFor = &onlineFor.String
+	}
+
+	return data, nil
+}
+
+// FindByIDForUpdate implements TicketStockRepository.
+func (r *ticketStockRepository) FindByIDForUpdate(ctx context.Context, ID string) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT 
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+		FROM ticket_stock
+		WHERE
+			id = $1
+		FOR UPDATE
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID)
 
 	var data TicketStock
 	var onlineFor sql.NullString
 
-	err = row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price, &data.Acquired, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return TicketStock{}, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties for update")
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting ticket stock's prorperties for update")
 	}
 	return data, nil
 }
 
 // Update implements TicketStockRepository.
-func (r *ticketStockRepository) Update(ctx context.Context, ID string, ts TicketStock, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *ticketStockRepository) Update(ctx context.Context, ID string, ts TicketStock) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		UPDATE ticket_stock
 		SET
 			acquired = $1,
-			last_stock_update = $2
+			reserved = $2,
+			last_stock_update = $3
 		WHERE 
-			id = $3
+			id = $4
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	_, err := cmd.ExecContext(ctx, query, ts.Acquired, ts.Reserved, ts.LastStockUpdate, ID)
 	if err != nil {
+		if code, _, ok := postgresql.ConstraintViolation(err); ok && code == postgresql.CheckViolation {
+			return errors.New(http.StatusConflict, status.SOLD_OUT, i18n.Message(ctx, i18n.SoldOut, ID))
+		}
+
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")
 	}
-	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, ts.Acquired, ts.LastStockUpdate, ID)
+	return nil
+}
+
+// Acquire implements TicketStockRepository. The tickets are acquired in a single conditional update which never lets
+// the acquired, held and reserved tickets exceed the allocation, whatever the number of concurrent orders. The
+// reserved tickets are the ones which were reserved for the customer, e.g. by a waitlist offer, they are released
+// along.
+func (r *ticketStockRepository) Acquire(ctx context.Context, ID string, quantity int64, reserved int64, lastStockUpdate time.Time) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE ticket_stock
+		SET
+			acquired = acquired + $2,
+			reserved = reserved - $3,
+			last_stock_update = $4
+		WHERE
+			id = $1
+			AND acquired + held + reserved - $3 + $2 <= allocation
+		RETURNING
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID, quantity, reserved, lastStockUpdate)
+
+	var data TicketStock
+	var onlineFor sql.NullString
+
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			if _, err := r.FindByID(ctx, ID); err != nil {
+				return TicketStock{}, err
+			}
+			return TicketStock{}, errors.New(http.StatusConflict, status.SOLD_OUT, i18n.Message(ctx, i18n.SoldOut, ID))
+		}
+		if code, _, ok := postgresql.ConstraintViolation(err); ok && code == postgresql.CheckViolation {
+			return TicketStock{}, errors.New(http.StatusConflict, status.SOLD_OUT, i18n.Message(ctx, i18n.SoldOut, ID))
+		}
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while acquiring ticket stock's prorperties")
 	}
 
-	return nil
+	if onlineFor.Valid {
+		data.OnlineFor = &onlineFor.String
+	}
+
+	return data, nil
 }
 
-// FindManyByShowID implements TicketStockRepository.
-func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID string, tx *sql.Tx) ([]TicketStock, error) {
-	var cmd sqlCommand = r.db
+// Return implements TicketStockRepository. The returned tickets are no longer acquired, they are left for the general
+// sale or for the waitlist.
+func (r *ticketStockRepository) Return(ctx context.Context, ID string, quantity int64, lastStockUpdate time.Time) (TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE ticket_stock
+		SET
+			acquired = GREATEST(acquired - $2, 0),
+			last_stock_update = $3
+		WHERE
+			id = $1
+		RETURNING
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
+	`
+
+	row := cmd.QueryRowContext(ctx, query, ID, quantity, lastStockUpdate)
+
+	var data TicketStock
+	var onlineFor sql.NullString
 
-	if tx != nil {
-		cmd = tx
+	err := row.Scan(&data.ID, &data.Tier, &data.Allocation, &data.Price.Amount, &data.Price.Currency, &data.Acquired, &data.Held, &data.Reserved, &data.LastStockUpdate, &onlineFor, &data.ShowID, &data.EventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TicketStock{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), ID))
+		}
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return TicketStock{}, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while returning ticket stock's prorperties")
+	}
+
+	if onlineFor.Valid {
+		data.OnlineFor = &onlineFor.String
 	}
 
+	return data, nil
+}
+
+// FindManyByShowID implements TicketStockRepository.
+func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID string) ([]TicketStock, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
 	query := `
 		SELECT 
-			id, tier, allocation, price, acquired, last_stock_update, online_for, show_id, event_id
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
 		FROM ticket_stock
 		WHERE
 			show_id = $1
 		ORDER BY price ASC
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
+	rows, err := cmd.QueryContext(ctx, query, showID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, showID)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of ticket stock's prorperties")
 	}
 
 	defer rows.Close()
@@ -141,10 +231,10 @@ func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID str
 	for rows.Next() {
 		var ts TicketStock
 		var onlineFor sql.NullString
-		err := rows.Scan(&ts.ID, &ts.Tier, &ts.Allocation, &ts.Price, &ts.Acquired, &ts.LastStockUpdate, &onlineFor, &ts.ShowID, &ts.EventID)
+		err := rows.Scan(&ts.ID, &ts.Tier, &ts.Allocation, &ts.Price.Amount, &ts.Price.Currency, &ts.Acquired, &ts.Held, &ts.Reserved, &ts.LastStockUpdate, &onlineFor, &ts.ShowID, &ts.EventID)
 		if err != nil {
 			r.logger.WithContext(ctx).WithError(err).Error()
-			return nil, errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of order rule day's prorperties")
 		}
 
 		if onlineFor.Valid {
@@ -158,41 +248,30 @@ func (r *ticketStockRepository) FindManyByShowID(ctx context.Context, showID str
 }
 
 // Save implements TicketStockRepository.
-func (r *ticketStockRepository) Save(ctx context.Context, ts TicketStock, tx *sql.Tx) error {
-	var cmd sqlCommand = r.db
-
-	if tx != nil {
-		cmd = tx
-	}
+func (r *ticketStockRepository) Save(ctx context.Context, ts TicketStock) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
 
 	query := `
 		INSERT INTO ticket_stock
 		(
-			id, tier, allocation, price, acquired, last_stock_update, online_for, show_id, event_id
+			id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, online_for, show_id, event_id
 		)
 		VALUES
 		(
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 		)
 	`
 
-	stmt, err := cmd.PrepareContext(ctx, query)
-	if err != nil {
-		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
-	}
-	defer stmt.Close()
-
 	var onlineFor sql.NullString
 	if ts.OnlineFor != nil {
 		onlineFor.Valid = true
 		onlineFor.String = *ts.OnlineFor
 	}
 
-	_, err = stmt.ExecContext(ctx, ts.ID, ts.Tier, ts.Allocation, ts.Price, ts.Acquired, ts.LastStockUpdate, onlineFor, ts.ShowID, ts.EventID)
+	_, err := cmd.ExecContext(ctx, query, ts.ID, ts.Tier, ts.Allocation, ts.Price.Amount, ts.Price.Currency, ts.Acquired, ts.Held, ts.Reserved, ts.LastStockUpdate, onlineFor, ts.ShowID, ts.EventID)
 	if err != nil {
 		r.logger.WithContext(ctx).WithError(err).Error()
-		return errors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving ticket stock's prorperties")
 	}
 
 	return nil
@@ -0,0 +1,139 @@
+package ticket_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/migrations"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// openTestDatabase connects to the Postgres given by POSTGRESQL_TEST_DSN, e.g. a throwaway container, and applies the
+// migrations. The test is skipped when it is not set.
+func openTestDatabase(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRESQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRESQL_TEST_DSN is not set")
+	}
+
+	db, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ms, err := migration.Load(migrations.FS, migrations.Prepare)
+	require.NoError(t, err)
+
+	_, err = migration.NewMigrator(db, ms).Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func seedTicketStock(t *testing.T, db *pgxpool.Pool, allocation int64) string {
+	ctx := context.Background()
+	now := time.Now()
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+
+	eventID, showID, ticketStockID := "EVTTEST"+suffix, "SHOWTEST"+suffix, "TSTEST"+suffix
+
+	_, err := db.Exec(ctx, `INSERT INTO event (id, name, description, status, currency, created_at, updated_at) VALUES ($1, 'Test', '', 'ACTIVE', 'IDR', $2, $2)`, eventID, now)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO event_show (event_id, id, venue, type, time, status, currency) VALUES ($1, $2, 'Test', 'LIVE', $3, 'ACTIVE', 'IDR')`, eventID, showID, now)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO ticket_stock (id, tier, allocation, price, currency, acquired, held, reserved, last_stock_update, show_id, event_id) VALUES ($1, 'CAT1', $2, 100000, 'IDR', 0, 0, 0, $3, $4, $5)`, ticketStockID, allocation, now, showID, eventID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM ticket_stock WHERE id = $1`, ticketStockID)
+		db.Exec(ctx, `DELETE FROM event_show WHERE id = $1`, showID)
+		db.Exec(ctx, `DELETE FROM event WHERE id = $1`, eventID)
+	})
+
+	return ticketStockID
+}
+
+func TestTicketStockRepositoryAcquire(t *testing.T) {
+	db := openTestDatabase(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repo := ticket.NewTicketStockRepository(logger, db)
+	tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+	t.Run("concurrent orders never acquire more tickets than the allocation", func(t *testing.T) {
+		const allocation, orders = 10, 50
+
+		ticketStockID := seedTicketStock(t, db, allocation)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		acquired, soldOut := 0, 0
+
+		for i := 0; i < orders; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+					_, err := repo.Acquire(ctx, ticketStockID, 1, 0, time.Now())
+					return err
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch {
+				case err == nil:
+					acquired++
+				case errors.MatchStatus(err, status.SOLD_OUT):
+					soldOut++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		ts, err := repo.FindByID(context.Background(), ticketStockID)
+		require.NoError(t, err)
+
+		assert.Equal(t, allocation, acquired)
+		assert.Equal(t, orders-allocation, soldOut)
+		assert.Equal(t, int64(allocation), ts.Acquired)
+	})
+
+	t.Run("tickets reserved for the customer are acquired along", func(t *testing.T) {
+		ticketStockID := seedTicketStock(t, db, 2)
+
+		_, err := db.Exec(context.Background(), `UPDATE ticket_stock SET reserved = 2 WHERE id = $1`, ticketStockID)
+		require.NoError(t, err)
+
+		_, err = repo.Acquire(context.Background(), ticketStockID, 1, 0, time.Now())
+		assert.True(t, errors.MatchStatus(err, status.SOLD_OUT))
+
+		ts, err := repo.Acquire(context.Background(), ticketStockID, 2, 2, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), ts.Acquired)
+		assert.Equal(t, int64(0), ts.Reserved)
+	})
+
+	t.Run("an unknown ticket stock is not found", func(t *testing.T) {
+		_, err := repo.Acquire(context.Background(), "TSUNKNOWN", 1, 0, time.Now())
+		assert.True(t, errors.MatchStatus(err, status.NOT_FOUND))
+	})
+}
@@ -0,0 +1,36 @@
+package waitlist
+
+import "time"
+
+const (
+	WaitlistEntryStatusWaiting   string = "WAITING"
+	WaitlistEntryStatusOffered   string = "OFFERED"
+	WaitlistEntryStatusPurchased string = "PURCHASED"
+	WaitlistEntryStatusExpired   string = "EXPIRED"
+	WaitlistEntryStatusLeft      string = "LEFT"
+)
+
+// OfferDuration is how long the tickets offered to a waitlisted customer are reserved for the customer.
+const OfferDuration = 30 * time.Minute
+
+// OfferCheckInterval is how often the expired offers and the returned tickets are looked up to offer them to the next
+// waitlisted customers.
+const OfferCheckInterval = time.Minute
+
+// WaitlistEntry is a customer waiting for the given quantity of tickets of a sold-out ticket stock. The position is
+// only known while the customer is waiting.
+type WaitlistEntry struct {
+	ID            string
+	EventID       string
+	ShowID        string
+	TicketStockID string
+	Tier          string
+	CustomerID    int64
+	Quantity      int64
+	Status        string
+	Position      int64
+	OfferedUntil  *time.Time
+	NotifiedAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,179 @@
+package waitlist
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	publicMiddleware "github.com/tsel-ticketmaster/tm-event/pkg/middleware"
+	"github.com/tsel-ticketmaster/tm-event/pkg/response"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type HTTPHandler struct {
+	SessionMiddleware *middleware.CustomerSession
+	Validate          *validator.Validate
+	WaitlistUseCase   WaitlistUseCase
+}
+
+func InitHTTPHandler(router *mux.Router, customerSession *middleware.CustomerSession, validate *validator.Validate, waitlistUseCase WaitlistUseCase) {
+	handler := &HTTPHandler{
+		Validate:        validate,
+		WaitlistUseCase: waitlistUseCase,
+	}
+
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/waitlist", publicMiddleware.SetRouteChain(handler.JoinWaitlist, customerSession.Verify)).Methods(http.MethodPost)
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/waitlist", publicMiddleware.SetRouteChain(handler.GetWaitlistEntry, customerSession.Verify)).Methods(http.MethodGet)
+	router.HandleFunc("/tm-event/v1/customerapp/events/{eventID}/shows/{showID}/tickets/{ticketStockID}/waitlist", publicMiddleware.SetRouteChain(handler.LeaveWaitlist, customerSession.Verify)).Methods(http.MethodDelete)
+	router.HandleFunc("/tm-event/v1/customerapp/waitlist-entries", publicMiddleware.SetRouteChain(handler.GetManyWaitlistEntry, customerSession.Verify)).Methods(http.MethodGet)
+}
+
+func (handler HTTPHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := JoinWaitlistRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, response.RESTEnvelope{
+			Status:  status.UNPROCESSABLE_ENTITY,
+			Message: err.Error(),
+		})
+
+		return
+	}
+	req.EventID = vars["eventID"]
+	req.ShowID = vars["showID"]
+	req.TicketStockID = vars["ticketStockID"]
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.WaitlistUseCase.JoinWaitlist(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusCreated, response.RESTEnvelope{
+		Status:  status.CREATED,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("waitlist"), i18n.Noun("joined")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := GetWaitlistEntryRequest{
+		EventID:       vars["eventID"],
+		ShowID:        vars["showID"],
+		TicketStockID: vars["ticketStockID"],
+	}
+
+	resp, err := handler.WaitlistUseCase.GetWaitlistEntry(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Data, i18n.Noun("waitlist entry")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	req := LeaveWaitlistRequest{
+		EventID:       vars["eventID"],
+		ShowID:        vars["showID"],
+		TicketStockID: vars["ticketStockID"],
+	}
+
+	if err := handler.WaitlistUseCase.LeaveWaitlist(ctx, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.Done, i18n.Noun("waitlist"), i18n.Noun("left")),
+		Data:    nil,
+		Meta:    nil,
+	})
+}
+
+func (handler HTTPHandler) GetManyWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := GetManyWaitlistEntryRequest{}
+
+	qs := r.URL.Query()
+
+	req.Page, _ = strconv.Atoi(qs.Get("page"))
+	req.Size, _ = strconv.Atoi(qs.Get("size"))
+
+	if err := validation.Struct(ctx, handler.Validate, req); err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+
+	resp, err := handler.WaitlistUseCase.GetManyWaitlistEntry(ctx, req)
+	if err != nil {
+		ae := errors.Destruct(err)
+		response.JSON(w, ae.HTTPStatusCode, response.RESTEnvelope{
+			Status:  ae.Status,
+			Message: ae.Message,
+			Errors:  ae.Errors,
+		})
+
+		return
+	}
+	response.JSON(w, http.StatusOK, response.RESTEnvelope{
+		Status:  status.OK,
+		Message: i18n.Message(ctx, i18n.ListOf, i18n.Noun("waitlist entry")),
+		Data:    resp,
+		Meta:    nil,
+	})
+}
@@ -0,0 +1,168 @@
+package waitlist
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+)
+
+// OfferTopic is the topic which the notification service consumes to alert the customers of their offers.
+const OfferTopic = "waitlist-offer"
+
+// Offerer offers the tickets which are back on the general sale to the waiting customers. The tickets come back when an
+// allocation is raised, a ticket hold is released, a customer leaves the waitlist, an offer expires or a paid order is
+// refunded or cancelled.
+type Offerer interface {
+	OfferReturnedStock(ctx context.Context, ticketStockID string) error
+	OfferPendingStock(ctx context.Context, now time.Time) error
+}
+
+type offerer struct {
+	logger                  *logrus.Logger
+	waitlistEntryRepository WaitlistEntryRepository
+	ticketStockRepository   ticket.TicketStockRepository
+	publisher               pubsub.Publisher
+	txManager               postgresql.TxManager
+}
+
+type OffererProperty struct {
+	Logger                  *logrus.Logger
+	WaitlistEntryRepository WaitlistEntryRepository
+	TicketStockRepository   ticket.TicketStockRepository
+	Publisher               pubsub.Publisher
+	TxManager               postgresql.TxManager
+}
+
+func NewOfferer(props OffererProperty) Offerer {
+	return &offerer{
+		logger:                  props.Logger,
+		waitlistEntryRepository: props.WaitlistEntryRepository,
+		ticketStockRepository:   props.TicketStockRepository,
+		publisher:               props.Publisher,
+		txManager:               props.TxManager,
+	}
+}
+
+// OfferReturnedStock implements Offerer. The expired offers of the ticket stock are released first, then the available
+// tickets are reserved for the waiting customers by their position. A customer who waits for more tickets than are
+// available keeps the position, the customers behind are not offered either. The offers are published to their
+// customers once they are made.
+func (o *offerer) OfferReturnedStock(ctx context.Context, ticketStockID string) error {
+	err := o.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ts, err := o.ticketStockRepository.FindByIDForUpdate(ctx, ticketStockID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		expired, err := o.waitlistEntryRepository.FindManyExpiredOfferForUpdate(ctx, ts.ID, now)
+		if err != nil {
+			return err
+		}
+
+		for _, we := range expired {
+			ts.Reserved = ts.Reserved - we.Quantity
+
+			we.Status = WaitlistEntryStatusExpired
+			we.UpdatedAt = now
+
+			if err := o.waitlistEntryRepository.Update(ctx, we); err != nil {
+				return err
+			}
+		}
+
+		available := ts.Available()
+
+		var waiting []WaitlistEntry
+		if available > 0 {
+			waiting, err = o.waitlistEntryRepository.FindManyWaitingForUpdate(ctx, ts.ID, available)
+			if err != nil {
+				return err
+			}
+		}
+
+		var offered int
+		for _, we := range waiting {
+			if we.Quantity > available {
+				break
+			}
+
+			offeredUntil := now.Add(OfferDuration)
+
+			we.Status = WaitlistEntryStatusOffered
+			we.Position = 0
+			we.OfferedUntil = &offeredUntil
+			we.UpdatedAt = now
+
+			if err := o.waitlistEntryRepository.Update(ctx, we); err != nil {
+				return err
+			}
+
+			available = available - we.Quantity
+			ts.Reserved = ts.Reserved + we.Quantity
+			offered++
+		}
+
+		if len(expired) < 1 && offered < 1 {
+			return nil
+		}
+
+		ts.LastStockUpdate = now
+
+		return o.ticketStockRepository.Update(ctx, ts.ID, ts)
+	})
+	if err != nil {
+		return err
+	}
+
+	return o.notifyOffers(ctx, ticketStockID)
+}
+
+// notifyOffers publishes the offers of the ticket stock which have not been published to their customers, an offer
+// which could not be published is left unnotified so the scheduler publishes it again.
+func (o *offerer) notifyOffers(ctx context.Context, ticketStockID string) error {
+	now := time.Now()
+
+	offers, err := o.waitlistEntryRepository.FindManyUnnotifiedOffer(ctx, ticketStockID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, we := range offers {
+		weBuff, _ := json.Marshal(we)
+
+		if err := o.publisher.Publish(ctx, OfferTopic, we.ID, nil, weBuff); err != nil {
+			o.logger.WithContext(ctx).WithError(err).WithField("waitlist_entry_id", we.ID).Error()
+			continue
+		}
+
+		if err := o.waitlistEntryRepository.MarkNotified(ctx, we.ID, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OfferPendingStock implements Offerer. It offers the tickets of every ticket stock which has expired offers or
+// available tickets while customers are waiting, e.g. the tickets which were returned while an offer failed.
+func (o *offerer) OfferPendingStock(ctx context.Context, now time.Time) error {
+	ticketStockIDs, err := o.waitlistEntryRepository.FindManyTicketStockIDToOffer(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, ticketStockID := range ticketStockIDs {
+		if err := o.OfferReturnedStock(ctx, ticketStockID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
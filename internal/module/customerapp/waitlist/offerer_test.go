@@ -0,0 +1,186 @@
+package waitlist_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/waitlist"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/pubsub"
+)
+
+// The fakes embed the interfaces they stand in for, a method which the offerer is not expected to call panics.
+
+// waitlistEntryStore keeps the entries of a single ticket stock in the order the customers joined.
+type waitlistEntryStore struct {
+	waitlist.WaitlistEntryRepository
+	entries []waitlist.WaitlistEntry
+}
+
+func (s *waitlistEntryStore) FindManyExpiredOfferForUpdate(ctx context.Context, ticketStockID string, now time.Time) ([]waitlist.WaitlistEntry, error) {
+	var data []waitlist.WaitlistEntry
+	for _, we := range s.entries {
+		if we.Status == waitlist.WaitlistEntryStatusOffered && !we.OfferedUntil.After(now) {
+			data = append(data, we)
+		}
+	}
+
+	return data, nil
+}
+
+func (s *waitlistEntryStore) FindManyWaitingForUpdate(ctx context.Context, ticketStockID string, limit int64) ([]waitlist.WaitlistEntry, error) {
+	var data []waitlist.WaitlistEntry
+	for _, we := range s.entries {
+		if we.Status == waitlist.WaitlistEntryStatusWaiting && int64(len(data)) < limit {
+			data = append(data, we)
+		}
+	}
+
+	return data, nil
+}
+
+func (s *waitlistEntryStore) Update(ctx context.Context, we waitlist.WaitlistEntry) error {
+	for k := range s.entries {
+		if s.entries[k].ID == we.ID {
+			notifiedAt := s.entries[k].NotifiedAt
+			s.entries[k] = we
+			s.entries[k].NotifiedAt = notifiedAt
+		}
+	}
+
+	return nil
+}
+
+func (s *waitlistEntryStore) FindManyUnnotifiedOffer(ctx context.Context, ticketStockID string, now time.Time) ([]waitlist.WaitlistEntry, error) {
+	var data []waitlist.WaitlistEntry
+	for _, we := range s.entries {
+		if we.Status == waitlist.WaitlistEntryStatusOffered && we.NotifiedAt == nil && we.OfferedUntil.After(now) {
+			data = append(data, we)
+		}
+	}
+
+	return data, nil
+}
+
+func (s *waitlistEntryStore) MarkNotified(ctx context.Context, ID string, now time.Time) error {
+	for k := range s.entries {
+		if s.entries[k].ID == ID {
+			s.entries[k].NotifiedAt = &now
+		}
+	}
+
+	return nil
+}
+
+func (s *waitlistEntryStore) FindManyTicketStockIDToOffer(ctx context.Context, now time.Time) ([]string, error) {
+	unnotified, _ := s.FindManyUnnotifiedOffer(ctx, "", now)
+	if len(unnotified) > 0 {
+		return []string{"TS1"}, nil
+	}
+
+	return nil, nil
+}
+
+type ticketStockStore struct {
+	ticket.TicketStockRepository
+	ts ticket.TicketStock
+}
+
+func (s *ticketStockStore) FindByIDForUpdate(ctx context.Context, ID string) (ticket.TicketStock, error) {
+	return s.ts, nil
+}
+
+func (s *ticketStockStore) Update(ctx context.Context, ID string, ts ticket.TicketStock) error {
+	s.ts = ts
+	return nil
+}
+
+type publisher struct {
+	err      error
+	messages [][]byte
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, key string, headers pubsub.MessageHeaders, message []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *publisher) Close() error {
+	return nil
+}
+
+type txManager struct {
+	postgresql.TxManager
+}
+
+func (txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestOfferer(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	newOfferer := func(entries *waitlistEntryStore, stocks *ticketStockStore, pub *publisher) waitlist.Offerer {
+		return waitlist.NewOfferer(waitlist.OffererProperty{
+			Logger:                  logger,
+			WaitlistEntryRepository: entries,
+			TicketStockRepository:   stocks,
+			Publisher:               pub,
+			TxManager:               txManager{},
+		})
+	}
+
+	newEntries := func() *waitlistEntryStore {
+		return &waitlistEntryStore{entries: []waitlist.WaitlistEntry{
+			{ID: "WAIT1", TicketStockID: "TS1", CustomerID: 1, Quantity: 2, Status: waitlist.WaitlistEntryStatusWaiting},
+			{ID: "WAIT2", TicketStockID: "TS1", CustomerID: 2, Quantity: 2, Status: waitlist.WaitlistEntryStatusWaiting},
+		}}
+	}
+
+	t.Run("the returned tickets are reserved for the waiting customers and published to them", func(t *testing.T) {
+		entries := newEntries()
+		stocks := &ticketStockStore{ts: ticket.TicketStock{ID: "TS1", Allocation: 10, Acquired: 7}}
+		pub := &publisher{}
+
+		require.NoError(t, newOfferer(entries, stocks, pub).OfferReturnedStock(context.Background(), "TS1"))
+
+		assert.Equal(t, waitlist.WaitlistEntryStatusOffered, entries.entries[0].Status)
+		assert.NotNil(t, entries.entries[0].NotifiedAt)
+		assert.Equal(t, waitlist.WaitlistEntryStatusWaiting, entries.entries[1].Status)
+		assert.Equal(t, int64(2), stocks.ts.Reserved)
+		assert.Len(t, pub.messages, 1)
+	})
+
+	t.Run("an offer which could not be published is published by the next check", func(t *testing.T) {
+		entries := newEntries()
+		stocks := &ticketStockStore{ts: ticket.TicketStock{ID: "TS1", Allocation: 10, Acquired: 6}}
+		pub := &publisher{err: fmt.Errorf("broker is down")}
+		o := newOfferer(entries, stocks, pub)
+
+		require.NoError(t, o.OfferReturnedStock(context.Background(), "TS1"))
+
+		assert.Equal(t, waitlist.WaitlistEntryStatusOffered, entries.entries[0].Status)
+		assert.Nil(t, entries.entries[0].NotifiedAt)
+		assert.Nil(t, entries.entries[1].NotifiedAt)
+
+		pub.err = nil
+		require.NoError(t, o.OfferPendingStock(context.Background(), time.Now()))
+
+		assert.NotNil(t, entries.entries[0].NotifiedAt)
+		assert.NotNil(t, entries.entries[1].NotifiedAt)
+		assert.Equal(t, int64(4), stocks.ts.Reserved)
+		assert.Len(t, pub.messages, 2)
+	})
+}
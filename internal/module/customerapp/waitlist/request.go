@@ -0,0 +1,46 @@
+package waitlist
+
+import (
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+type JoinWaitlistRequest struct {
+	EventID       string `json:"-"`
+	ShowID        string `json:"-"`
+	TicketStockID string `json:"-"`
+	Quantity      int64  `json:"quantity" validate:"required,gt=0,lte=10"`
+}
+
+func (r JoinWaitlistRequest) ToEntityWaitlistEntry(customerID int64, tier string, now time.Time) WaitlistEntry {
+	return WaitlistEntry{
+		ID:            util.GenerateTimestampWithPrefix("WAIT"),
+		EventID:       r.EventID,
+		ShowID:        r.ShowID,
+		TicketStockID: r.TicketStockID,
+		Tier:          tier,
+		CustomerID:    customerID,
+		Quantity:      r.Quantity,
+		Status:        WaitlistEntryStatusWaiting,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+type GetWaitlistEntryRequest struct {
+	EventID       string
+	ShowID        string
+	TicketStockID string
+}
+
+type LeaveWaitlistRequest struct {
+	EventID       string
+	ShowID        string
+	TicketStockID string
+}
+
+type GetManyWaitlistEntryRequest struct {
+	Page int `validate:"required"`
+	Size int `validate:"required"`
+}
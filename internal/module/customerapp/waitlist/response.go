@@ -0,0 +1,40 @@
+package waitlist
+
+import "time"
+
+type WaitlistEntryResponse struct {
+	ID            string     `json:"id"`
+	EventID       string     `json:"event_id"`
+	ShowID        string     `json:"show_id"`
+	TicketStockID string     `json:"ticket_stock_id"`
+	Tier          string     `json:"tier"`
+	Quantity      int64      `json:"quantity"`
+	Status        string     `json:"status"`
+	Position      *int64     `json:"position,omitempty"`
+	OfferedUntil  *time.Time `json:"offered_until,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (r *WaitlistEntryResponse) PopulateFromEntity(we WaitlistEntry) {
+	r.ID = we.ID
+	r.EventID = we.EventID
+	r.ShowID = we.ShowID
+	r.TicketStockID = we.TicketStockID
+	r.Tier = we.Tier
+	r.Quantity = we.Quantity
+	r.Status = we.Status
+	r.OfferedUntil = we.OfferedUntil
+	r.CreatedAt = we.CreatedAt
+	r.UpdatedAt = we.UpdatedAt
+
+	if we.Status == WaitlistEntryStatusWaiting {
+		position := we.Position
+		r.Position = &position
+	}
+}
+
+type GetManyWaitlistEntryResponse struct {
+	Total           int64                   `json:"total"`
+	WaitlistEntries []WaitlistEntryResponse `json:"waitlist_entries"`
+}
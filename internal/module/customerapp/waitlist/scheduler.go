@@ -0,0 +1,75 @@
+package waitlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// OfferScheduler periodically offers the tickets of the expired offers and the returned tickets to the waitlists.
+type OfferScheduler interface {
+	Start()
+	Stop()
+}
+
+type offerScheduler struct {
+	logger   *logrus.Logger
+	offerer  Offerer
+	interval time.Duration
+	timeout  time.Duration
+	done     chan struct{}
+	stopped  chan struct{}
+}
+
+type OfferSchedulerProperty struct {
+	Logger   *logrus.Logger
+	Offerer  Offerer
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+func NewOfferScheduler(props OfferSchedulerProperty) OfferScheduler {
+	return &offerScheduler{
+		logger:   props.Logger,
+		offerer:  props.Offerer,
+		interval: props.Interval,
+		timeout:  props.Timeout,
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Start implements OfferScheduler. The waitlists are checked in the background until the scheduler is stopped.
+func (s *offerScheduler) Start() {
+	go func() {
+		defer close(s.stopped)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.done:
+				return
+			case now := <-ticker.C:
+				s.check(now)
+			}
+		}
+	}()
+}
+
+func (s *offerScheduler) check(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	if err := s.offerer.OfferPendingStock(ctx, now); err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error()
+	}
+}
+
+// Stop implements OfferScheduler. It waits for the running check to finish.
+func (s *offerScheduler) Stop() {
+	close(s.done)
+	<-s.stopped
+}
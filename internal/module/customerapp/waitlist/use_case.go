@@ -0,0 +1,228 @@
+package waitlist
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/internal/module/customerapp/ticket"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/sync/errgroup"
+)
+
+type WaitlistUseCase interface {
+	JoinWaitlist(ctx context.Context, req JoinWaitlistRequest) (WaitlistEntryResponse, error)
+	GetWaitlistEntry(ctx context.Context, req GetWaitlistEntryRequest) (WaitlistEntryResponse, error)
+	LeaveWaitlist(ctx context.Context, req LeaveWaitlistRequest) error
+	GetManyWaitlistEntry(ctx context.Context, req GetManyWaitlistEntryRequest) (GetManyWaitlistEntryResponse, error)
+}
+
+type waitlistUseCase struct {
+	logger                  *logrus.Logger
+	location                *time.Location
+	timeout                 time.Duration
+	waitlistEntryRepository WaitlistEntryRepository
+	ticketStockRepository   ticket.TicketStockRepository
+	offerer                 Offerer
+	txManager               postgresql.TxManager
+}
+
+type WaitlistUseCaseProperty struct {
+	Logger                  *logrus.Logger
+	Location                *time.Location
+	Timeout                 time.Duration
+	WaitlistEntryRepository WaitlistEntryRepository
+	TicketStockRepository   ticket.TicketStockRepository
+	Offerer                 Offerer
+	TxManager               postgresql.TxManager
+}
+
+func NewWaitlistUseCase(props WaitlistUseCaseProperty) WaitlistUseCase {
+	return &waitlistUseCase{
+		logger:                  props.Logger,
+		location:                props.Location,
+		timeout:                 props.Timeout,
+		waitlistEntryRepository: props.WaitlistEntryRepository,
+		ticketStockRepository:   props.TicketStockRepository,
+		offerer:                 props.Offerer,
+		txManager:               props.TxManager,
+	}
+}
+
+// JoinWaitlist implements WaitlistUseCase. Only the waitlist of a sold-out ticket stock can be joined.
+func (u *waitlistUseCase) JoinWaitlist(ctx context.Context, req JoinWaitlistRequest) (WaitlistEntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	ts, err := u.ticketStockRepository.FindByID(ctx, req.TicketStockID)
+	if err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	if ts.EventID != req.EventID || ts.ShowID != req.ShowID {
+		return WaitlistEntryResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotFoundByID, i18n.Noun("ticket stock"), req.TicketStockID))
+	}
+
+	if ts.Available() > 0 {
+		return WaitlistEntryResponse{}, errors.New(http.StatusConflict, status.CONFLICT, i18n.Message(ctx, i18n.NotSoldOut, ts.ID))
+	}
+
+	we := req.ToEntityWaitlistEntry(acc.ID, ts.Tier, time.Now())
+	if err := u.waitlistEntryRepository.Save(ctx, we); err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	we, err = u.waitlistEntryRepository.FindActiveByCustomerID(ctx, acc.ID, ts.ID)
+	if err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	resp := WaitlistEntryResponse{}
+	resp.PopulateFromEntity(we)
+
+	return resp, nil
+}
+
+// GetWaitlistEntry implements WaitlistUseCase.
+func (u *waitlistUseCase) GetWaitlistEntry(ctx context.Context, req GetWaitlistEntryRequest) (WaitlistEntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	we, err := u.waitlistEntryRepository.FindActiveByCustomerID(ctx, acc.ID, req.TicketStockID)
+	if err != nil {
+		return WaitlistEntryResponse{}, err
+	}
+
+	if we.EventID != req.EventID || we.ShowID != req.ShowID {
+		return WaitlistEntryResponse{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotOnWaitlist, req.TicketStockID))
+	}
+
+	resp := WaitlistEntryResponse{}
+	resp.PopulateFromEntity(we)
+
+	return resp, nil
+}
+
+// LeaveWaitlist implements WaitlistUseCase. The tickets which are offered to the customer are offered to the next
+// waiting customers.
+func (u *waitlistUseCase) LeaveWaitlist(ctx context.Context, req LeaveWaitlistRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var ts ticket.TicketStock
+	var offered bool
+	err = u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		ts, err = u.ticketStockRepository.FindByIDForUpdate(ctx, req.TicketStockID)
+		if err != nil {
+			return err
+		}
+
+		we, err := u.waitlistEntryRepository.FindActiveByCustomerIDForUpdate(ctx, acc.ID, ts.ID)
+		if err != nil {
+			return err
+		}
+
+		if we.EventID != req.EventID || we.ShowID != req.ShowID {
+			return errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotOnWaitlist, req.TicketStockID))
+		}
+
+		now := time.Now()
+		offered = we.Status == WaitlistEntryStatusOffered
+
+		if offered {
+			ts.Reserved = ts.Reserved - we.Quantity
+			ts.LastStockUpdate = now
+
+			if err := u.ticketStockRepository.Update(ctx, ts.ID, ts); err != nil {
+				return err
+			}
+		}
+
+		we.Status = WaitlistEntryStatusLeft
+		we.UpdatedAt = now
+
+		return u.waitlistEntryRepository.Update(ctx, we)
+	})
+	if err != nil {
+		return err
+	}
+
+	if offered {
+		if err := u.offerer.OfferReturnedStock(ctx, ts.ID); err != nil {
+			u.logger.WithContext(ctx).WithError(err).Error()
+		}
+	}
+
+	return nil
+}
+
+// GetManyWaitlistEntry implements WaitlistUseCase.
+func (u *waitlistUseCase) GetManyWaitlistEntry(ctx context.Context, req GetManyWaitlistEntryRequest) (GetManyWaitlistEntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	acc, err := session.GetAccountFromCtx(ctx)
+	if err != nil {
+		return GetManyWaitlistEntryResponse{}, err
+	}
+
+	offset := (req.Page - 1) * req.Size
+	limit := req.Size
+
+	var entries []WaitlistEntry
+	var total int64
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		count, err := u.waitlistEntryRepository.CountByCustomerID(gctx, acc.ID)
+		if err != nil {
+			return err
+		}
+		total = count
+		return nil
+	})
+	g.Go(func() error {
+		data, err := u.waitlistEntryRepository.FindManyByCustomerID(gctx, acc.ID, offset, limit)
+		if err != nil {
+			return err
+		}
+		entries = data
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return GetManyWaitlistEntryResponse{}, err
+	}
+
+	resp := GetManyWaitlistEntryResponse{
+		Total:           total,
+		WaitlistEntries: make([]WaitlistEntryResponse, len(entries)),
+	}
+
+	for k, v := range entries {
+		resp.WaitlistEntries[k].PopulateFromEntity(v)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,352 @@
+package waitlist
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+type WaitlistEntryRepository interface {
+	Save(ctx context.Context, we WaitlistEntry) error
+	Update(ctx context.Context, we WaitlistEntry) error
+	FindActiveByCustomerID(ctx context.Context, customerID int64, ticketStockID string) (WaitlistEntry, error)
+	FindActiveByCustomerIDForUpdate(ctx context.Context, customerID int64, ticketStockID string) (WaitlistEntry, error)
+	FindManyByCustomerID(ctx context.Context, customerID int64, offset, limit int) ([]WaitlistEntry, error)
+	CountByCustomerID(ctx context.Context, customerID int64) (int64, error)
+	FindManyWaitingForUpdate(ctx context.Context, ticketStockID string, limit int64) ([]WaitlistEntry, error)
+	FindManyExpiredOfferForUpdate(ctx context.Context, ticketStockID string, now time.Time) ([]WaitlistEntry, error)
+	FindManyUnnotifiedOffer(ctx context.Context, ticketStockID string, now time.Time) ([]WaitlistEntry, error)
+	MarkNotified(ctx context.Context, ID string, now time.Time) error
+	FindManyTicketStockIDToOffer(ctx context.Context, now time.Time) ([]string, error)
+}
+
+// waitlistEntryColumns are the columns of the waitlist entries aliased as w, the position of a waiting customer counts
+// the customers who joined the waitlist of the ticket stock earlier and are still waiting.
+const waitlistEntryColumns = `
+	w.id, w.event_id, w.show_id, w.ticket_stock_id, w.tier, w.customer_id, w.quantity, w.status, w.offered_until,
+	w.notified_at, w.created_at, w.updated_at,
+	CASE WHEN w.status = 'WAITING' THEN (
+		SELECT count(o.id) + 1 FROM waitlist_entry o
+		WHERE
+			o.ticket_stock_id = w.ticket_stock_id
+			AND o.status = 'WAITING'
+			AND (o.created_at, o.id) < (w.created_at, w.id)
+	) ELSE 0 END AS position
+`
+
+type waitlistEntryRepository struct {
+	logger *logrus.Logger
+	db     *pgxpool.Pool
+}
+
+func NewWaitlistEntryRepository(logger *logrus.Logger, db *pgxpool.Pool) WaitlistEntryRepository {
+	return &waitlistEntryRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (r *waitlistEntryRepository) scan(rows postgresql.Rows) ([]WaitlistEntry, error) {
+	var data = make([]WaitlistEntry, 0)
+	for rows.Next() {
+		var we WaitlistEntry
+		var offeredUntil, notifiedAt sql.NullTime
+		err := rows.Scan(
+			&we.ID, &we.EventID, &we.ShowID, &we.TicketStockID, &we.Tier, &we.CustomerID, &we.Quantity, &we.Status, &offeredUntil,
+			&notifiedAt, &we.CreatedAt, &we.UpdatedAt, &we.Position,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if offeredUntil.Valid {
+			we.OfferedUntil = &offeredUntil.Time
+		}
+
+		if notifiedAt.Valid {
+			we.NotifiedAt = &notifiedAt.Time
+		}
+
+		data = append(data, we)
+	}
+
+	return data, nil
+}
+
+func (r *waitlistEntryRepository) findMany(ctx context.Context, query string, args ...interface{}) ([]WaitlistEntry, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	rows, err := cmd.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of waitlist entry's prorperties")
+	}
+
+	defer rows.Close()
+
+	data, err := r.scan(rows)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of waitlist entry's prorperties")
+	}
+
+	return data, nil
+}
+
+// Save implements WaitlistEntryRepository. A customer can only be on the waitlist of a ticket stock once at a time.
+func (r *waitlistEntryRepository) Save(ctx context.Context, we WaitlistEntry) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		INSERT INTO waitlist_entry
+		(
+			id, event_id, show_id, ticket_stock_id, tier, customer_id, quantity, status, offered_until, created_at, updated_at
+		)
+		VALUES
+		(
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+		ON CONFLICT (customer_id, ticket_stock_id) WHERE status IN ('WAITING', 'OFFERED') DO NOTHING
+	`
+
+	result, err := cmd.ExecContext(ctx, query, we.ID, we.EventID, we.ShowID, we.TicketStockID, we.Tier, we.CustomerID, we.Quantity, we.Status, we.OfferedUntil, we.CreatedAt, we.UpdatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving waitlist entry's prorperties")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while saving waitlist entry's prorperties")
+	}
+
+	if affected < 1 {
+		return errors.New(http.StatusConflict, status.ALREADY_EXIST, i18n.Message(ctx, i18n.OnWaitlist, we.TicketStockID))
+	}
+
+	return nil
+}
+
+// Update implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) Update(ctx context.Context, we WaitlistEntry) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE waitlist_entry
+		SET
+			status = $1,
+			offered_until = $2,
+			updated_at = $3
+		WHERE
+			id = $4
+	`
+
+	_, err := cmd.ExecContext(ctx, query, we.Status, we.OfferedUntil, we.UpdatedAt, we.ID)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating waitlist entry's prorperties")
+	}
+
+	return nil
+}
+
+// FindActiveByCustomerID implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) FindActiveByCustomerID(ctx context.Context, customerID int64, ticketStockID string) (WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.customer_id = $1
+			AND w.ticket_stock_id = $2
+			AND w.status IN ('WAITING', 'OFFERED')
+		LIMIT 1
+	`
+
+	data, err := r.findMany(ctx, query, customerID, ticketStockID)
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+
+	if len(data) < 1 {
+		return WaitlistEntry{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotOnWaitlist, ticketStockID))
+	}
+
+	return data[0], nil
+}
+
+// FindActiveByCustomerIDForUpdate implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) FindActiveByCustomerIDForUpdate(ctx context.Context, customerID int64, ticketStockID string) (WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.customer_id = $1
+			AND w.ticket_stock_id = $2
+			AND w.status IN ('WAITING', 'OFFERED')
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	data, err := r.findMany(ctx, query, customerID, ticketStockID)
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+
+	if len(data) < 1 {
+		return WaitlistEntry{}, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.NotOnWaitlist, ticketStockID))
+	}
+
+	return data[0], nil
+}
+
+// FindManyByCustomerID implements WaitlistEntryRepository. The entries are ordered by the latest join.
+func (r *waitlistEntryRepository) FindManyByCustomerID(ctx context.Context, customerID int64, offset int, limit int) ([]WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.customer_id = $1
+		ORDER BY w.created_at DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	return r.findMany(ctx, query, customerID, offset, limit)
+}
+
+// CountByCustomerID implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) CountByCustomerID(ctx context.Context, customerID int64) (int64, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT
+			count(id)
+		FROM waitlist_entry
+		WHERE
+			customer_id = $1
+	`
+
+	var count int64
+	if err := cmd.QueryRowContext(ctx, query, customerID).Scan(&count); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return 0, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while counting waitlist entry's prorperties")
+	}
+
+	return count, nil
+}
+
+// FindManyWaitingForUpdate implements WaitlistEntryRepository. The waiting customers are ordered by their position.
+func (r *waitlistEntryRepository) FindManyWaitingForUpdate(ctx context.Context, ticketStockID string, limit int64) ([]WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.ticket_stock_id = $1
+			AND w.status = 'WAITING'
+		ORDER BY w.created_at ASC, w.id ASC
+		LIMIT $2
+		FOR UPDATE
+	`
+
+	return r.findMany(ctx, query, ticketStockID, limit)
+}
+
+// FindManyExpiredOfferForUpdate implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) FindManyExpiredOfferForUpdate(ctx context.Context, ticketStockID string, now time.Time) ([]WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.ticket_stock_id = $1
+			AND w.status = 'OFFERED'
+			AND w.offered_until <= $2
+		FOR UPDATE
+	`
+
+	return r.findMany(ctx, query, ticketStockID, now)
+}
+
+// FindManyUnnotifiedOffer implements WaitlistEntryRepository. It returns the offers of the ticket stock which have not
+// been published to their customers and still last.
+func (r *waitlistEntryRepository) FindManyUnnotifiedOffer(ctx context.Context, ticketStockID string, now time.Time) ([]WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistEntryColumns + `
+		FROM waitlist_entry w
+		WHERE
+			w.ticket_stock_id = $1
+			AND w.status = 'OFFERED'
+			AND w.notified_at IS NULL
+			AND w.offered_until > $2
+		ORDER BY w.updated_at ASC, w.id ASC
+	`
+
+	return r.findMany(ctx, query, ticketStockID, now)
+}
+
+// MarkNotified implements WaitlistEntryRepository.
+func (r *waitlistEntryRepository) MarkNotified(ctx context.Context, ID string, now time.Time) error {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		UPDATE waitlist_entry
+		SET
+			notified_at = $1
+		WHERE
+			id = $2
+			AND notified_at IS NULL
+	`
+
+	if _, err := cmd.ExecContext(ctx, query, now, ID); err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating waitlist entry's prorperties")
+	}
+
+	return nil
+}
+
+// FindManyTicketStockIDToOffer implements WaitlistEntryRepository. It returns the ticket stocks which have expired
+// offers, offers which have not been published, or available tickets while customers are still waiting for them.
+func (r *waitlistEntryRepository) FindManyTicketStockIDToOffer(ctx context.Context, now time.Time) ([]string, error) {
+	cmd := postgresql.CommandFromContext(ctx, r.db)
+
+	query := `
+		SELECT DISTINCT
+			w.ticket_stock_id
+		FROM waitlist_entry w
+		JOIN ticket_stock ts ON ts.id = w.ticket_stock_id
+		WHERE
+			(w.status = 'OFFERED' AND w.offered_until <= $1)
+			OR (w.status = 'OFFERED' AND w.notified_at IS NULL)
+			OR (w.status = 'WAITING' AND ts.allocation - ts.acquired - ts.held - ts.reserved > 0)
+	`
+
+	rows, err := cmd.QueryContext(ctx, query, now)
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error()
+		return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of waitlist entry's prorperties")
+	}
+
+	defer rows.Close()
+
+	var data = make([]string, 0)
+	for rows.Next() {
+		var ticketStockID string
+		if err := rows.Scan(&ticketStockID); err != nil {
+			r.logger.WithContext(ctx).WithError(err).Error()
+			return nil, errors.Wrap(err, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while getting bunch of waitlist entry's prorperties")
+		}
+
+		data = append(data, ticketStockID)
+	}
+
+	return data, nil
+}
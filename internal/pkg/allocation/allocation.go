@@ -0,0 +1,56 @@
+// Package allocation splits a number of tickets into whole parts.
+package allocation
+
+import (
+	"math"
+	"sort"
+)
+
+// LargestRemainder splits the total into parts which are proportional to the given weights. Every part is first
+// rounded down, the tickets which are left are then handed out one by one to the parts with the largest remainders,
+// earlier parts win ties. Unlike rounding every part on its own, the parts always sum up to the total.
+func LargestRemainder(total int64, weights []float64) []int64 {
+	parts := make([]int64, len(weights))
+
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	if total <= 0 || sum <= 0 {
+		return parts
+	}
+
+	remainders := make([]float64, len(weights))
+	allocated := int64(0)
+	for k, w := range weights {
+		quota := float64(total) * w / sum
+		parts[k] = int64(math.Floor(quota))
+		remainders[k] = quota - float64(parts[k])
+		allocated += parts[k]
+	}
+
+	order := make([]int, len(weights))
+	for k := range order {
+		order[k] = k
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+
+	for k := 0; allocated < total; k++ {
+		parts[order[k%len(order)]]++
+		allocated++
+	}
+
+	return parts
+}
+
+// Even splits the total into the given number of parts which differ by at most one ticket.
+func Even(total int64, n int) []int64 {
+	weights := make([]float64, n)
+	for k := range weights {
+		weights[k] = 1
+	}
+
+	return LargestRemainder(total, weights)
+}
@@ -0,0 +1,41 @@
+package allocation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/allocation"
+)
+
+func TestLargestRemainder(t *testing.T) {
+	t.Run("parts sum up to the total where rounding every part does not", func(t *testing.T) {
+		// rounding 33.33% of 100 on its own gives 33 three times, which leaves a ticket unallocated
+		parts := allocation.LargestRemainder(100, []float64{33.33, 33.33, 33.34})
+
+		assert.Equal(t, []int64{33, 33, 34}, parts)
+	})
+
+	t.Run("left over tickets go to the largest remainders", func(t *testing.T) {
+		// the quotas are 2.5, 3.5 and 4.0
+		parts := allocation.LargestRemainder(10, []float64{25, 35, 40})
+
+		assert.Equal(t, []int64{3, 3, 4}, parts)
+	})
+
+	t.Run("earlier parts win ties", func(t *testing.T) {
+		parts := allocation.LargestRemainder(5, []float64{50, 50})
+
+		assert.Equal(t, []int64{3, 2}, parts)
+	})
+
+	t.Run("no weight gives no tickets", func(t *testing.T) {
+		assert.Equal(t, []int64{0, 0}, allocation.LargestRemainder(10, []float64{0, 0}))
+		assert.Equal(t, []int64{}, allocation.LargestRemainder(10, nil))
+	})
+}
+
+func TestEven(t *testing.T) {
+	parts := allocation.Even(1000, 3)
+
+	assert.Equal(t, []int64{334, 333, 333}, parts)
+}
@@ -0,0 +1,110 @@
+// Package imaging decodes the uploaded images and resizes them into smaller variants.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+)
+
+// MaxPixels bounds the size of a decoded image so that a small, highly compressed file can not exhaust the memory.
+const MaxPixels = 40_000_000
+
+// Decode decodes a jpeg or a png image, its format is returned along with it.
+func Decode(content []byte) (image.Image, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, "", errors.New("image has no pixels")
+	}
+
+	if config.Width*config.Height > MaxPixels {
+		return nil, "", fmt.Errorf("image of %dx%d pixels exceeds %d pixels", config.Width, config.Height, MaxPixels)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return img, format, nil
+}
+
+// FitSize returns the size of an image of the given size which is scaled down to fit into the given bounds while
+// keeping its aspect ratio. An image which already fits keeps its size.
+func FitSize(width, height, maxWidth, maxHeight int) (int, int) {
+	if width <= maxWidth && height <= maxHeight {
+		return width, height
+	}
+
+	if width*maxHeight > height*maxWidth {
+		return maxWidth, max(1, height*maxWidth/width)
+	}
+
+	return max(1, width*maxHeight/height), maxHeight
+}
+
+// Fit scales the image down to fit into the given bounds while keeping its aspect ratio. Every pixel of the result is
+// the average of the pixels of the source it covers, transparent pixels are laid over a white background.
+func Fit(src image.Image, maxWidth, maxHeight int) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	width, height := FitSize(srcWidth, srcHeight, maxWidth, maxHeight)
+
+	flattened := image.NewRGBA(image.Rect(0, 0, srcWidth, srcHeight))
+	draw.Draw(flattened, flattened.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flattened, flattened.Bounds(), src, bounds.Min, draw.Over)
+
+	if width == srcWidth && height == srcHeight {
+		return flattened
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := max(y0+1, (y+1)*srcHeight/height)
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := max(x0+1, (x+1)*srcWidth/width)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := flattened.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(flattened.Pix[offset])
+					g += uint64(flattened.Pix[offset+1])
+					b += uint64(flattened.Pix[offset+2])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = 0xff
+		}
+	}
+
+	return dst
+}
+
+// EncodeJPEG encodes the image as a jpeg of the given quality.
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
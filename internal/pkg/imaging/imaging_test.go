@@ -0,0 +1,62 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/imaging"
+)
+
+func TestFitSize(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		width, height         int
+		maxWidth, maxHeight   int
+		wantWidth, wantHeight int
+	}{
+		{name: "fits already", width: 300, height: 200, maxWidth: 320, maxHeight: 320, wantWidth: 300, wantHeight: 200},
+		{name: "landscape", width: 1600, height: 900, maxWidth: 800, maxHeight: 800, wantWidth: 800, wantHeight: 450},
+		{name: "portrait", width: 900, height: 1600, maxWidth: 800, maxHeight: 800, wantWidth: 450, wantHeight: 800},
+		{name: "thin strip keeps a pixel", width: 10000, height: 2, maxWidth: 320, maxHeight: 320, wantWidth: 320, wantHeight: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			width, height := imaging.FitSize(tc.width, tc.height, tc.maxWidth, tc.maxHeight)
+			assert.Equal(t, tc.wantWidth, width)
+			assert.Equal(t, tc.wantHeight, height)
+		})
+	}
+}
+
+func TestDecodeAndFit(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 40; x++ {
+			if x < 20 {
+				src.Set(x, y, color.NRGBA{R: 0xff, A: 0xff})
+			} else {
+				src.Set(x, y, color.NRGBA{A: 0})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, src))
+
+	img, format, err := imaging.Decode(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, "png", format)
+
+	dst := imaging.Fit(img, 10, 10)
+	assert.Equal(t, image.Rect(0, 0, 10, 5), dst.Bounds())
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, dst.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, dst.RGBAAt(9, 4))
+
+	_, _, err = imaging.Decode([]byte("not an image"))
+	assert.Error(t, err)
+}
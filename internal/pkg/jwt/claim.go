@@ -7,4 +7,7 @@ type Claim struct {
 	Name  string
 	Email string
 	Type  string
+	// Role and PromotorID are issued for an admin only, PromotorID is the promotor whose events a PROMOTOR manages.
+	Role       string
+	PromotorID string
 }
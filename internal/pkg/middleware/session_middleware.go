@@ -7,6 +7,7 @@ import (
 
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/jwt"
 	"github.com/tsel-ticketmaster/tm-event/internal/pkg/session"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
 	"github.com/tsel-ticketmaster/tm-event/pkg/response"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
@@ -30,13 +31,13 @@ func (s *AdminSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
-			respondUnauthorized(w, "invalid token")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidToken))
 			return
 		}
 
 		bearerToken := strings.Split(authHeader, " ")
 		if len(bearerToken) != 2 {
-			respondUnauthorized(w, "invalid token")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidToken))
 			return
 		}
 
@@ -45,7 +46,7 @@ func (s *AdminSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 		var claim jwt.Claim
 
 		if err := s.jsonWebToken.Parse(ctx, token, &claim); err != nil {
-			respondUnauthorized(w, err.Error())
+			respondUnauthorized(w, tokenMessage(ctx, err))
 			return
 		}
 
@@ -56,10 +57,16 @@ func (s *AdminSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 		}
 
 		if acc.Type != "ADMIN" {
-			respondUnauthorized(w, "invalid type of user")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidUserType))
 			return
 		}
 
+		// the role of a session which is stored before the roles is the one of its token
+		if acc.Role == "" {
+			acc.Role = claim.Role
+			acc.PromotorID = claim.PromotorID
+		}
+
 		ctx = context.WithValue(ctx, session.AccountContextKey{}, acc)
 		r = r.WithContext(ctx)
 
@@ -67,6 +74,18 @@ func (s *AdminSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// tokenMessage tells why a token is rejected in the locale of the request.
+func tokenMessage(ctx context.Context, err error) string {
+	switch err {
+	case jwt.ErrInvalidToken:
+		return i18n.Message(ctx, i18n.InvalidToken)
+	case jwt.ErrExpiredOrNotReady:
+		return i18n.Message(ctx, i18n.TokenExpiredOrNotReady)
+	}
+
+	return err.Error()
+}
+
 func respondUnauthorized(w http.ResponseWriter, message string) {
 	response.JSON(w, http.StatusUnauthorized, response.RESTEnvelope{
 		Status:  status.UNAUTHORIZED,
@@ -93,13 +112,13 @@ func (s *CustomerSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
-			respondUnauthorized(w, "invalid token")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidToken))
 			return
 		}
 
 		bearerToken := strings.Split(authHeader, " ")
 		if len(bearerToken) != 2 {
-			respondUnauthorized(w, "invalid token")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidToken))
 			return
 		}
 
@@ -108,7 +127,7 @@ func (s *CustomerSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 		var claim jwt.Claim
 
 		if err := s.jsonWebToken.Parse(ctx, token, &claim); err != nil {
-			respondUnauthorized(w, err.Error())
+			respondUnauthorized(w, tokenMessage(ctx, err))
 			return
 		}
 
@@ -119,7 +138,7 @@ func (s *CustomerSession) Verify(next http.HandlerFunc) http.HandlerFunc {
 		}
 
 		if acc.Type != "CUSTOMER" {
-			respondUnauthorized(w, "invalid type of user")
+			respondUnauthorized(w, i18n.Message(ctx, i18n.InvalidUserType))
 			return
 		}
 
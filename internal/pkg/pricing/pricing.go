@@ -0,0 +1,245 @@
+// Package pricing computes the current price of a ticket tier from a set of rules.
+//
+// The base price of the tier is adjusted by the rules in order of their priority, the last rule which applies sets the
+// price. Time based rules announce the instants at which they start or stop to apply and sell-through rules announce
+// the number of sold tickets from which they apply, which lets the engine tell when the price is going to change next.
+package pricing
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+// Rule priorities, a rule with a higher priority takes precedence over the ones with a lower priority.
+const (
+	PriorityPhase       int = 10
+	PrioritySellThrough int = 20
+	PriorityOverride    int = 30
+)
+
+// State is the state of a tier at which the price is computed.
+type State struct {
+	Now        time.Time
+	BasePrice  money.Money
+	Allocation int64
+	Sold       int64
+}
+
+// SoldPercentage returns the percentage of the allocation which has been sold.
+func (s State) SoldPercentage() float64 {
+	if s.Allocation < 1 {
+		return 100
+	}
+
+	return float64(s.Sold) / float64(s.Allocation) * 100
+}
+
+// Rule sets the price of a tier for the states it applies to.
+type Rule interface {
+	// Name describes the rule, e.g. early-bird.
+	Name() string
+	// Priority orders the rules, see the Priority constants.
+	Priority() int
+	// Price returns the price set by the rule and whether the rule applies to the given state.
+	Price(s State) (money.Money, bool)
+	// ChangesAt returns the instants at which the rule starts or stops to apply.
+	ChangesAt() []time.Time
+	// ChangesAfterSold returns the numbers of sold tickets from which the rule starts or stops to apply.
+	ChangesAfterSold(allocation int64) []int64
+}
+
+// Change is an upcoming change of the price. Either EffectiveFrom or AfterSold is set.
+type Change struct {
+	Price         money.Money
+	Rule          string
+	EffectiveFrom *time.Time
+	AfterSold     *int64
+}
+
+// Quote is the price of a tier at a given state.
+type Quote struct {
+	Price      money.Money
+	Rule       string
+	NextChange *Change
+}
+
+// Engine computes quotes from a set of rules.
+type Engine struct {
+	rules []Rule
+}
+
+// New returns an engine of the given rules. Rules of the same priority keep their order, the later one takes precedence.
+func New(rules ...Rule) *Engine {
+	sorted := make([]Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority() < sorted[j].Priority()
+	})
+
+	return &Engine{rules: sorted}
+}
+
+func (e *Engine) price(s State) (money.Money, string) {
+	price, name := s.BasePrice, ""
+	for _, r := range e.rules {
+		if p, ok := r.Price(s); ok {
+			price, name = p, r.Name()
+		}
+	}
+
+	return price, name
+}
+
+// Quote returns the current price of the given state and its next change. A change in time is preferred over a change
+// by sell-through since the latter can not be dated.
+func (e *Engine) Quote(s State) Quote {
+	price, name := e.price(s)
+	q := Quote{Price: price, Rule: name}
+
+	instants := make([]time.Time, 0)
+	for _, r := range e.rules {
+		for _, t := range r.ChangesAt() {
+			if t.After(s.Now) {
+				instants = append(instants, t)
+			}
+		}
+	}
+	sort.Slice(instants, func(i, j int) bool { return instants[i].Before(instants[j]) })
+
+	for _, t := range instants {
+		next := s
+		next.Now = t
+		if p, n := e.price(next); !p.Equal(price) {
+			effectiveFrom := t
+			q.NextChange = &Change{Price: p, Rule: n, EffectiveFrom: &effectiveFrom}
+			return q
+		}
+	}
+
+	thresholds := make([]int64, 0)
+	for _, r := range e.rules {
+		for _, sold := range r.ChangesAfterSold(s.Allocation) {
+			if sold > s.Sold && sold <= s.Allocation {
+				thresholds = append(thresholds, sold)
+			}
+		}
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+
+	for _, sold := range thresholds {
+		next := s
+		next.Sold = sold
+		if p, n := e.price(next); !p.Equal(price) {
+			afterSold := sold
+			q.NextChange = &Change{Price: p, Rule: n, AfterSold: &afterSold}
+			return q
+		}
+	}
+
+	return q
+}
+
+// window is the validity period of time based rules, an empty EndsAt means the rule never expires.
+type window struct {
+	StartsAt time.Time
+	EndsAt   *time.Time
+}
+
+func (w window) contains(t time.Time) bool {
+	if t.Before(w.StartsAt) {
+		return false
+	}
+
+	return w.EndsAt == nil || t.Before(*w.EndsAt)
+}
+
+func (w window) changesAt() []time.Time {
+	if w.EndsAt == nil {
+		return []time.Time{w.StartsAt}
+	}
+
+	return []time.Time{w.StartsAt, *w.EndsAt}
+}
+
+// PhaseRule sets the price during a sale phase, e.g. early-bird, presale or general.
+type PhaseRule struct {
+	Phase    string
+	Amount   money.Money
+	StartsAt time.Time
+	EndsAt   *time.Time
+}
+
+// Name implements Rule.
+func (r PhaseRule) Name() string { return r.Phase }
+
+// Priority implements Rule.
+func (r PhaseRule) Priority() int { return PriorityPhase }
+
+// Price implements Rule.
+func (r PhaseRule) Price(s State) (money.Money, bool) {
+	return r.Amount, window{r.StartsAt, r.EndsAt}.contains(s.Now)
+}
+
+// ChangesAt implements Rule.
+func (r PhaseRule) ChangesAt() []time.Time { return window{r.StartsAt, r.EndsAt}.changesAt() }
+
+// ChangesAfterSold implements Rule.
+func (r PhaseRule) ChangesAfterSold(allocation int64) []int64 { return nil }
+
+// SellThroughRule sets the price once the given percentage of the allocation has been sold.
+type SellThroughRule struct {
+	Step       string
+	Amount     money.Money
+	Percentage float64
+}
+
+// Name implements Rule.
+func (r SellThroughRule) Name() string { return r.Step }
+
+// Priority implements Rule.
+func (r SellThroughRule) Priority() int { return PrioritySellThrough }
+
+// Price implements Rule.
+func (r SellThroughRule) Price(s State) (money.Money, bool) {
+	return r.Amount, s.Allocation > 0 && s.Sold >= r.threshold(s.Allocation)
+}
+
+// ChangesAt implements Rule.
+func (r SellThroughRule) ChangesAt() []time.Time { return nil }
+
+// ChangesAfterSold implements Rule.
+func (r SellThroughRule) ChangesAfterSold(allocation int64) []int64 {
+	return []int64{r.threshold(allocation)}
+}
+
+func (r SellThroughRule) threshold(allocation int64) int64 {
+	return int64(math.Ceil(float64(allocation) * r.Percentage / 100))
+}
+
+// OverrideRule sets the price regardless of every other rule, it is set by an admin.
+type OverrideRule struct {
+	Reason   string
+	Amount   money.Money
+	StartsAt time.Time
+	EndsAt   *time.Time
+}
+
+// Name implements Rule.
+func (r OverrideRule) Name() string { return r.Reason }
+
+// Priority implements Rule.
+func (r OverrideRule) Priority() int { return PriorityOverride }
+
+// Price implements Rule.
+func (r OverrideRule) Price(s State) (money.Money, bool) {
+	return r.Amount, window{r.StartsAt, r.EndsAt}.contains(s.Now)
+}
+
+// ChangesAt implements Rule.
+func (r OverrideRule) ChangesAt() []time.Time { return window{r.StartsAt, r.EndsAt}.changesAt() }
+
+// ChangesAfterSold implements Rule.
+func (r OverrideRule) ChangesAfterSold(allocation int64) []int64 { return nil }
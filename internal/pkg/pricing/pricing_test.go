@@ -0,0 +1,69 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/pricing"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+func idr(amount string) money.Money {
+	return money.MustParse(amount, "IDR")
+}
+
+func TestEngineQuote(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	earlyBirdEnd := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	engine := pricing.New(
+		pricing.OverrideRule{Reason: "flash sale", Amount: idr("50"), StartsAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		pricing.SellThroughRule{Step: "50% sold", Amount: idr("120"), Percentage: 50},
+		pricing.SellThroughRule{Step: "80% sold", Amount: idr("150"), Percentage: 80},
+		pricing.PhaseRule{Phase: "early-bird", Amount: idr("80"), StartsAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), EndsAt: &earlyBirdEnd},
+	)
+
+	t.Run("phase applies and the next change is its end", func(t *testing.T) {
+		q := engine.Quote(pricing.State{Now: now, BasePrice: idr("100"), Allocation: 100, Sold: 10})
+
+		assert.Equal(t, idr("80"), q.Price)
+		assert.Equal(t, "early-bird", q.Rule)
+		if assert.NotNil(t, q.NextChange) {
+			assert.Equal(t, idr("100"), q.NextChange.Price)
+			assert.Equal(t, earlyBirdEnd, *q.NextChange.EffectiveFrom)
+		}
+	})
+
+	t.Run("sell-through takes precedence over phase", func(t *testing.T) {
+		q := engine.Quote(pricing.State{Now: now, BasePrice: idr("100"), Allocation: 100, Sold: 50})
+
+		assert.Equal(t, idr("120"), q.Price)
+		assert.Equal(t, "50% sold", q.Rule)
+	})
+
+	t.Run("next sell-through step once there is no change in time", func(t *testing.T) {
+		q := engine.Quote(pricing.State{Now: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), BasePrice: idr("100"), Allocation: 100, Sold: 60})
+
+		assert.Equal(t, idr("120"), q.Price)
+		if assert.NotNil(t, q.NextChange) {
+			assert.Equal(t, idr("50"), q.NextChange.Price)
+			assert.NotNil(t, q.NextChange.EffectiveFrom)
+		}
+
+		q = engine.Quote(pricing.State{Now: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), BasePrice: idr("100"), Allocation: 100, Sold: 60})
+		assert.Equal(t, idr("50"), q.Price)
+		assert.Nil(t, q.NextChange)
+	})
+
+	t.Run("sell-through threshold as next change", func(t *testing.T) {
+		e := pricing.New(pricing.SellThroughRule{Step: "80% sold", Amount: idr("150"), Percentage: 80})
+		q := e.Quote(pricing.State{Now: now, BasePrice: idr("100"), Allocation: 10, Sold: 3})
+
+		assert.Equal(t, idr("100"), q.Price)
+		if assert.NotNil(t, q.NextChange) {
+			assert.Equal(t, idr("150"), q.NextChange.Price)
+			assert.Equal(t, int64(8), *q.NextChange.AfterSold)
+		}
+	})
+}
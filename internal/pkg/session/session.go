@@ -10,6 +10,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
 
@@ -19,10 +20,21 @@ var (
 
 type AccountContextKey struct{}
 
+// The roles of an admin's account, they tell which of the customers' data the admin sees unmasked.
+const (
+	RoleAdmin    = "ADMIN"
+	RolePromotor = "PROMOTOR"
+	RoleVenue    = "VENUE"
+)
+
 type Account struct {
 	ID   int64
 	Name string
 	Type string
+	// Role is set for an admin only, an account which is stored before the roles has none.
+	Role string
+	// PromotorID is the promotor whose events an admin of the PROMOTOR role manages.
+	PromotorID string
 }
 
 type Session interface {
@@ -55,7 +67,7 @@ func (s *redisSessionStore) Get(ctx context.Context, key string) (Account, error
 	dataBuff, err := s.r.Get(ctx, sessionKey).Bytes()
 	if err != nil {
 		if err == redis.Nil {
-			return acc, errors.New(http.StatusNotFound, status.NOT_FOUND, "user session is not found")
+			return acc, errors.New(http.StatusNotFound, status.NOT_FOUND, i18n.Message(ctx, i18n.SessionNotFound))
 		}
 
 		s.l.WithContext(ctx).WithError(err).Error()
@@ -88,13 +100,13 @@ func NewRedisSessionStore(l *logrus.Logger, r redis.UniversalClient) Session {
 
 func GetAccountFromCtx(ctx context.Context) (Account, error) {
 	if ctx == nil {
-		return Account{}, errors.New(http.StatusForbidden, status.FORBIDDEN, "request has an empty context")
+		return Account{}, errors.New(http.StatusForbidden, status.FORBIDDEN, i18n.Message(ctx, i18n.EmptyContext))
 	}
 	value := ctx.Value(AccountContextKey{})
 
 	acc, ok := value.(Account)
 	if !ok {
-		return Account{}, errors.New(http.StatusForbidden, status.FORBIDDEN, "request has an invalid context")
+		return Account{}, errors.New(http.StatusForbidden, status.FORBIDDEN, i18n.Message(ctx, i18n.InvalidContext))
 	}
 
 	return acc, nil
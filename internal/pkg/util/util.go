@@ -6,9 +6,12 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
 )
 
 // GenerateRandomHEX returns random hex number in string format by the given size (in bytes).
@@ -57,3 +60,26 @@ func GenerateUniqueID(composition string, length int) (id string) {
 	}
 	return string(b)
 }
+
+// Slugify returns the url friendly form of the given text. Diacritics are stripped, letters are lowercased and every
+// other run of characters is replaced by a single dash, e.g. "Béyoncé & Jay-Z" becomes "beyonce-jay-z".
+func Slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFKD.String(text) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			dash = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			dash = true
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,52 @@
+// Package validation validates the requests of both the admin's and the customer's app.
+package validation
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	appValidator "github.com/tsel-ticketmaster/tm-event/pkg/validator"
+)
+
+// Struct validates the payload. Its violations are returned as a bad request error which carries a field error for
+// each of them, the fields are named by their json path and the messages are in the locale of the context.
+func Struct(ctx context.Context, validate *validator.Validate, payload interface{}) error {
+	err := validate.StructCtx(ctx, payload)
+	if err == nil {
+		return nil
+	}
+
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return errors.New(http.StatusBadRequest, status.BAD_REQUEST, err.Error())
+	}
+
+	trans := appValidator.Translator(i18n.FromContext(ctx).String())
+
+	fieldErrors := make([]errors.FieldError, len(validationErrors))
+	for k, v := range validationErrors {
+		fieldErrors[k] = errors.FieldError{
+			Field:   fieldPath(v.Namespace()),
+			Rule:    v.Tag(),
+			Param:   v.Param(),
+			Message: v.Translate(trans),
+		}
+	}
+
+	return errors.NewValidation(fieldErrors)
+}
+
+// fieldPath strips the name of the payload from the namespace of a field, e.g.
+// "CreateEventRequest.shows[0].venue_id" becomes "shows[0].venue_id".
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+
+	return namespace
+}
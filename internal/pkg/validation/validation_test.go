@@ -0,0 +1,55 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/validation"
+	"github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"github.com/tsel-ticketmaster/tm-event/pkg/validator"
+	"golang.org/x/text/language"
+)
+
+type locationRequest struct {
+	FormattedAddress string `json:"formatted_address" validate:"formatted_address"`
+}
+
+type showRequest struct {
+	ID       string            `json:"-" validate:"required"`
+	Type     string            `json:"type" validate:"oneof=LIVE HOLOGRAM_LIVE"`
+	Location []locationRequest `json:"locations" validate:"dive"`
+}
+
+func TestStruct(t *testing.T) {
+	t.Run("valid payload", func(t *testing.T) {
+		req := showRequest{ID: "SHOW1", Type: "LIVE", Location: []locationRequest{{FormattedAddress: "Jl. Pintu Satu Senayan, Jakarta"}}}
+
+		assert.NoError(t, validation.Struct(context.Background(), validator.Get(), req))
+	})
+
+	t.Run("violations are reported by their json path", func(t *testing.T) {
+		req := showRequest{Type: "VIRTUAL", Location: []locationRequest{{FormattedAddress: "Jl. Senayan\nJakarta"}}}
+
+		ae := errors.Destruct(validation.Struct(context.Background(), validator.Get(), req))
+
+		assert.Equal(t, status.BAD_REQUEST, ae.Status)
+		assert.Equal(t, []errors.FieldError{
+			{Field: "ID", Rule: "required", Message: "ID is a required field"},
+			{Field: "type", Rule: "oneof", Param: "LIVE HOLOGRAM_LIVE", Message: "type must be one of [LIVE HOLOGRAM_LIVE]"},
+			{Field: "locations[0].formatted_address", Rule: "formatted_address", Message: "formatted_address must be a formatted address"},
+		}, ae.Errors)
+	})
+	t.Run("messages are in the locale of the context", func(t *testing.T) {
+		req := showRequest{ID: "SHOW1", Type: "LIVE", Location: []locationRequest{{FormattedAddress: " "}}}
+		ctx := i18n.NewContext(context.Background(), language.Indonesian)
+
+		ae := errors.Destruct(validation.Struct(ctx, validator.Get(), req))
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "locations[0].formatted_address", Rule: "formatted_address", Message: "formatted_address harus berupa alamat yang lengkap"},
+		}, ae.Errors)
+	})
+}
@@ -0,0 +1,30 @@
+DROP TABLE IF EXISTS waitlist_entry;
+DROP TABLE IF EXISTS follow_notification;
+DROP TABLE IF EXISTS follow;
+DROP TABLE IF EXISTS media_variant;
+DROP TABLE IF EXISTS media;
+DROP TABLE IF EXISTS promo_redemption;
+DROP TABLE IF EXISTS promo_code;
+DROP TABLE IF EXISTS ticket_price_quote;
+DROP TABLE IF EXISTS ticket_price_change;
+DROP TABLE IF EXISTS ticket_price_rule;
+DROP TABLE IF EXISTS acquired_ticket;
+DROP TABLE IF EXISTS ticket_hold;
+DROP TABLE IF EXISTS show_seat;
+DROP TABLE IF EXISTS ticket_stock;
+DROP TABLE IF EXISTS event_show_location;
+DROP TABLE IF EXISTS event_show_translation;
+DROP TABLE IF EXISTS event_show;
+DROP TABLE IF EXISTS order_rule_range_date;
+DROP TABLE IF EXISTS order_rule_day;
+DROP TABLE IF EXISTS event_taxonomy;
+DROP TABLE IF EXISTS taxonomy;
+DROP TABLE IF EXISTS event_promotor;
+DROP TABLE IF EXISTS event_artist;
+DROP TABLE IF EXISTS promotor;
+DROP TABLE IF EXISTS artist;
+DROP TABLE IF EXISTS event_translation;
+DROP TABLE IF EXISTS event;
+DROP TABLE IF EXISTS seat_map_seat;
+DROP TABLE IF EXISTS seat_map;
+DROP TABLE IF EXISTS venue;
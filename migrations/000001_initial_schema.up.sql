@@ -0,0 +1,628 @@
+-- Initial schema.
+--
+-- The first section is the schema the service started from, a database which has been running it adopts it as it
+-- is. The following sections change it in the order the features were added and take over the former scripts/*.sql,
+-- a new database goes through them as well. Every step is guarded, so a database which has been upgraded by some of
+-- the former scripts only gets the changes it lacks.
+
+-- Baseline.
+
+CREATE TABLE IF NOT EXISTS event (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS event_status_idx ON event (status, created_at);
+
+CREATE TABLE IF NOT EXISTS event_artist (
+    event_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS event_promotor (
+    event_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    phone VARCHAR(64) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS order_rule_day (
+    event_id VARCHAR(64) NOT NULL,
+    day SMALLINT NOT NULL,
+    PRIMARY KEY (event_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS order_rule_range_date (
+    event_id VARCHAR(64) PRIMARY KEY,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_rule_range_date_start_date_idx ON order_rule_range_date (start_date);
+
+CREATE TABLE IF NOT EXISTS event_show (
+    event_id VARCHAR(64) NOT NULL,
+    id VARCHAR(64) PRIMARY KEY,
+    venue VARCHAR(255) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    status VARCHAR(32) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS event_show_event_id_idx ON event_show (event_id);
+
+CREATE TABLE IF NOT EXISTS event_show_location (
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) PRIMARY KEY,
+    country VARCHAR(128) NOT NULL,
+    city VARCHAR(128) NOT NULL,
+    formatted_address TEXT NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS ticket_stock (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    online_for VARCHAR(64) NULL,
+    allocation BIGINT NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    acquired BIGINT NOT NULL,
+    last_stock_update TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ticket_stock_show_id_idx ON ticket_stock (show_id);
+
+CREATE TABLE IF NOT EXISTS acquired_ticket (
+    id BIGSERIAL PRIMARY KEY,
+    "number" VARCHAR(64) NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    event_name VARCHAR(255) NOT NULL,
+    show_venue VARCHAR(255) NOT NULL,
+    show_type VARCHAR(32) NOT NULL,
+    show_country VARCHAR(128) NOT NULL,
+    show_city VARCHAR(128) NOT NULL,
+    show_formatted_address TEXT NOT NULL,
+    show_time TIMESTAMPTZ NOT NULL,
+    customer_name VARCHAR(255) NOT NULL,
+    customer_email VARCHAR(255) NOT NULL,
+    customer_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    order_id VARCHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS acquired_ticket_customer_id_idx ON acquired_ticket (customer_id, created_at);
+CREATE INDEX IF NOT EXISTS acquired_ticket_order_id_idx ON acquired_ticket (order_id);
+
+-- Seat maps.
+--
+-- A seat map is a reusable layout of the seats of a venue, it is copied into the seats of a show when it is assigned
+-- to the show. The acquired ticket keeps the seat it was issued for.
+
+CREATE TABLE IF NOT EXISTS seat_map (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    venue VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS seat_map_seat (
+    seat_map_id VARCHAR(64) NOT NULL REFERENCES seat_map (id) ON DELETE CASCADE,
+    id VARCHAR(64) NOT NULL,
+    section VARCHAR(64) NOT NULL,
+    "row" VARCHAR(16) NOT NULL,
+    "number" VARCHAR(16) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    PRIMARY KEY (seat_map_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS show_seat (
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    seat_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    section VARCHAR(64) NOT NULL,
+    "row" VARCHAR(16) NOT NULL,
+    "number" VARCHAR(16) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    held_by BIGINT NULL,
+    held_until TIMESTAMPTZ NULL,
+    order_id VARCHAR(64) NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (show_id, seat_id)
+);
+
+ALTER TABLE acquired_ticket
+    ADD COLUMN IF NOT EXISTS seat_id VARCHAR(64) NULL,
+    ADD COLUMN IF NOT EXISTS seat_section VARCHAR(64) NULL,
+    ADD COLUMN IF NOT EXISTS seat_row VARCHAR(16) NULL,
+    ADD COLUMN IF NOT EXISTS seat_number VARCHAR(16) NULL;
+
+-- Venue registry.
+--
+-- The shows reference the venues of the registry. The locations of the physical shows which share the same venue
+-- name and address are folded into a single venue, its capacity is the largest live allocation among its shows. The
+-- shows which reference a venue and the online shows no longer keep a location of their own.
+
+CREATE TABLE IF NOT EXISTS venue (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    country VARCHAR(128) NOT NULL,
+    city VARCHAR(128) NOT NULL,
+    formatted_address TEXT NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    capacity BIGINT NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    default_seat_map_id VARCHAR(64) NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE event_show ADD COLUMN IF NOT EXISTS venue_id VARCHAR(64) NULL REFERENCES venue (id);
+
+CREATE INDEX IF NOT EXISTS event_show_venue_id_idx ON event_show (venue_id);
+
+INSERT INTO venue
+(
+    id, name, country, city, formatted_address, latitude, longitude,
+    capacity, timezone, default_seat_map_id, created_at, updated_at
+)
+SELECT
+    'VENUE' || to_char(now(), 'YYYYMMDDHH24MISS') || lpad(row_number() OVER (ORDER BY d.name, d.formatted_address)::TEXT, 6, '0'),
+    d.name, d.country, d.city, d.formatted_address, d.latitude, d.longitude,
+    d.capacity, 'Asia/Jakarta', NULL, now(), now()
+FROM (
+    SELECT
+        s.venue AS name, l.country, l.city, l.formatted_address,
+        max(l.latitude) AS latitude, max(l.longitude) AS longitude,
+        max(COALESCE(a.allocation, 0)) AS capacity
+    FROM event_show s
+    JOIN event_show_location l ON l.show_id = s.id
+    LEFT JOIN (
+        SELECT show_id, sum(allocation) AS allocation
+        FROM ticket_stock
+        WHERE online_for IS NULL
+        GROUP BY show_id
+    ) a ON a.show_id = s.id
+    WHERE s.type <> 'ONLINE' AND s.venue_id IS NULL
+    GROUP BY s.venue, l.country, l.city, l.formatted_address
+) d
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM venue v
+    WHERE
+        v.name = d.name
+        AND v.country = d.country
+        AND v.city = d.city
+        AND v.formatted_address = d.formatted_address
+);
+
+UPDATE event_show s
+SET venue_id = v.id
+FROM event_show_location l, venue v
+WHERE
+    l.show_id = s.id
+    AND s.type <> 'ONLINE'
+    AND s.venue_id IS NULL
+    AND v.name = s.venue
+    AND v.country = l.country
+    AND v.city = l.city
+    AND v.formatted_address = l.formatted_address;
+
+DELETE FROM event_show_location l
+USING event_show s
+WHERE
+    l.show_id = s.id
+    AND (s.venue_id IS NOT NULL OR s.type = 'ONLINE');
+
+-- Nearby shows.
+--
+-- The nearby search prefilters the venues and the locations by a latitude/longitude bounding box before the
+-- haversine distance is computed.
+
+CREATE INDEX IF NOT EXISTS venue_latitude_longitude_idx ON venue (latitude, longitude);
+CREATE INDEX IF NOT EXISTS event_show_location_latitude_longitude_idx ON event_show_location (latitude, longitude);
+CREATE INDEX IF NOT EXISTS event_show_status_time_idx ON event_show (status, time);
+
+-- Artist and promotor registry.
+--
+-- The artists and the promotors of an event are links to the registered ones. The names of the events are
+-- deduplicated by their slug, a promotor keeps the email and the phone of its earliest event.
+
+CREATE TABLE IF NOT EXISTS artist (
+    id VARCHAR(64) PRIMARY KEY,
+    slug VARCHAR(128) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    bio TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS promotor (
+    id VARCHAR(64) PRIMARY KEY,
+    slug VARCHAR(128) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    phone VARCHAR(64) NOT NULL,
+    bio TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE event_artist ADD COLUMN IF NOT EXISTS artist_id VARCHAR(64) NULL REFERENCES artist (id);
+ALTER TABLE event_promotor ADD COLUMN IF NOT EXISTS promotor_id VARCHAR(64) NULL REFERENCES promotor (id);
+
+-- the names are only left on a database which has not been linked to the registry yet, their slugs are given by the
+-- prepare step of this migration (migrations.Prepare) the same way as the service computes them. Every name has a
+-- slug, so every artist and promotor of an event is linked.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'event_artist' AND column_name = 'name') THEN
+        INSERT INTO artist (id, slug, name, bio, image_url, created_at, updated_at)
+        SELECT
+            'ARTIST' || to_char(now(), 'YYYYMMDDHH24MISS') || lpad(row_number() OVER (ORDER BY d.slug)::TEXT, 6, '0'),
+            d.slug, d.name, '', '', now(), now()
+        FROM (
+            SELECT DISTINCT ON (slug) slug, name
+            FROM event_artist
+            ORDER BY slug, name
+        ) d
+        ON CONFLICT (slug) DO NOTHING;
+
+        UPDATE event_artist ea
+        SET artist_id = a.id
+        FROM artist a
+        WHERE a.slug = ea.slug;
+
+        ALTER TABLE event_artist DROP COLUMN name, DROP COLUMN slug;
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'event_promotor' AND column_name = 'name') THEN
+        INSERT INTO promotor (id, slug, name, email, phone, bio, image_url, created_at, updated_at)
+        SELECT
+            'PROMOTOR' || to_char(now(), 'YYYYMMDDHH24MISS') || lpad(row_number() OVER (ORDER BY d.slug)::TEXT, 6, '0'),
+            d.slug, d.name, d.email, d.phone, '', '', now(), now()
+        FROM (
+            SELECT DISTINCT ON (slug) slug, name, email, phone
+            FROM event_promotor
+            ORDER BY slug, event_id
+        ) d
+        ON CONFLICT (slug) DO NOTHING;
+
+        UPDATE event_promotor ep
+        SET promotor_id = p.id
+        FROM promotor p
+        WHERE p.slug = ep.slug;
+
+        ALTER TABLE event_promotor DROP COLUMN name, DROP COLUMN email, DROP COLUMN phone, DROP COLUMN slug;
+    END IF;
+END $$;
+
+-- an event which listed the same artist or promotor twice keeps a single link.
+DELETE FROM event_artist a
+USING event_artist b
+WHERE a.ctid > b.ctid AND a.event_id = b.event_id AND a.artist_id = b.artist_id;
+
+DELETE FROM event_promotor a
+USING event_promotor b
+WHERE a.ctid > b.ctid AND a.event_id = b.event_id AND a.promotor_id = b.promotor_id;
+
+ALTER TABLE event_artist ALTER COLUMN artist_id SET NOT NULL;
+ALTER TABLE event_promotor ALTER COLUMN promotor_id SET NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conrelid = 'event_artist'::regclass AND contype = 'p') THEN
+        ALTER TABLE event_artist ADD PRIMARY KEY (event_id, artist_id);
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conrelid = 'event_promotor'::regclass AND contype = 'p') THEN
+        ALTER TABLE event_promotor ADD PRIMARY KEY (event_id, promotor_id);
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS event_artist_artist_id_idx ON event_artist (artist_id);
+CREATE INDEX IF NOT EXISTS event_promotor_promotor_id_idx ON event_promotor (promotor_id);
+
+-- Dynamic pricing.
+--
+-- The price rules of the ticket stocks, the history of their price changes and the price quotes which are honoured
+-- by the order flow until they expire. The acquired ticket keeps the price it was paid for, the existing tickets are
+-- given the price of their ticket stock.
+
+CREATE TABLE IF NOT EXISTS ticket_price_rule (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    price NUMERIC(18, 2) NOT NULL,
+    starts_at TIMESTAMPTZ NULL,
+    ends_at TIMESTAMPTZ NULL,
+    sold_percentage NUMERIC(5, 2) NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ticket_price_rule_show_id_idx ON ticket_price_rule (show_id);
+CREATE INDEX IF NOT EXISTS ticket_price_rule_ticket_stock_id_idx ON ticket_price_rule (ticket_stock_id);
+
+CREATE TABLE IF NOT EXISTS ticket_price_change (
+    id BIGSERIAL PRIMARY KEY,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    price NUMERIC(18, 2) NOT NULL,
+    rule VARCHAR(255) NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ticket_price_change_ticket_stock_id_idx ON ticket_price_change (ticket_stock_id, effective_from);
+
+CREATE TABLE IF NOT EXISTS ticket_price_quote (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    customer_id BIGINT NOT NULL,
+    price NUMERIC(18, 2) NOT NULL,
+    rule VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS price NUMERIC(18, 2) NULL;
+
+UPDATE acquired_ticket SET price = ticket_stock.price
+FROM ticket_stock
+WHERE acquired_ticket.ticket_stock_id = ticket_stock.id AND acquired_ticket.price IS NULL;
+
+ALTER TABLE acquired_ticket ALTER COLUMN price SET NOT NULL;
+
+-- Money with currency.
+--
+-- Every event and show carries the currency it is sold in, and every price is stored as a NUMERIC amount next to its
+-- currency. The existing events are sold in IDR, the rows which derive from them inherit the currency of their
+-- parent.
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE event ALTER COLUMN currency DROP DEFAULT;
+
+ALTER TABLE event_show ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE event_show SET currency = event.currency FROM event WHERE event_show.event_id = event.id AND event_show.currency IS NULL;
+ALTER TABLE event_show ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE ticket_stock ALTER COLUMN price TYPE NUMERIC(18, 2) USING round(price::NUMERIC, 2);
+ALTER TABLE ticket_stock ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE ticket_stock SET currency = event_show.currency FROM event_show WHERE ticket_stock.show_id = event_show.id AND ticket_stock.currency IS NULL;
+ALTER TABLE ticket_stock ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE show_seat ALTER COLUMN price TYPE NUMERIC(18, 2) USING round(price::NUMERIC, 2);
+ALTER TABLE show_seat ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE show_seat SET currency = ticket_stock.currency FROM ticket_stock WHERE show_seat.ticket_stock_id = ticket_stock.id AND show_seat.currency IS NULL;
+ALTER TABLE show_seat ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE acquired_ticket SET currency = ticket_stock.currency FROM ticket_stock WHERE acquired_ticket.ticket_stock_id = ticket_stock.id AND acquired_ticket.currency IS NULL;
+ALTER TABLE acquired_ticket ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE ticket_price_rule ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE ticket_price_rule SET currency = ticket_stock.currency FROM ticket_stock WHERE ticket_price_rule.ticket_stock_id = ticket_stock.id AND ticket_price_rule.currency IS NULL;
+ALTER TABLE ticket_price_rule ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE ticket_price_change ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE ticket_price_change SET currency = ticket_stock.currency FROM ticket_stock WHERE ticket_price_change.ticket_stock_id = ticket_stock.id AND ticket_price_change.currency IS NULL;
+ALTER TABLE ticket_price_change ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE ticket_price_quote ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NULL;
+UPDATE ticket_price_quote SET currency = ticket_stock.currency FROM ticket_stock WHERE ticket_price_quote.ticket_stock_id = ticket_stock.id AND ticket_price_quote.currency IS NULL;
+ALTER TABLE ticket_price_quote ALTER COLUMN currency SET NOT NULL;
+
+-- Promo and presale access codes.
+--
+-- A reserved redemption counts against the limits of its code until it expires or is released, it is confirmed
+-- along with the discount which has been paid once the order which carries it has been paid.
+
+CREATE TABLE IF NOT EXISTS promo_code (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NULL,
+    tier VARCHAR(64) NULL,
+    code VARCHAR(64) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    discount_percentage NUMERIC(5, 2) NULL,
+    discount_amount NUMERIC(18, 2) NULL,
+    currency VARCHAR(3) NULL,
+    usage_limit BIGINT NULL,
+    per_customer_limit BIGINT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NULL,
+    status VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (event_id, code)
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemption (
+    id VARCHAR(64) PRIMARY KEY,
+    promo_code_id VARCHAR(64) NOT NULL,
+    code VARCHAR(64) NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    customer_id BIGINT NOT NULL,
+    quantity BIGINT NOT NULL,
+    price NUMERIC(18, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    discount NUMERIC(18, 2) NOT NULL,
+    paid_discount NUMERIC(18, 2) NULL,
+    early_access BOOLEAN NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    order_id VARCHAR(64) NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS promo_redemption_promo_code_id_idx ON promo_redemption (promo_code_id, customer_id);
+
+-- Ticket holds.
+--
+-- The named sub-allocations which are carved out of the ticket stocks for comps, sponsors, guest lists and the box
+-- office. The comp tickets which are issued from a hold refer to it instead of an order.
+
+ALTER TABLE ticket_stock ADD COLUMN IF NOT EXISTS held BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS ticket_hold (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    allocation BIGINT NOT NULL,
+    issued BIGINT NOT NULL,
+    released BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ticket_hold_show_id_idx ON ticket_hold (show_id);
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS ticket_hold_id VARCHAR(64) NULL;
+
+-- Event translations.
+--
+-- A translation which leaves a field empty falls back to the content of the event or the show.
+
+CREATE TABLE IF NOT EXISTS event_translation (
+    event_id VARCHAR(64) NOT NULL,
+    locale VARCHAR(16) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    PRIMARY KEY (event_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS event_show_translation (
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    locale VARCHAR(16) NOT NULL,
+    venue VARCHAR(255) NOT NULL,
+    PRIMARY KEY (show_id, locale)
+);
+
+-- Media.
+
+CREATE TABLE IF NOT EXISTS media (
+    id VARCHAR(64) PRIMARY KEY,
+    owner_type VARCHAR(16) NOT NULL,
+    owner_id VARCHAR(64) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS media_owner_idx ON media (owner_type, owner_id);
+
+CREATE TABLE IF NOT EXISTS media_variant (
+    media_id VARCHAR(64) NOT NULL REFERENCES media (id) ON DELETE CASCADE,
+    name VARCHAR(16) NOT NULL,
+    storage_key VARCHAR(512) NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL,
+    PRIMARY KEY (media_id, name)
+);
+
+-- Event taxonomy.
+--
+-- The categories, the genres and the tags share the taxonomy table and are told apart by its type, the slug is
+-- unique per type only.
+
+CREATE TABLE IF NOT EXISTS taxonomy (
+    id VARCHAR(64) PRIMARY KEY,
+    type VARCHAR(16) NOT NULL,
+    slug VARCHAR(128) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (type, slug)
+);
+
+CREATE TABLE IF NOT EXISTS event_taxonomy (
+    event_id VARCHAR(64) NOT NULL REFERENCES event (id) ON DELETE CASCADE,
+    taxonomy_id VARCHAR(64) NOT NULL REFERENCES taxonomy (id),
+    PRIMARY KEY (event_id, taxonomy_id)
+);
+
+CREATE INDEX IF NOT EXISTS event_taxonomy_taxonomy_idx ON event_taxonomy (taxonomy_id);
+
+INSERT INTO taxonomy (id, type, slug, name, created_at, updated_at)
+VALUES
+    ('CATEGORY' || to_char(now(), 'YYYYMMDDHH24MISS') || '000001', 'CATEGORY', 'concert', 'Concert', now(), now()),
+    ('CATEGORY' || to_char(now(), 'YYYYMMDDHH24MISS') || '000002', 'CATEGORY', 'festival', 'Festival', now(), now()),
+    ('CATEGORY' || to_char(now(), 'YYYYMMDDHH24MISS') || '000003', 'CATEGORY', 'theatre', 'Theatre', now(), now()),
+    ('CATEGORY' || to_char(now(), 'YYYYMMDDHH24MISS') || '000004', 'CATEGORY', 'sports', 'Sports', now(), now())
+ON CONFLICT (type, slug) DO NOTHING;
+
+-- Follows.
+
+CREATE TABLE IF NOT EXISTS follow (
+    customer_id BIGINT NOT NULL,
+    target_type VARCHAR(16) NOT NULL,
+    target_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (customer_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS follow_target_idx ON follow (target_type, target_id);
+
+CREATE TABLE IF NOT EXISTS follow_notification (
+    kind VARCHAR(32) NOT NULL,
+    ref_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (kind, ref_id)
+);
+
+-- Waitlists.
+--
+-- The tickets which are offered to a waiting customer are reserved on the ticket stock until the offer expires.
+
+ALTER TABLE ticket_stock ADD COLUMN IF NOT EXISTS reserved BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS waitlist_entry (
+    id VARCHAR(64) PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    show_id VARCHAR(64) NOT NULL,
+    ticket_stock_id VARCHAR(64) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    customer_id BIGINT NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    status VARCHAR(16) NOT NULL,
+    offered_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS waitlist_entry_active_customer_idx ON waitlist_entry (customer_id, ticket_stock_id) WHERE status IN ('WAITING', 'OFFERED');
+CREATE INDEX IF NOT EXISTS waitlist_entry_ticket_stock_status_idx ON waitlist_entry (ticket_stock_id, status, created_at);
+CREATE INDEX IF NOT EXISTS waitlist_entry_customer_idx ON waitlist_entry (customer_id, created_at);
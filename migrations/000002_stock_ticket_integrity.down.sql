@@ -0,0 +1,43 @@
+DROP INDEX IF EXISTS acquired_ticket_ticket_stock_id_idx;
+
+ALTER TABLE acquired_ticket
+    DROP CONSTRAINT IF EXISTS acquired_ticket_ticket_stock_id_fkey,
+    DROP CONSTRAINT IF EXISTS acquired_ticket_show_id_fkey,
+    DROP CONSTRAINT IF EXISTS acquired_ticket_event_id_fkey;
+
+ALTER TABLE ticket_stock
+    DROP CONSTRAINT IF EXISTS ticket_stock_show_id_fkey,
+    DROP CONSTRAINT IF EXISTS ticket_stock_event_id_fkey;
+
+ALTER TABLE event_show_location
+    DROP CONSTRAINT IF EXISTS event_show_location_show_id_fkey,
+    DROP CONSTRAINT IF EXISTS event_show_location_event_id_fkey;
+
+ALTER TABLE event_show
+    DROP CONSTRAINT IF EXISTS event_show_event_id_fkey;
+
+DROP INDEX IF EXISTS acquired_ticket_order_sequence_idx;
+DROP INDEX IF EXISTS acquired_ticket_number_idx;
+
+ALTER TABLE ticket_stock
+    DROP CONSTRAINT IF EXISTS ticket_stock_capacity_check,
+    DROP CONSTRAINT IF EXISTS ticket_stock_reserved_check,
+    DROP CONSTRAINT IF EXISTS ticket_stock_held_check,
+    DROP CONSTRAINT IF EXISTS ticket_stock_acquired_check,
+    DROP CONSTRAINT IF EXISTS ticket_stock_allocation_check;
+
+UPDATE acquired_ticket a
+SET "number" = r."number"
+FROM acquired_ticket_renumbered r
+WHERE a.id = r.acquired_ticket_id AND a."number" = r.renumbered_to;
+
+DROP TABLE IF EXISTS acquired_ticket_renumbered;
+
+UPDATE ticket_stock t
+SET allocation = o.allocation
+FROM ticket_stock_oversold o
+WHERE t.id = o.ticket_stock_id;
+
+DROP TABLE IF EXISTS ticket_stock_oversold;
+
+ALTER TABLE acquired_ticket DROP COLUMN IF EXISTS sequence;
@@ -0,0 +1,122 @@
+-- Integrity of the stock and the tickets.
+--
+-- The tickets of a ticket stock can not exceed its allocation, a ticket number is issued once and an order issues
+-- its tickets of a ticket stock once, their sequence tells them apart. The shows, their locations, the ticket stocks
+-- and the acquired tickets reference the rows they belong to.
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS sequence BIGINT NULL;
+
+UPDATE acquired_ticket a
+SET sequence = s.sequence
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY order_id, ticket_stock_id ORDER BY id) AS sequence
+    FROM acquired_ticket
+) s
+WHERE a.id = s.id AND a.sequence IS NULL;
+
+ALTER TABLE acquired_ticket ALTER COLUMN sequence SET NOT NULL;
+
+-- The racy acquisition of the earlier versions of the service may have oversold a ticket stock or issued a ticket
+-- number twice. The tickets have been sold, so an oversold ticket stock is given the allocation of its tickets and a
+-- duplicated number of a later ticket is renumbered, both are recorded here for the admins to follow up on.
+
+CREATE TABLE IF NOT EXISTS ticket_stock_oversold (
+    ticket_stock_id VARCHAR(64) PRIMARY KEY,
+    allocation BIGINT NOT NULL,
+    acquired BIGINT NOT NULL,
+    held BIGINT NOT NULL,
+    reserved BIGINT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL
+);
+
+-- a count which went below zero has no tickets behind it.
+UPDATE ticket_stock
+SET acquired = GREATEST(acquired, 0), held = GREATEST(held, 0), reserved = GREATEST(reserved, 0)
+WHERE acquired < 0 OR held < 0 OR reserved < 0;
+
+INSERT INTO ticket_stock_oversold (ticket_stock_id, allocation, acquired, held, reserved, recorded_at)
+SELECT id, allocation, acquired, held, reserved, now()
+FROM ticket_stock
+WHERE acquired + held + reserved > allocation
+ON CONFLICT (ticket_stock_id) DO NOTHING;
+
+UPDATE ticket_stock
+SET allocation = acquired + held + reserved
+WHERE acquired + held + reserved > allocation;
+
+CREATE TABLE IF NOT EXISTS acquired_ticket_renumbered (
+    acquired_ticket_id BIGINT PRIMARY KEY,
+    "number" VARCHAR(64) NOT NULL,
+    renumbered_to VARCHAR(64) NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL
+);
+
+INSERT INTO acquired_ticket_renumbered (acquired_ticket_id, "number", renumbered_to, recorded_at)
+SELECT a.id, a."number", upper(substr(md5(random()::TEXT || a.id::TEXT), 1, 20)), now()
+FROM acquired_ticket a
+WHERE EXISTS (SELECT 1 FROM acquired_ticket b WHERE b."number" = a."number" AND b.id < a.id)
+ON CONFLICT (acquired_ticket_id) DO NOTHING;
+
+UPDATE acquired_ticket a
+SET "number" = r.renumbered_to
+FROM acquired_ticket_renumbered r
+WHERE a.id = r.acquired_ticket_id AND a."number" = r."number";
+
+ALTER TABLE ticket_stock
+    ADD CONSTRAINT ticket_stock_allocation_check CHECK (allocation >= 0),
+    ADD CONSTRAINT ticket_stock_acquired_check CHECK (acquired >= 0),
+    ADD CONSTRAINT ticket_stock_held_check CHECK (held >= 0),
+    ADD CONSTRAINT ticket_stock_reserved_check CHECK (reserved >= 0),
+    ADD CONSTRAINT ticket_stock_capacity_check CHECK (acquired + held + reserved <= allocation);
+
+CREATE UNIQUE INDEX IF NOT EXISTS acquired_ticket_number_idx ON acquired_ticket ("number");
+
+-- comp tickets are issued from a ticket hold instead of an order.
+CREATE UNIQUE INDEX IF NOT EXISTS acquired_ticket_order_sequence_idx ON acquired_ticket (order_id, ticket_stock_id, sequence) WHERE ticket_hold_id IS NULL;
+
+-- A row which references a missing one, e.g. a ticket of a deleted show, is kept. The foreign keys hold for the new
+-- rows right away and are validated once there is no such row left, they are left NOT VALID otherwise.
+
+ALTER TABLE event_show
+    ADD CONSTRAINT event_show_event_id_fkey FOREIGN KEY (event_id) REFERENCES event (id) NOT VALID;
+
+ALTER TABLE event_show_location
+    ADD CONSTRAINT event_show_location_event_id_fkey FOREIGN KEY (event_id) REFERENCES event (id) NOT VALID,
+    ADD CONSTRAINT event_show_location_show_id_fkey FOREIGN KEY (show_id) REFERENCES event_show (id) NOT VALID;
+
+ALTER TABLE ticket_stock
+    ADD CONSTRAINT ticket_stock_event_id_fkey FOREIGN KEY (event_id) REFERENCES event (id) NOT VALID,
+    ADD CONSTRAINT ticket_stock_show_id_fkey FOREIGN KEY (show_id) REFERENCES event_show (id) NOT VALID;
+
+ALTER TABLE acquired_ticket
+    ADD CONSTRAINT acquired_ticket_event_id_fkey FOREIGN KEY (event_id) REFERENCES event (id) NOT VALID,
+    ADD CONSTRAINT acquired_ticket_show_id_fkey FOREIGN KEY (show_id) REFERENCES event_show (id) NOT VALID,
+    ADD CONSTRAINT acquired_ticket_ticket_stock_id_fkey FOREIGN KEY (ticket_stock_id) REFERENCES ticket_stock (id) NOT VALID;
+
+CREATE INDEX IF NOT EXISTS acquired_ticket_ticket_stock_id_idx ON acquired_ticket (ticket_stock_id);
+
+DO $$
+DECLARE
+    c RECORD;
+BEGIN
+    FOR c IN
+        SELECT conrelid::regclass AS tbl, conname
+        FROM pg_constraint
+        WHERE contype = 'f' AND NOT convalidated AND conname IN (
+            'event_show_event_id_fkey',
+            'event_show_location_event_id_fkey',
+            'event_show_location_show_id_fkey',
+            'ticket_stock_event_id_fkey',
+            'ticket_stock_show_id_fkey',
+            'acquired_ticket_event_id_fkey',
+            'acquired_ticket_show_id_fkey',
+            'acquired_ticket_ticket_stock_id_fkey'
+        )
+    LOOP
+        BEGIN
+            EXECUTE format('ALTER TABLE %s VALIDATE CONSTRAINT %I', c.tbl, c.conname);
+        EXCEPTION WHEN foreign_key_violation THEN
+            RAISE WARNING '% of % is left NOT VALID, some of its rows reference missing ones', c.conname, c.tbl;
+        END;
+    END LOOP;
+END $$;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS acquired_ticket_event_id_idx;
+DROP INDEX IF EXISTS acquired_ticket_show_id_idx;
+
+ALTER TABLE acquired_ticket DROP COLUMN IF EXISTS checked_in_at;
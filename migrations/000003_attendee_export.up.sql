@@ -0,0 +1,9 @@
+-- Attendee export.
+--
+-- The tickets of a show or of an event are exported in the order they are issued, a ticket is checked in once it is
+-- scanned at the gate.
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS acquired_ticket_show_id_idx ON acquired_ticket (show_id, id);
+CREATE INDEX IF NOT EXISTS acquired_ticket_event_id_idx ON acquired_ticket (event_id, id);
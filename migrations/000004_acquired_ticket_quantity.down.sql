@@ -0,0 +1,3 @@
+ALTER TABLE acquired_ticket DROP CONSTRAINT IF EXISTS acquired_ticket_quantity_check;
+
+ALTER TABLE acquired_ticket DROP COLUMN IF EXISTS quantity;
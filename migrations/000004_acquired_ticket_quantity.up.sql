@@ -0,0 +1,9 @@
+-- Quantity of the acquired tickets.
+--
+-- An acquired ticket which is issued for an order without seats stands for every ticket of its order item, the ones
+-- of a seat or of a ticket hold stand for one. The tickets which are acquired before are counted as one each, the way
+-- they are counted until now.
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS quantity BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE acquired_ticket ADD CONSTRAINT acquired_ticket_quantity_check CHECK (quantity > 0);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS waitlist_entry_unnotified_offer_idx;
+
+ALTER TABLE waitlist_entry DROP COLUMN IF EXISTS notified_at;
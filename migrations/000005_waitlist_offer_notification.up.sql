@@ -0,0 +1,10 @@
+-- Notification of the waitlist offers.
+--
+-- An offer is notified once it is published to its customer, an offer which could not be published is published
+-- again by the offer scheduler while it lasts. The offers which are made before are taken as notified.
+
+ALTER TABLE waitlist_entry ADD COLUMN IF NOT EXISTS notified_at TIMESTAMPTZ NULL;
+
+UPDATE waitlist_entry SET notified_at = updated_at WHERE status = 'OFFERED' AND notified_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS waitlist_entry_unnotified_offer_idx ON waitlist_entry (ticket_stock_id) WHERE status = 'OFFERED' AND notified_at IS NULL;
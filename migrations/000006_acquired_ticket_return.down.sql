@@ -0,0 +1 @@
+ALTER TABLE acquired_ticket DROP COLUMN IF EXISTS returned_at;
@@ -0,0 +1,6 @@
+-- Return of the acquired tickets.
+--
+-- The tickets of an order which is refunded or cancelled after it was paid go back to their ticket stock, a returned
+-- ticket is kept for the records but it no longer counts as sold nor as an attendee.
+
+ALTER TABLE acquired_ticket ADD COLUMN IF NOT EXISTS returned_at TIMESTAMPTZ NULL;
@@ -0,0 +1,19 @@
+// Package migrations embeds the versioned SQL migrations of the schema of the service, they are applied by
+// "app migrate up".
+package migrations
+
+import (
+	"embed"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+)
+
+// FS holds the migrations, e.g. "000001_initial_schema.up.sql" and "000001_initial_schema.down.sql".
+//
+//go:embed *.sql
+var FS embed.FS
+
+// Prepare holds the steps of the migrations which are written in Go by the version of their migration.
+var Prepare = map[int64]migration.Func{
+	1: slugRegistryNames,
+}
@@ -0,0 +1,77 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+// maxRegistrySlugLength is the length of the slug column of the artist and the promotor tables.
+const maxRegistrySlugLength = 128
+
+// registryNames are the tables which list the artists and the promotors of the events by their names on a database
+// which has not been linked to the registry yet, along with the prefix of the slugs which are generated for them.
+var registryNames = []struct {
+	table  string
+	prefix string
+}{
+	{table: "event_artist", prefix: "artist"},
+	{table: "event_promotor", prefix: "promotor"},
+}
+
+// slugRegistryNames gives the names of the artists and the promotors of the events the slug of util.Slugify, which is
+// the one the registry matches the names by, so the initial schema registers and links them the same way as the
+// service does. A name which has no letters nor digits is given a generated slug of its own so it is linked as well.
+func slugRegistryNames(ctx context.Context, tx pgx.Tx) error {
+	for _, rn := range registryNames {
+		var named bool
+		query := `SELECT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = $1 AND column_name = 'name')`
+		if err := tx.QueryRow(ctx, query, rn.table).Scan(&named); err != nil {
+			return fmt.Errorf("slugging the names of %s: %w", rn.table, err)
+		}
+
+		if !named {
+			continue
+		}
+
+		if _, err := tx.Exec(ctx, `ALTER TABLE `+rn.table+` ADD COLUMN IF NOT EXISTS slug VARCHAR(128) NULL`); err != nil {
+			return fmt.Errorf("slugging the names of %s: %w", rn.table, err)
+		}
+
+		rows, err := tx.Query(ctx, `SELECT DISTINCT name FROM `+rn.table)
+		if err != nil {
+			return fmt.Errorf("slugging the names of %s: %w", rn.table, err)
+		}
+
+		names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("slugging the names of %s: %w", rn.table, err)
+		}
+
+		for _, name := range names {
+			if _, err := tx.Exec(ctx, `UPDATE `+rn.table+` SET slug = $1 WHERE name = $2`, registrySlug(rn.prefix, name), name); err != nil {
+				return fmt.Errorf("slugging the names of %s: %w", rn.table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// registrySlug returns the slug of the name which fits in the slug column, or a generated one when the name has no
+// letters nor digits.
+func registrySlug(prefix, name string) string {
+	slug := []rune(util.Slugify(name))
+	if len(slug) > maxRegistrySlugLength {
+		slug = []rune(strings.TrimRight(string(slug[:maxRegistrySlugLength]), "-"))
+	}
+
+	if len(slug) == 0 {
+		return prefix + "-" + util.GenerateUniqueID(util.Lowercase+util.Numeric, 16)
+	}
+
+	return string(slug)
+}
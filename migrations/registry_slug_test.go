@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/internal/pkg/util"
+)
+
+func TestRegistrySlug(t *testing.T) {
+	t.Run("the slug is the one of the service", func(t *testing.T) {
+		for _, name := range []string{"Béyoncé & Jay-Z", "Sigur Rós", "Mötley Crüe", "宇多田ヒカル", "Raisa"} {
+			assert.Equal(t, util.Slugify(name), registrySlug("artist", name), name)
+		}
+	})
+
+	t.Run("a name without letters nor digits is given a slug of its own", func(t *testing.T) {
+		slug := registrySlug("artist", "★★★")
+
+		assert.True(t, strings.HasPrefix(slug, "artist-"))
+		assert.NotEqual(t, slug, registrySlug("artist", "★★★"))
+	})
+
+	t.Run("a long slug fits in the slug column", func(t *testing.T) {
+		slug := registrySlug("promotor", strings.Repeat("ab ", 100))
+
+		assert.LessOrEqual(t, utf8.RuneCountInString(slug), maxRegistrySlugLength)
+		assert.False(t, strings.HasSuffix(slug, "-"))
+	})
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/tsel-ticketmaster/tm-event/pkg/status"
 )
@@ -11,6 +12,18 @@ type AppError struct {
 	HTTPStatusCode int
 	Status         string
 	Message        string
+	Errors         []FieldError
+	cause          error
+}
+
+// FieldError describes why the value of a single field of a request is not valid. The field is given as a path of
+// the json names, e.g. "shows[0].ticket_allocation[1].tier", the rule is the violated validation tag along with its
+// param, if any.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
 }
 
 // Error implements error.
@@ -32,6 +45,33 @@ func New(httpStatusCode int, status string, message string) *AppError {
 	return err
 }
 
+// Wrap returns an error like New which keeps the error it is caused by, e.g. the error of the database, so it can be
+// told apart with errors.As while the response only carries the message.
+func Wrap(cause error, httpStatusCode int, status string, message string) *AppError {
+	err := New(httpStatusCode, status, message)
+	err.cause = cause
+
+	return err
+}
+
+// Unwrap returns the error the AppError is caused by, if any.
+func (e *AppError) Unwrap() error {
+	return e.cause
+}
+
+// NewValidation returns a bad request error which carries the given field errors, its message joins their messages.
+func NewValidation(fieldErrors []FieldError) *AppError {
+	messages := make([]string, len(fieldErrors))
+	for k, v := range fieldErrors {
+		messages[k] = v.Message
+	}
+
+	err := New(http.StatusBadRequest, status.BAD_REQUEST, strings.Join(messages, ", "))
+	err.Errors = fieldErrors
+
+	return err
+}
+
 func Destruct(err error) *AppError {
 	if err == nil {
 		return nil
@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// catalog holds the messages of a single locale by their keys along with the translations of the nouns which are
+// given to the messages as arguments and the texts of the statuses.
+type catalog struct {
+	messages map[Key]string
+	nouns    map[string]string
+	statuses map[string]string
+}
+
+var placeholder = regexp.MustCompile(`\{(\d+)\}`)
+
+// format fills the arguments in the placeholders of the message, a noun is translated as well.
+func (c catalog) format(message string, args []any) string {
+	return placeholder.ReplaceAllStringFunc(message, func(s string) string {
+		i, _ := strconv.Atoi(s[1 : len(s)-1])
+		if i < 1 || i > len(args) {
+			return s
+		}
+
+		if noun, ok := args[i-1].(Noun); ok {
+			if translated, ok := c.nouns[string(noun)]; ok {
+				return translated
+			}
+			return string(noun)
+		}
+
+		return fmt.Sprint(args[i-1])
+	})
+}
+
+var catalogs = map[language.Tag]catalog{
+	language.English:    english,
+	language.Indonesian: indonesian,
+}
+
+// Translate returns the message of the key in the given locale. A message which is not in the catalog of the locale
+// is given in english.
+func Translate(locale language.Tag, key Key, args ...any) string {
+	c, ok := catalogs[locale]
+	if !ok {
+		c = english
+	}
+
+	message, ok := c.messages[key]
+	if !ok {
+		c = english
+		if message, ok = c.messages[key]; !ok {
+			return string(key)
+		}
+	}
+
+	return c.format(message, args)
+}
+
+// Message returns the message of the key in the locale carried by the context, see Translate.
+func Message(ctx context.Context, key Key, args ...any) string {
+	return Translate(FromContext(ctx), key, args...)
+}
+
+// StatusText returns the human readable text of the given status, e.g. "NOT_FOUND", in the given locale.
+func StatusText(locale language.Tag, status string) string {
+	if c, ok := catalogs[locale]; ok {
+		if text, ok := c.statuses[status]; ok {
+			return text
+		}
+	}
+
+	if text, ok := english.statuses[status]; ok {
+		return text
+	}
+
+	return strings.ToLower(strings.ReplaceAll(status, "_", " "))
+}
@@ -0,0 +1,134 @@
+package i18n
+
+import "github.com/tsel-ticketmaster/tm-event/pkg/status"
+
+var english = catalog{
+	messages: map[Key]string{
+		Data:       "{1}",
+		ListOf:     "list of {1}",
+		Done:       "{1} has been successfully {2}",
+		DonePlural: "{1} have been successfully {2}",
+
+		NotFoundByID:      "{1}'s properties with id '{2}' is not found",
+		NotFoundByCode:    "{1}'s properties with code '{2}' is not found",
+		NotFoundByName:    "{1} '{2}' is not found",
+		AlreadyExistsByID: "{1}'s properties with id '{2}' already exists",
+		SomeAlreadyExist:  "some of the {1}' properties already exist",
+		SomeNotFound:      "some of the {1} of the {2} are not found",
+
+		InvalidToken:           "invalid token",
+		TokenExpiredOrNotReady: "token is either expired or not ready to use",
+		InvalidUserType:        "invalid type of user",
+		SessionNotFound:        "user session is not found",
+		EmptyContext:           "request has an empty context",
+		InvalidContext:         "request has an invalid context",
+
+		InvalidValue:    "invalid '{1}' with value '{2}'",
+		MustBeAfter:     "'{1}' must be after '{2}'",
+		Required:        "'{1}' is required",
+		InvalidTimezone: "invalid timezone '{1}'",
+
+		NewPromotorContact:     "new promotor '{1}' requires an email and a phone",
+		OnlinePriceCurrency:    "online ticket price must be in the currency '{1}' of the event",
+		VenueCapacityExceeded:  "total ticket allocation {1} exceeds the capacity {2} of venue '{3}'",
+		ShowVenueOrLocation:    "show at '{1}' requires either a venue id or a location",
+		ShowAllocationPositive: "total ticket allocation of show at '{1}' must be greater than 0",
+		ShowTimeAfterOrderRule: "'show_time' must be after 'end_date' of the order rule range date",
+		LocaleTranslated:       "locale '{1}' is already translated by {2}",
+		TierAllocated:          "tier '{1}' is already allocated by {2}",
+		TierAllocationRange:    "allocation of tier '{1}' must be greater than 0 and at most 100 percent",
+		TierPriceCurrency:      "price of tier '{1}' must be in the currency '{2}' of the show",
+		AllocationSum:          "allocation percentages of the tiers sum up to {1} instead of 100",
+		UniqueViolation:        "{1}'s properties violate the unique constraint '{2}'",
+		ForeignKeyViolation:    "{1}'s properties refer to a missing row by '{2}'",
+		CheckViolation:         "{1}'s properties violate the check constraint '{2}'",
+
+		UnsupportedImportFormat:   "unsupported event import format '{1}'",
+		InvalidImportJSONL:        "invalid event import jsonl: {1}",
+		InvalidImportCSV:          "invalid event import csv: {1}",
+		UnknownImportColumn:       "unknown event import csv column '{1}'",
+		ImportEventColumnRequired: "event import csv requires the 'event' column",
+		ImportColumnDiffers:       "'{1}' differs from row {2} of the {3}",
+		InvalidCreateEventRequest: "invalid create event request: {1}",
+		EmptyImport:               "event import does not contain any event",
+		ImportTooLarge:            "event import file must not be larger than {1} bytes",
+
+		InvalidName:        "invalid {1}'s name '{2}'",
+		NameOrSlugRequired: "{1}'s name or slug must contain at least one letter or digit",
+		SlugUsed:           "{1}'s slug '{2}' is already used by {3} with id '{4}'",
+		StillLinked:        "{1} with id '{2}' is still linked to {3} event(s)",
+		MergeIntoItself:    "{1} with id '{2}' can not be merged into itself",
+		VenueStillUsed:     "venue with id '{1}' is still used by {2} show(s)",
+
+		SeatMapOfShowNotFound:     "seat map of event show with id '{1}' is not found",
+		SeatsUnavailable:          "some of the selected seats are no longer available",
+		OrderSeatsUnavailable:     "some of the seats of order '{1}' are no longer available",
+		OrderSeatCount:            "order '{1}' has {2} seats for {3} tickets",
+		OrderSeatStock:            "seat '{1}' of order '{2}' does not belong to the ticket stock '{3}'",
+		VenueWithoutSeatMap:       "venue '{1}' does not have a default seat map, seat map id is required",
+		ShowHasSeatMap:            "event show with id '{1}' already has a seat map",
+		SeatTierNotAllocated:      "tier '{1}' of seat '{2}' is not allocated for the show",
+		SeatTierExceedsAllocation: "seats of tier '{1}' exceed its allocation of {2}",
+		UnsupportedSeatMapFormat:  "unsupported seat map format '{1}'",
+		InvalidSeatMapJSON:        "invalid seat map json: {1}",
+		InvalidSeatMapCSV:         "invalid seat map csv: {1}",
+		InvalidSeatMapCSVHeader:   "invalid seat map csv header, expected '{1}'",
+		EmptySeatMap:              "seat map does not contain any seat",
+		SeatMapTooLarge:           "seat map file must not be larger than {1} bytes",
+		IncompleteSeat:            "seat number {1} has an incomplete section, row, number or tier",
+		DuplicatedSeat:            "seat '{1}' row '{2}' number '{3}' is duplicated",
+
+		PriceCurrency:      "price must be in the currency '{1}' of the ticket stock",
+		OrderPriceCurrency: "price of order '{1}' is not in the currency '{2}' of the ticket stock",
+		StockHoldAvailable: "only {1} tickets of ticket stock '{2}' are available to be held",
+		HoldReleaseLeft:    "only {1} tickets of ticket hold '{2}' can be released",
+		HoldIssueLeft:      "only {1} tickets of ticket hold '{2}' are left to be issued",
+		AllocationTooLow:   "allocation of ticket stock '{1}' must not be less than {2}",
+		AllocationsTooLow:  "allocation of the ticket stocks must not be less than their acquired, held and reserved tickets",
+		SoldOut:            "ticket stock '{1}' is sold out",
+		NotSoldOut:         "ticket stock '{1}' is not sold out",
+		TicketNumberExists: "ticket with number '{1}' already exists",
+		TicketNumbersExist: "some of the tickets' numbers already exist",
+		TicketsIssued:      "tickets of order '{1}' for ticket stock '{2}' are already issued",
+		OnWaitlist:         "customer is already on the waitlist of ticket stock '{1}'",
+		NotOnWaitlist:      "customer is not on the waitlist of ticket stock '{1}'",
+		EventNotPromoted:   "event '{1}' is not promoted by the promotor of the account",
+		TicketCheckedIn:    "ticket '{1}' is already checked in at {2}",
+
+		DiscountRequired:             "a discount code requires either 'discount_percentage' or 'discount_amount'",
+		DiscountExclusive:            "either 'discount_percentage' or 'discount_amount' can be set, not both",
+		DiscountCurrency:             "discount amount must be in the currency '{1}' of the event",
+		PromoCodeExists:              "promo code '{1}' already exists",
+		PromoCodeUnavailable:         "promo code '{1}' is not available",
+		PromoCodeNotApplicable:       "promo code '{1}' can not be redeemed for ticket stock '{2}'",
+		PromoCodeBeforeSale:          "promo code '{1}' can not be redeemed before the sale starts",
+		PromoCodeUsageLimit:          "promo code '{1}' has reached its usage limit",
+		PromoCodeCustomerLimit:       "promo code '{1}' has reached its limit per customer",
+		PromoRedemptionNotReleasable: "promo redemption '{1}' can no longer be released",
+		PromoRedemptionNotOwned:      "promo redemption '{1}' does not belong to the customer of order '{2}'",
+		PromoRedemptionConfirmed:     "promo redemption '{1}' has already been confirmed",
+
+		MediaKindNotAllowed:   "media of kind '{1}' is not allowed for {2}",
+		UnsupportedMediaOwner: "unsupported media owner '{1}'",
+		ImageTooLarge:         "image must not be larger than {1} bytes",
+		UnsupportedImageType:  "unsupported image type '{1}'",
+		InvalidImage:          "invalid image: {1}",
+	},
+	statuses: map[string]string{
+		status.OK:                     "ok",
+		status.CREATED:                "created",
+		status.BAD_REQUEST:            "bad request",
+		status.UNAUTHORIZED:           "unauthorized",
+		status.FORBIDDEN:              "forbidden",
+		status.NOT_FOUND:              "not found",
+		status.CONFLICT:               "conflict",
+		status.UNPROCESSABLE_ENTITY:   "unprocessable entity",
+		status.EXPECTATION_FAILED:     "expectation failed",
+		status.INTERNAL_SERVER_ERROR:  "internal server error",
+		status.ALREADY_EXIST:          "already exist",
+		status.ALREADY_SIGNED_IN:      "already signed in",
+		status.SEAT_UNAVAILABLE:       "seat is unavailable",
+		status.PROMO_CODE_UNAVAILABLE: "promo code is unavailable",
+		status.SOLD_OUT:               "sold out",
+	},
+}
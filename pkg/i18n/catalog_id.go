@@ -0,0 +1,199 @@
+package i18n
+
+import "github.com/tsel-ticketmaster/tm-event/pkg/status"
+
+var indonesian = catalog{
+	messages: map[Key]string{
+		Data:       "{1}",
+		ListOf:     "daftar {1}",
+		Done:       "{1} berhasil {2}",
+		DonePlural: "{1} berhasil {2}",
+
+		NotFoundByID:      "data {1} dengan id '{2}' tidak ditemukan",
+		NotFoundByCode:    "data {1} dengan kode '{2}' tidak ditemukan",
+		NotFoundByName:    "{1} '{2}' tidak ditemukan",
+		AlreadyExistsByID: "data {1} dengan id '{2}' sudah ada",
+		SomeAlreadyExist:  "sebagian data {1} sudah ada",
+		SomeNotFound:      "sebagian {1} dari {2} tidak ditemukan",
+
+		InvalidToken:           "token tidak valid",
+		TokenExpiredOrNotReady: "token sudah kedaluwarsa atau belum dapat digunakan",
+		InvalidUserType:        "tipe pengguna tidak valid",
+		SessionNotFound:        "sesi pengguna tidak ditemukan",
+		EmptyContext:           "permintaan tidak memiliki konteks",
+		InvalidContext:         "permintaan memiliki konteks yang tidak valid",
+
+		InvalidValue:    "'{1}' dengan nilai '{2}' tidak valid",
+		MustBeAfter:     "'{1}' harus setelah '{2}'",
+		Required:        "'{1}' wajib diisi",
+		InvalidTimezone: "zona waktu '{1}' tidak valid",
+
+		NewPromotorContact:     "promotor baru '{1}' memerlukan email dan nomor telepon",
+		OnlinePriceCurrency:    "harga tiket online harus dalam mata uang '{1}' dari acara",
+		VenueCapacityExceeded:  "total alokasi tiket {1} melebihi kapasitas {2} dari tempat '{3}'",
+		ShowVenueOrLocation:    "pertunjukan di '{1}' memerlukan id tempat atau lokasi",
+		ShowAllocationPositive: "total alokasi tiket pertunjukan di '{1}' harus lebih besar dari 0",
+		ShowTimeAfterOrderRule: "'show_time' harus setelah 'end_date' dari aturan rentang tanggal pemesanan",
+		LocaleTranslated:       "locale '{1}' sudah diterjemahkan oleh {2}",
+		TierAllocated:          "tier '{1}' sudah dialokasikan oleh {2}",
+		TierAllocationRange:    "alokasi tier '{1}' harus lebih besar dari 0 dan paling banyak 100 persen",
+		TierPriceCurrency:      "harga tier '{1}' harus dalam mata uang '{2}' dari pertunjukan",
+		AllocationSum:          "jumlah persentase alokasi tier adalah {1}, bukan 100",
+		UniqueViolation:        "data {1} melanggar batasan unik '{2}'",
+		ForeignKeyViolation:    "data {1} merujuk ke data yang tidak ada melalui '{2}'",
+		CheckViolation:         "data {1} melanggar batasan '{2}'",
+
+		UnsupportedImportFormat:   "format impor acara '{1}' tidak didukung",
+		InvalidImportJSONL:        "jsonl impor acara tidak valid: {1}",
+		InvalidImportCSV:          "csv impor acara tidak valid: {1}",
+		UnknownImportColumn:       "kolom csv impor acara '{1}' tidak dikenal",
+		ImportEventColumnRequired: "csv impor acara memerlukan kolom 'event'",
+		ImportColumnDiffers:       "'{1}' berbeda dengan baris {2} dari {3}",
+		InvalidCreateEventRequest: "permintaan pembuatan acara tidak valid: {1}",
+		EmptyImport:               "impor acara tidak memiliki acara",
+		ImportTooLarge:            "berkas impor acara tidak boleh lebih besar dari {1} byte",
+
+		InvalidName:        "nama {1} '{2}' tidak valid",
+		NameOrSlugRequired: "nama atau slug {1} harus mengandung setidaknya satu huruf atau angka",
+		SlugUsed:           "slug {1} '{2}' sudah digunakan oleh {3} dengan id '{4}'",
+		StillLinked:        "{1} dengan id '{2}' masih terhubung dengan {3} acara",
+		MergeIntoItself:    "{1} dengan id '{2}' tidak dapat digabungkan dengan dirinya sendiri",
+		VenueStillUsed:     "tempat dengan id '{1}' masih digunakan oleh {2} pertunjukan",
+
+		SeatMapOfShowNotFound:     "denah kursi pertunjukan dengan id '{1}' tidak ditemukan",
+		SeatsUnavailable:          "sebagian kursi yang dipilih sudah tidak tersedia",
+		OrderSeatsUnavailable:     "sebagian kursi dari pesanan '{1}' sudah tidak tersedia",
+		OrderSeatCount:            "pesanan '{1}' memiliki {2} kursi untuk {3} tiket",
+		OrderSeatStock:            "kursi '{1}' dari pesanan '{2}' bukan bagian dari stok tiket '{3}'",
+		VenueWithoutSeatMap:       "tempat '{1}' tidak memiliki denah kursi bawaan, id denah kursi wajib diisi",
+		ShowHasSeatMap:            "pertunjukan dengan id '{1}' sudah memiliki denah kursi",
+		SeatTierNotAllocated:      "tier '{1}' dari kursi '{2}' tidak dialokasikan untuk pertunjukan",
+		SeatTierExceedsAllocation: "kursi tier '{1}' melebihi alokasinya sebanyak {2}",
+		UnsupportedSeatMapFormat:  "format denah kursi '{1}' tidak didukung",
+		InvalidSeatMapJSON:        "json denah kursi tidak valid: {1}",
+		InvalidSeatMapCSV:         "csv denah kursi tidak valid: {1}",
+		InvalidSeatMapCSVHeader:   "header csv denah kursi tidak valid, seharusnya '{1}'",
+		EmptySeatMap:              "denah kursi tidak memiliki kursi",
+		SeatMapTooLarge:           "berkas denah kursi tidak boleh lebih besar dari {1} byte",
+		IncompleteSeat:            "kursi nomor {1} memiliki section, baris, nomor, atau tier yang tidak lengkap",
+		DuplicatedSeat:            "kursi '{1}' baris '{2}' nomor '{3}' duplikat",
+
+		PriceCurrency:      "harga harus dalam mata uang '{1}' dari stok tiket",
+		OrderPriceCurrency: "harga pesanan '{1}' tidak dalam mata uang '{2}' dari stok tiket",
+		StockHoldAvailable: "hanya {1} tiket dari stok tiket '{2}' yang tersedia untuk ditahan",
+		HoldReleaseLeft:    "hanya {1} tiket dari penahanan tiket '{2}' yang dapat dilepas",
+		HoldIssueLeft:      "hanya tersisa {1} tiket dari penahanan tiket '{2}' yang dapat diterbitkan",
+		AllocationTooLow:   "alokasi stok tiket '{1}' tidak boleh kurang dari {2}",
+		AllocationsTooLow:  "alokasi stok tiket tidak boleh kurang dari tiket yang sudah dimiliki, ditahan, dan dipesan",
+		SoldOut:            "stok tiket '{1}' sudah habis terjual",
+		NotSoldOut:         "stok tiket '{1}' belum habis terjual",
+		TicketNumberExists: "tiket dengan nomor '{1}' sudah ada",
+		TicketNumbersExist: "sebagian nomor tiket sudah ada",
+		TicketsIssued:      "tiket pesanan '{1}' untuk stok tiket '{2}' sudah diterbitkan",
+		OnWaitlist:         "pelanggan sudah ada dalam daftar tunggu stok tiket '{1}'",
+		NotOnWaitlist:      "pelanggan tidak ada dalam daftar tunggu stok tiket '{1}'",
+		EventNotPromoted:   "acara '{1}' tidak dipromosikan oleh promotor dari akun ini",
+		TicketCheckedIn:    "tiket '{1}' sudah check in pada {2}",
+
+		DiscountRequired:             "kode diskon memerlukan 'discount_percentage' atau 'discount_amount'",
+		DiscountExclusive:            "hanya salah satu dari 'discount_percentage' atau 'discount_amount' yang dapat diisi",
+		DiscountCurrency:             "nominal diskon harus dalam mata uang '{1}' dari acara",
+		PromoCodeExists:              "kode promo '{1}' sudah ada",
+		PromoCodeUnavailable:         "kode promo '{1}' tidak tersedia",
+		PromoCodeNotApplicable:       "kode promo '{1}' tidak dapat digunakan untuk stok tiket '{2}'",
+		PromoCodeBeforeSale:          "kode promo '{1}' tidak dapat digunakan sebelum penjualan dimulai",
+		PromoCodeUsageLimit:          "kode promo '{1}' telah mencapai batas penggunaan",
+		PromoCodeCustomerLimit:       "kode promo '{1}' telah mencapai batas per pelanggan",
+		PromoRedemptionNotReleasable: "penukaran promo '{1}' sudah tidak dapat dilepas",
+		PromoRedemptionNotOwned:      "penukaran promo '{1}' bukan milik pelanggan dari pesanan '{2}'",
+		PromoRedemptionConfirmed:     "penukaran promo '{1}' sudah dikonfirmasi",
+
+		MediaKindNotAllowed:   "media jenis '{1}' tidak diperbolehkan untuk {2}",
+		UnsupportedMediaOwner: "pemilik media '{1}' tidak didukung",
+		ImageTooLarge:         "gambar tidak boleh lebih besar dari {1} byte",
+		UnsupportedImageType:  "tipe gambar '{1}' tidak didukung",
+		InvalidImage:          "gambar tidak valid: {1}",
+	},
+	nouns: map[string]string{
+		"artist":                    "artis",
+		"artists":                   "artis",
+		"artist's upcoming event":   "acara mendatang artis",
+		"promotor":                  "promotor",
+		"promotors":                 "promotor",
+		"promotor's upcoming event": "acara mendatang promotor",
+		"event":                     "acara",
+		"event show":                "pertunjukan",
+		"event shows":               "pertunjukan",
+		"event show location":       "lokasi pertunjukan",
+		"event show locations":      "lokasi pertunjukan",
+		"locations":                 "lokasi",
+		"show":                      "pertunjukan",
+		"nearby show":               "pertunjukan terdekat",
+		"show tickets":              "tiket pertunjukan",
+		"acquired tickets":          "tiket yang dimiliki",
+		"venue":                     "tempat",
+		"venues":                    "tempat",
+		"seat map":                  "denah kursi",
+		"seat map of show":          "denah kursi pertunjukan",
+		"media":                     "media",
+		"category":                  "kategori",
+		"genre":                     "genre",
+		"tag":                       "tag",
+		"event taxonomy":            "taksonomi acara",
+		"events":                    "acara",
+		"seats":                     "kursi",
+		"ticket stock":              "stok tiket",
+		"ticket stocks":             "stok tiket",
+		"ticket hold":               "penahanan tiket",
+		"ticket":                    "tiket",
+		"comp tickets":              "tiket gratis",
+		"ticket price rule":         "aturan harga tiket",
+		"ticket price quote":        "penawaran harga tiket",
+		"price quote":               "penawaran harga",
+		"promo code":                "kode promo",
+		"promo redemption":          "penukaran promo",
+		"order rule range date":     "aturan rentang tanggal pemesanan",
+		"followed event":            "acara yang diikuti",
+		"followed artist":           "artis yang diikuti",
+		"waitlist":                  "daftar tunggu",
+		"waitlist entry":            "entri daftar tunggu",
+		"sales report":              "laporan penjualan",
+
+		"created":              "dibuat",
+		"updated":              "diperbarui",
+		"deleted":              "dihapus",
+		"merged":               "digabungkan",
+		"imported":             "diimpor",
+		"validated":            "divalidasi",
+		"checked":              "diperiksa",
+		"held":                 "ditahan",
+		"released":             "dilepas",
+		"redeemed":             "digunakan",
+		"deactivated":          "dinonaktifkan",
+		"uploaded":             "diunggah",
+		"issued":               "diterbitkan",
+		"checked in":           "check in",
+		"assigned to the show": "ditetapkan untuk pertunjukan",
+		"followed":             "diikuti",
+		"unfollowed":           "berhenti diikuti",
+		"joined":               "diikuti",
+		"left":                 "ditinggalkan",
+	},
+	statuses: map[string]string{
+		status.OK:                     "berhasil",
+		status.CREATED:                "berhasil dibuat",
+		status.BAD_REQUEST:            "permintaan tidak valid",
+		status.UNAUTHORIZED:           "tidak terautentikasi",
+		status.FORBIDDEN:              "akses ditolak",
+		status.NOT_FOUND:              "tidak ditemukan",
+		status.CONFLICT:               "terjadi konflik",
+		status.UNPROCESSABLE_ENTITY:   "permintaan tidak dapat diproses",
+		status.EXPECTATION_FAILED:     "ekspektasi gagal",
+		status.INTERNAL_SERVER_ERROR:  "terjadi kesalahan pada server",
+		status.ALREADY_EXIST:          "sudah ada",
+		status.ALREADY_SIGNED_IN:      "sudah masuk",
+		status.SEAT_UNAVAILABLE:       "kursi tidak tersedia",
+		status.PROMO_CODE_UNAVAILABLE: "kode promo tidak tersedia",
+		status.SOLD_OUT:               "habis terjual",
+	},
+}
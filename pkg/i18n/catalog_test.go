@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func placeholders(message string) []string {
+	found := placeholder.FindAllString(message, -1)
+	sort.Strings(found)
+
+	return found
+}
+
+// TestCatalogs checks that every message is translated into every locale with the same arguments as in english.
+func TestCatalogs(t *testing.T) {
+	for locale, c := range catalogs {
+		for key, message := range english.messages {
+			translated, ok := c.messages[key]
+			if !assert.True(t, ok, "%s: message %q is not translated", locale, key) {
+				continue
+			}
+			assert.ElementsMatch(t, placeholders(message), placeholders(translated), "%s: message %q", locale, key)
+		}
+
+		for key := range c.messages {
+			_, ok := english.messages[key]
+			assert.True(t, ok, "%s: message %q is not in english", locale, key)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Package i18n negotiates the locale of a request and translates the messages of the responses into it.
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the locale used when none of the locales accepted by the client is supported.
+var DefaultLocale = language.English
+
+// Supported are the locales the messages and the contents are available in, the first one is the default.
+var Supported = []language.Tag{
+	language.English,
+	language.Indonesian,
+}
+
+var matcher = language.NewMatcher(Supported)
+
+type localeContextKey struct{}
+
+// Negotiate returns the supported locale which matches the given Accept-Language header the best.
+func Negotiate(acceptLanguage string) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+
+	return Supported[index]
+}
+
+// NewContext returns a copy of the context which carries the given locale.
+func NewContext(ctx context.Context, locale language.Tag) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// FromContext returns the locale carried by the context, it falls back to the default locale.
+func FromContext(ctx context.Context) language.Tag {
+	locale, ok := ctx.Value(localeContextKey{}).(language.Tag)
+	if !ok {
+		return DefaultLocale
+	}
+
+	return locale
+}
@@ -0,0 +1,73 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/text/language"
+)
+
+func TestNegotiate(t *testing.T) {
+	testCases := []struct {
+		acceptLanguage string
+		want           language.Tag
+	}{
+		{acceptLanguage: "", want: language.English},
+		{acceptLanguage: "id", want: language.Indonesian},
+		{acceptLanguage: "id-ID,id;q=0.9,en;q=0.8", want: language.Indonesian},
+		{acceptLanguage: "fr-FR,en;q=0.5,id;q=0.4", want: language.English},
+		{acceptLanguage: "ja", want: language.English},
+		{acceptLanguage: "not a language;;", want: language.English},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, tc.want, i18n.Negotiate(tc.acceptLanguage))
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		locale language.Tag
+		key    i18n.Key
+		args   []any
+		want   string
+	}{
+		{name: "english list", locale: language.English, key: i18n.ListOf, args: []any{i18n.Noun("event")}, want: "list of event"},
+		{name: "indonesian list", locale: language.Indonesian, key: i18n.ListOf, args: []any{i18n.Noun("event")}, want: "daftar acara"},
+		{name: "indonesian done", locale: language.Indonesian, key: i18n.Done, args: []any{i18n.Noun("venue"), i18n.Noun("created")}, want: "tempat berhasil dibuat"},
+		{name: "english not found", locale: language.English, key: i18n.NotFoundByID, args: []any{i18n.Noun("event"), "EVENT1"}, want: "event's properties with id 'EVENT1' is not found"},
+		{name: "indonesian not found", locale: language.Indonesian, key: i18n.NotFoundByID, args: []any{i18n.Noun("event"), "EVENT1"}, want: "data acara dengan id 'EVENT1' tidak ditemukan"},
+		{name: "indonesian number", locale: language.Indonesian, key: i18n.HoldReleaseLeft, args: []any{int64(3), "HOLD1"}, want: "hanya 3 tiket dari penahanan tiket 'HOLD1' yang dapat dilepas"},
+		{name: "indonesian float", locale: language.Indonesian, key: i18n.AllocationSum, args: []any{99.5}, want: "jumlah persentase alokasi tier adalah 99.5, bukan 100"},
+		{name: "indonesian unknown noun", locale: language.Indonesian, key: i18n.NotFoundByName, args: []any{i18n.Noun("opera"), "x"}, want: "opera 'x' tidak ditemukan"},
+		{name: "text argument is not translated", locale: language.Indonesian, key: i18n.SoldOut, args: []any{"event"}, want: "stok tiket 'event' sudah habis terjual"},
+		{name: "unsupported locale", locale: language.Japanese, key: i18n.SoldOut, args: []any{"STOCK1"}, want: "ticket stock 'STOCK1' is sold out"},
+		{name: "missing argument", locale: language.English, key: i18n.SoldOut, want: "ticket stock '{1}' is sold out"},
+		{name: "unknown key", locale: language.Indonesian, key: i18n.Key("unknown"), want: "unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, i18n.Translate(tc.locale, tc.key, tc.args...))
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	ctx := i18n.NewContext(context.Background(), language.Indonesian)
+
+	assert.Equal(t, "daftar acara", i18n.Message(ctx, i18n.ListOf, i18n.Noun("event")))
+	assert.Equal(t, "list of event", i18n.Message(context.Background(), i18n.ListOf, i18n.Noun("event")))
+}
+
+func TestStatusText(t *testing.T) {
+	assert.Equal(t, "not found", i18n.StatusText(language.English, status.NOT_FOUND))
+	assert.Equal(t, "tidak ditemukan", i18n.StatusText(language.Indonesian, status.NOT_FOUND))
+	assert.Equal(t, "some status", i18n.StatusText(language.Indonesian, "SOME_STATUS"))
+}
@@ -0,0 +1,122 @@
+package i18n
+
+// Key identifies a message in the catalogs, it stays the same whatever the wording of the message is in any locale.
+type Key string
+
+// Noun is an argument of a message which is translated along with it, e.g. the name of an entity.
+type Noun string
+
+// The keys of the messages. A message refers to its arguments by their position, e.g. "{1}", the english catalog
+// tells their order.
+const (
+	// the messages which describe the data of a response or the outcome of a request
+	Data       Key = "data"
+	ListOf     Key = "list_of"
+	Done       Key = "done"
+	DonePlural Key = "done_plural"
+
+	NotFoundByID      Key = "not_found_by_id"
+	NotFoundByCode    Key = "not_found_by_code"
+	NotFoundByName    Key = "not_found_by_name"
+	AlreadyExistsByID Key = "already_exists_by_id"
+	SomeAlreadyExist  Key = "some_already_exist"
+	SomeNotFound      Key = "some_not_found"
+
+	InvalidToken           Key = "invalid_token"
+	TokenExpiredOrNotReady Key = "token_expired_or_not_ready"
+	InvalidUserType        Key = "invalid_user_type"
+	SessionNotFound        Key = "session_not_found"
+	EmptyContext           Key = "empty_context"
+	InvalidContext         Key = "invalid_context"
+
+	InvalidValue    Key = "invalid_value"
+	MustBeAfter     Key = "must_be_after"
+	Required        Key = "required"
+	InvalidTimezone Key = "invalid_timezone"
+
+	NewPromotorContact     Key = "new_promotor_contact"
+	OnlinePriceCurrency    Key = "online_price_currency"
+	VenueCapacityExceeded  Key = "venue_capacity_exceeded"
+	ShowVenueOrLocation    Key = "show_venue_or_location"
+	ShowAllocationPositive Key = "show_allocation_positive"
+	ShowTimeAfterOrderRule Key = "show_time_after_order_rule"
+	LocaleTranslated       Key = "locale_translated"
+	TierAllocated          Key = "tier_allocated"
+	TierAllocationRange    Key = "tier_allocation_range"
+	TierPriceCurrency      Key = "tier_price_currency"
+	AllocationSum          Key = "allocation_sum"
+	UniqueViolation        Key = "unique_violation"
+	ForeignKeyViolation    Key = "foreign_key_violation"
+	CheckViolation         Key = "check_violation"
+
+	UnsupportedImportFormat   Key = "unsupported_import_format"
+	InvalidImportJSONL        Key = "invalid_import_jsonl"
+	InvalidImportCSV          Key = "invalid_import_csv"
+	UnknownImportColumn       Key = "unknown_import_column"
+	ImportEventColumnRequired Key = "import_event_column_required"
+	ImportColumnDiffers       Key = "import_column_differs"
+	InvalidCreateEventRequest Key = "invalid_create_event_request"
+	EmptyImport               Key = "empty_import"
+	ImportTooLarge            Key = "import_too_large"
+
+	InvalidName        Key = "invalid_name"
+	NameOrSlugRequired Key = "name_or_slug_required"
+	SlugUsed           Key = "slug_used"
+	StillLinked        Key = "still_linked"
+	MergeIntoItself    Key = "merge_into_itself"
+	VenueStillUsed     Key = "venue_still_used"
+
+	SeatMapOfShowNotFound     Key = "seat_map_of_show_not_found"
+	SeatsUnavailable          Key = "seats_unavailable"
+	OrderSeatsUnavailable     Key = "order_seats_unavailable"
+	OrderSeatCount            Key = "order_seat_count"
+	OrderSeatStock            Key = "order_seat_stock"
+	VenueWithoutSeatMap       Key = "venue_without_seat_map"
+	ShowHasSeatMap            Key = "show_has_seat_map"
+	SeatTierNotAllocated      Key = "seat_tier_not_allocated"
+	SeatTierExceedsAllocation Key = "seat_tier_exceeds_allocation"
+	UnsupportedSeatMapFormat  Key = "unsupported_seat_map_format"
+	InvalidSeatMapJSON        Key = "invalid_seat_map_json"
+	InvalidSeatMapCSV         Key = "invalid_seat_map_csv"
+	InvalidSeatMapCSVHeader   Key = "invalid_seat_map_csv_header"
+	EmptySeatMap              Key = "empty_seat_map"
+	SeatMapTooLarge           Key = "seat_map_too_large"
+	IncompleteSeat            Key = "incomplete_seat"
+	DuplicatedSeat            Key = "duplicated_seat"
+
+	PriceCurrency      Key = "price_currency"
+	OrderPriceCurrency Key = "order_price_currency"
+	StockHoldAvailable Key = "stock_hold_available"
+	HoldReleaseLeft    Key = "hold_release_left"
+	HoldIssueLeft      Key = "hold_issue_left"
+	AllocationTooLow   Key = "allocation_too_low"
+	AllocationsTooLow  Key = "allocations_too_low"
+	SoldOut            Key = "sold_out"
+	NotSoldOut         Key = "not_sold_out"
+	TicketNumberExists Key = "ticket_number_exists"
+	TicketNumbersExist Key = "ticket_numbers_exist"
+	TicketsIssued      Key = "tickets_issued"
+	OnWaitlist         Key = "on_waitlist"
+	NotOnWaitlist      Key = "not_on_waitlist"
+	EventNotPromoted   Key = "event_not_promoted"
+	TicketCheckedIn    Key = "ticket_checked_in"
+
+	DiscountRequired             Key = "discount_required"
+	DiscountExclusive            Key = "discount_exclusive"
+	DiscountCurrency             Key = "discount_currency"
+	PromoCodeExists              Key = "promo_code_exists"
+	PromoCodeUnavailable         Key = "promo_code_unavailable"
+	PromoCodeNotApplicable       Key = "promo_code_not_applicable"
+	PromoCodeBeforeSale          Key = "promo_code_before_sale"
+	PromoCodeUsageLimit          Key = "promo_code_usage_limit"
+	PromoCodeCustomerLimit       Key = "promo_code_customer_limit"
+	PromoRedemptionNotReleasable Key = "promo_redemption_not_releasable"
+	PromoRedemptionNotOwned      Key = "promo_redemption_not_owned"
+	PromoRedemptionConfirmed     Key = "promo_redemption_confirmed"
+
+	MediaKindNotAllowed   Key = "media_kind_not_allowed"
+	UnsupportedMediaOwner Key = "unsupported_media_owner"
+	ImageTooLarge         Key = "image_too_large"
+	UnsupportedImageType  Key = "unsupported_image_type"
+	InvalidImage          Key = "invalid_image"
+)
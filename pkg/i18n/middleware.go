@@ -0,0 +1,36 @@
+package i18n
+
+import (
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+// ResponseWriter is a http.ResponseWriter which knows the locale its response is written in.
+type ResponseWriter struct {
+	http.ResponseWriter
+	locale language.Tag
+}
+
+// Locale returns the negotiated locale of the response.
+func (w *ResponseWriter) Locale() language.Tag {
+	return w.locale
+}
+
+// Unwrap returns the original http.ResponseWriter.
+func (w *ResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Middleware negotiates the locale of the request from its Accept-Language header. The locale is carried by the
+// context of the request and by the response writer so that the response can be written in it.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := Negotiate(r.Header.Get("Accept-Language"))
+
+		w.Header().Set("Content-Language", locale.String())
+		w.Header().Add("Vary", "Accept-Language")
+
+		next.ServeHTTP(&ResponseWriter{ResponseWriter: w, locale: locale}, r.WithContext(NewContext(r.Context(), locale)))
+	})
+}
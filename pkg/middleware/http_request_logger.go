@@ -55,6 +55,11 @@ func (wrw wrappedResponseWriter) WriteHeader(statusCode int) {
 	wrw.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap returns the original http.ResponseWriter, e.g. for a http.ResponseController to flush it.
+func (wrw wrappedResponseWriter) Unwrap() http.ResponseWriter {
+	return wrw.ResponseWriter
+}
+
 func (wrw wrappedResponseWriter) Write(b []byte) (n int, err error) {
 	wrw.recorder.Write(b)
 	return wrw.ResponseWriter.Write(b)
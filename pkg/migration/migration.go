@@ -0,0 +1,299 @@
+// Package migration applies versioned SQL migrations to a Postgres database. The migrations are read from a file
+// system, e.g. an embed.FS, and are named "<version>_<name>.up.sql" and "<version>_<name>.down.sql". A migration may
+// be prepared by a Func when some of its values can not be computed in SQL.
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Table is the table which records the applied migrations, its name is the key of the advisory lock as well.
+const Table = "schema_migration"
+
+var filenamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Func is a step of a migration which is written in Go, e.g. a backfill of values which have to be computed the same
+// way as the service computes them.
+type Func func(ctx context.Context, tx pgx.Tx) error
+
+// Migration is a versioned change of the schema along with the statements which revert it. Prepare, when there is
+// one, runs right before the up statements within the same transaction.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+	Prepare Func
+}
+
+// Status is the state of a migration in the database.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Load reads the migrations from the root of the file system ordered by their version. Every version must have both
+// an up and a down file. The migrations are prepared by the funcs of their version, a func of a version which has no
+// migration is rejected.
+func Load(fsys fs.FS, prepare map[int64]Func) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := filenamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration: invalid filename '%s'", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration: invalid version of '%s': %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration: version %d is used by both '%s' and '%s'", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	for version, fn := range prepare {
+		m, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("migration: version %d of a prepare func has no migration", version)
+		}
+		m.Prepare = fn
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration: version %d '%s' must have both up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator is a collection of behavior of the migration runner. Every run holds a Postgres advisory lock so the
+// instances which start at the same time apply the migrations one after another.
+type Migrator interface {
+	// Up applies the pending migrations and returns them.
+	Up(ctx context.Context) ([]Migration, error)
+	// Down reverts the last applied migrations, at most steps of them, and returns them.
+	Down(ctx context.Context, steps int) ([]Migration, error)
+	// Status returns the state of every known migration.
+	Status(ctx context.Context) ([]Status, error)
+}
+
+type migrator struct {
+	db         *pgxpool.Pool
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator of the database, the migrations must be ordered by their version as returned by
+// Load.
+func NewMigrator(db *pgxpool.Pool, migrations []Migration) Migrator {
+	return &migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Up implements Migrator.
+func (m *migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn) error {
+		appliedAt, err := m.appliedAt(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := appliedAt[mig.Version]; ok {
+				continue
+			}
+
+			if err := m.apply(ctx, conn, mig.Prepare, mig.Up, `INSERT INTO `+Table+` (version, name, applied_at) VALUES ($1, $2, $3)`, mig.Version, mig.Name, time.Now()); err != nil {
+				return fmt.Errorf("migration: applying %d '%s': %w", mig.Version, mig.Name, err)
+			}
+
+			applied = append(applied, mig)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down implements Migrator.
+func (m *migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn) error {
+		appliedAt, err := m.appliedAt(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := appliedAt[mig.Version]; !ok {
+				continue
+			}
+
+			if err := m.apply(ctx, conn, nil, mig.Down, `DELETE FROM `+Table+` WHERE version = $1`, mig.Version); err != nil {
+				return fmt.Errorf("migration: reverting %d '%s': %w", mig.Version, mig.Name, err)
+			}
+
+			reverted = append(reverted, mig)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status implements Migrator.
+func (m *migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn) error {
+		appliedAt, err := m.appliedAt(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		statuses = make([]Status, len(m.migrations))
+		for k, mig := range m.migrations {
+			statuses[k] = Status{Version: mig.Version, Name: mig.Name}
+			if t, ok := appliedAt[mig.Version]; ok {
+				statuses[k].AppliedAt = &t
+			}
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// locked runs fn on a single connection of the pool which holds the advisory lock of the migrations, the lock is
+// released before the connection goes back to the pool.
+func (m *migrator) locked(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext($1))`, Table); err != nil {
+		return fmt.Errorf("migration: acquiring lock: %w", err)
+	}
+	defer conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, Table)
+
+	query := `
+		CREATE TABLE IF NOT EXISTS ` + Table + ` (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL
+		)
+	`
+	if _, err := conn.Exec(ctx, query); err != nil {
+		return fmt.Errorf("migration: creating %s: %w", Table, err)
+	}
+
+	return fn(conn)
+}
+
+func (m *migrator) appliedAt(ctx context.Context, conn *pgxpool.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM `+Table)
+	if err != nil {
+		return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+	}
+	defer rows.Close()
+
+	appliedAt := make(map[int64]time.Time)
+	for rows.Next() {
+		var version int64
+		var t time.Time
+		if err := rows.Scan(&version, &t); err != nil {
+			return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+		}
+		appliedAt[version] = t
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("migration: reading %s: %w", Table, err)
+	}
+
+	return appliedAt, nil
+}
+
+// apply runs the statements of a migration, prepared by fn when it is not nil, and records it in a single
+// transaction, a failed migration leaves no trace.
+func (m *migrator) apply(ctx context.Context, conn *pgxpool.Conn, fn Func, statements string, record string, args ...interface{}) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if fn != nil {
+		if err := fn(ctx, tx); err != nil {
+			tx.Rollback(context.Background())
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, statements); err != nil {
+		tx.Rollback(context.Background())
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, record, args...); err != nil {
+		tx.Rollback(context.Background())
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,104 @@
+package migration_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/pkg/migration"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("migrations are paired and ordered by their version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000002_add_column.down.sql":   {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+			"000002_add_column.up.sql":     {Data: []byte("ALTER TABLE a ADD COLUMN b INT;")},
+			"000010_add_index.up.sql":      {Data: []byte("CREATE INDEX a_b_idx ON a (b);")},
+			"000010_add_index.down.sql":    {Data: []byte("DROP INDEX a_b_idx;")},
+			"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")},
+		}
+
+		migrations, err := migration.Load(fsys, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []migration.Migration{
+			{Version: 1, Name: "create_table", Up: "CREATE TABLE a (id INT);", Down: "DROP TABLE a;"},
+			{Version: 2, Name: "add_column", Up: "ALTER TABLE a ADD COLUMN b INT;", Down: "ALTER TABLE a DROP COLUMN b;"},
+			{Version: 10, Name: "add_index", Up: "CREATE INDEX a_b_idx ON a (b);", Down: "DROP INDEX a_b_idx;"},
+		}, migrations)
+	})
+
+	t.Run("a migration without its down file is rejected", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_table.up.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+		}
+
+		_, err := migration.Load(fsys, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("a version used by two migrations is rejected", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")},
+			"000001_create_other.up.sql":   {Data: []byte("CREATE TABLE b (id INT);")},
+			"000001_create_other.down.sql": {Data: []byte("DROP TABLE b;")},
+		}
+
+		_, err := migration.Load(fsys, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("a file which is not a migration is rejected", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"schema.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+		}
+
+		_, err := migration.Load(fsys, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("a migration is prepared by the func of its version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")},
+			"000002_add_column.up.sql":     {Data: []byte("ALTER TABLE a ADD COLUMN b INT;")},
+			"000002_add_column.down.sql":   {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+		}
+
+		prepared := false
+		migrations, err := migration.Load(fsys, map[int64]migration.Func{
+			2: func(ctx context.Context, tx pgx.Tx) error {
+				prepared = true
+				return nil
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Nil(t, migrations[0].Prepare)
+		require.NotNil(t, migrations[1].Prepare)
+		assert.NoError(t, migrations[1].Prepare(context.Background(), nil))
+		assert.True(t, prepared)
+	})
+
+	t.Run("a func of a version which has no migration is rejected", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")},
+		}
+
+		_, err := migration.Load(fsys, map[int64]migration.Func{
+			2: func(ctx context.Context, tx pgx.Tx) error { return nil },
+		})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,220 @@
+// Package money represents monetary amounts as decimals in an ISO-4217 currency.
+//
+// Amounts are never held as floating points, they are rounded to the minor unit of their currency, e.g. 2 decimals
+// for USD and none for IDR or JPY. A Money is serialized to JSON as a string amount plus its currency and is stored
+// as a NUMERIC amount next to its currency.
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/currency"
+)
+
+// Money is an amount in a currency.
+type Money struct {
+	Amount   decimal.Decimal
+	Currency string
+}
+
+// New returns the given amount in the given currency, rounded to the minor unit of the currency.
+func New(amount decimal.Decimal, currencyCode string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currencyCode)}.Round()
+}
+
+// Zero returns nothing in the given currency.
+func Zero(currencyCode string) Money {
+	return New(decimal.Zero, currencyCode)
+}
+
+// Parse returns the money of the given string amount and currency.
+func Parse(amount, currencyCode string) (Money, error) {
+	if !IsCurrency(currencyCode) {
+		return Money{}, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount '%s'", amount)
+	}
+
+	return New(d, currencyCode), nil
+}
+
+// MustParse is like Parse but panics on an invalid amount or currency.
+func MustParse(amount, currencyCode string) Money {
+	m, err := Parse(amount, currencyCode)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// IsCurrency reports whether the given code is an ISO-4217 currency.
+func IsCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+
+	_, err := currency.ParseISO(code)
+	return err == nil
+}
+
+// Scale returns the number of decimals of the minor unit of the currency.
+func (m Money) Scale() int32 {
+	unit, err := currency.ParseISO(m.Currency)
+	if err != nil {
+		return 2
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+	return int32(scale)
+}
+
+// Round rounds the amount to the minor unit of the currency, halves are rounded away from zero.
+func (m Money) Round() Money {
+	m.Amount = m.Amount.Round(m.Scale())
+	return m
+}
+
+// Add returns the sum of both amounts, both have to be in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	return New(m.Amount.Add(o.Amount), m.Currency), nil
+}
+
+// Sub returns the difference of both amounts, both have to be in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	return New(m.Amount.Sub(o.Amount), m.Currency), nil
+}
+
+// Mul returns the amount multiplied by the given quantity.
+func (m Money) Mul(quantity int64) Money {
+	return New(m.Amount.Mul(decimal.NewFromInt(quantity)), m.Currency)
+}
+
+// Percentage returns the given percentage of the amount, rounded to the minor unit of the currency.
+func (m Money) Percentage(percentage float64) Money {
+	return New(m.Amount.Mul(decimal.NewFromFloat(percentage)).Div(decimal.NewFromInt(100)), m.Currency)
+}
+
+// Equal reports whether both are the same amount in the same currency.
+func (m Money) Equal(o Money) bool {
+	return m.Currency == o.Currency && m.Amount.Equal(o.Amount)
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.Amount.IsPositive()
+}
+
+// IsNegative reports whether the amount is lower than zero.
+func (m Money) IsNegative() bool {
+	return m.Amount.IsNegative()
+}
+
+// String returns the amount with the decimals of the currency followed by the currency, e.g. 150000 IDR.
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Amount.StringFixed(m.Scale()), m.Currency)
+}
+
+func (m Money) sameCurrency(o Money) error {
+	if m.Currency != o.Currency {
+		return fmt.Errorf("currency mismatch between '%s' and '%s'", m.Currency, o.Currency)
+	}
+
+	return nil
+}
+
+type jsonMoney struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON serializes the money as a string amount plus its currency, e.g. {"amount":"150000","currency":"IDR"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonMoney{
+		Amount:   m.Amount.StringFixed(m.Scale()),
+		Currency: m.Currency,
+	})
+}
+
+// UnmarshalJSON parses a string amount plus its currency. A bare amount, a number or a numeric string, is parsed
+// without a currency since the payloads which were published before the amounts had one still carry them, the
+// currency is given afterwards by OrDefaultCurrency.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	bare := bytes.TrimSpace(b)
+	if bytes.Equal(bare, []byte("null")) {
+		return nil
+	}
+
+	if len(bare) > 0 && bare[0] != '{' {
+		var amount json.Number
+		if err := json.Unmarshal(bare, &amount); err != nil {
+			return fmt.Errorf("money must be an object of a string amount and a currency or a bare amount: %w", err)
+		}
+
+		d, err := decimal.NewFromString(amount.String())
+		if err != nil {
+			return fmt.Errorf("invalid amount '%s'", amount)
+		}
+
+		*m = Money{Amount: d}
+		return nil
+	}
+
+	var jm jsonMoney
+	if err := json.Unmarshal(b, &jm); err != nil {
+		return fmt.Errorf("money must be an object of a string amount and a currency: %w", err)
+	}
+
+	parsed, err := Parse(jm.Amount, jm.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// OrDefaultCurrency returns the money in the given currency when it has none, e.g. a bare amount, otherwise the money
+// as is.
+func (m Money) OrDefaultCurrency(currencyCode string) Money {
+	if m.Currency != "" {
+		return m
+	}
+
+	return New(m.Amount, currencyCode)
+}
+
+// Validate is a validator function of the money tag, the money has to be in an ISO-4217 currency and must not be
+// negative.
+func Validate(fl validator.FieldLevel) bool {
+	m, ok := fl.Field().Interface().(Money)
+	return ok && IsCurrency(m.Currency) && !m.IsNegative()
+}
+
+// ValidatePositive is a validator function of the positive_money tag, it is like Validate but the money must be
+// greater than zero.
+func ValidatePositive(fl validator.FieldLevel) bool {
+	m, ok := fl.Field().Interface().(Money)
+	return ok && IsCurrency(m.Currency) && m.IsPositive()
+}
@@ -0,0 +1,52 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
+)
+
+func TestMoney(t *testing.T) {
+	t.Run("rounds to the minor unit of the currency", func(t *testing.T) {
+		assert.Equal(t, "0.30 USD", money.MustParse("0.1", "USD").Mul(3).String())
+		assert.Equal(t, "1235 JPY", money.MustParse("1234.5", "JPY").String())
+	})
+
+	t.Run("rejects an unknown currency", func(t *testing.T) {
+		_, err := money.Parse("10", "XYZ1")
+		assert.Error(t, err)
+	})
+
+	t.Run("does not add different currencies", func(t *testing.T) {
+		_, err := money.MustParse("10", "IDR").Add(money.MustParse("10", "USD"))
+		assert.Error(t, err)
+	})
+
+	t.Run("serializes as a string amount plus currency", func(t *testing.T) {
+		b, err := json.Marshal(money.MustParse("150000", "IDR"))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount":"150000","currency":"IDR"}`, string(b))
+
+		var m money.Money
+		assert.NoError(t, json.Unmarshal(b, &m))
+		assert.True(t, m.Equal(money.MustParse("150000", "IDR")))
+	})
+
+	t.Run("parses a bare amount without a currency", func(t *testing.T) {
+		for b, want := range map[string]string{`150000`: "150000", `"150000"`: "150000", `150000.5`: "150001"} {
+			var m money.Money
+			assert.NoError(t, json.Unmarshal([]byte(b), &m))
+			assert.Empty(t, m.Currency)
+			assert.True(t, m.OrDefaultCurrency("IDR").Equal(money.MustParse(want, "IDR")), b)
+		}
+
+		var m money.Money
+		assert.Error(t, json.Unmarshal([]byte(`"cheap"`), &m))
+	})
+
+	t.Run("keeps its own currency over the default one", func(t *testing.T) {
+		assert.True(t, money.MustParse("10", "USD").OrDefaultCurrency("IDR").Equal(money.MustParse("10", "USD")))
+	})
+}
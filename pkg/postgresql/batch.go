@@ -0,0 +1,139 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrQueued is returned by the result of a write which is queued in a batch, what it affected is known once the batch
+// is sent.
+var ErrQueued = errors.New("postgresql: the statement is queued in a batch")
+
+type batchKey struct{}
+
+type queuedQuery struct {
+	query string
+	args  []interface{}
+}
+
+// batch is the Command of the context of TxManager.WithinBatch, it queues the writes and sends them as a pgx.Batch on
+// the pool or on the transaction it is begun within.
+type batch struct {
+	mu      sync.Mutex
+	db      DB
+	queries []queuedQuery
+	err     error
+}
+
+type queuedResult struct{}
+
+func (queuedResult) RowsAffected() (int64, error) { return 0, ErrQueued }
+
+func batchFromContext(ctx context.Context) *batch {
+	b, _ := ctx.Value(batchKey{}).(*batch)
+
+	return b
+}
+
+// command returns what the batch runs its reads on.
+func (b *batch) command() Command {
+	return command{db: b.db}
+}
+
+// ExecContext implements Command, the statement is queued.
+func (b *batch) ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queries = append(b.queries, queuedQuery{query: query, args: args})
+
+	return queuedResult{}, nil
+}
+
+// QueryRowContext implements Command, the queued writes are sent first. Their failure is reported when the batch
+// ends.
+func (b *batch) QueryRowContext(ctx context.Context, query string, args ...interface{}) Row {
+	if err := b.flush(ctx); err != nil {
+		b.fail(err)
+	}
+
+	return b.command().QueryRowContext(ctx, query, args...)
+}
+
+// QueryContext implements Command, the queued writes are sent first.
+func (b *batch) QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	if err := b.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	return b.command().QueryContext(ctx, query, args...)
+}
+
+func (b *batch) fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// flush sends the queued writes in one round trip.
+func (b *batch) flush(ctx context.Context) error {
+	b.mu.Lock()
+	queries := b.queries
+	b.queries = nil
+	b.mu.Unlock()
+
+	if len(queries) < 1 {
+		return nil
+	}
+
+	pb := &pgx.Batch{}
+	for _, q := range queries {
+		pb.Queue(q.query, q.args...)
+	}
+
+	return b.db.SendBatch(ctx, pb).Close()
+}
+
+// WithinBatch implements TxManager.
+func (m *txManager) WithinBatch(ctx context.Context, fn func(ctx context.Context) error) error {
+	if b := batchFromContext(ctx); b != nil {
+		return fn(ctx)
+	}
+
+	b := &batch{db: m.db}
+	if tx, ok := TxFromContext(ctx); ok {
+		b.db = tx
+	}
+	if err := fn(context.WithValue(ctx, batchKey{}, b)); err != nil {
+		return err
+	}
+
+	if b.err != nil {
+		return b.err
+	}
+
+	return b.flush(ctx)
+}
+
+// CopyFrom copies the rows into the columns of the table in one round trip with the COPY protocol. The copy runs
+// within the transaction carried by the context.
+func CopyFrom(ctx context.Context, db DB, table string, columns []string, rows [][]interface{}) (int64, error) {
+	// the writes which are queued before the copy are sent first
+	if b := batchFromContext(ctx); b != nil {
+		if err := b.flush(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	if tx, ok := TxFromContext(ctx); ok {
+		db = tx
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows))
+}
@@ -0,0 +1,102 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+func TestTxManagerWithinBatch(t *testing.T) {
+	t.Run("the writes are queued until fn returns", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinBatch(context.Background(), func(ctx context.Context) error {
+			cmd := postgresql.CommandFromContext(ctx, db)
+
+			result, err := cmd.ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+			require.NoError(t, err)
+			_, err = result.RowsAffected()
+			assert.ErrorIs(t, err, postgresql.ErrQueued)
+
+			_, err = cmd.ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-2")
+			require.NoError(t, err)
+
+			assert.Empty(t, r.Calls())
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"INSERT INTO event_show VALUES ($1)", "INSERT INTO event_show VALUES ($1)"}, r.Calls())
+	})
+
+	t.Run("nothing is sent when fn fails", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinBatch(context.Background(), func(ctx context.Context) error {
+			postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+			return errors.New("boom")
+		})
+
+		assert.EqualError(t, err, "boom")
+		assert.Empty(t, r.Calls())
+	})
+
+	t.Run("the batch is sent within the transaction of the context", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			return tm.WithinBatch(ctx, func(ctx context.Context) error {
+				_, err := postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+				return err
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"BEGIN", "INSERT INTO event_show VALUES ($1)", "COMMIT"}, r.Calls())
+	})
+
+	t.Run("the queued writes are sent before a transaction within the batch", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinBatch(context.Background(), func(ctx context.Context) error {
+			postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+
+			return tm.WithinTx(ctx, func(ctx context.Context) error {
+				_, err := postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "UPDATE event_show SET status = $1", "ACTIVE")
+				return err
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"INSERT INTO event_show VALUES ($1)", "BEGIN", "UPDATE event_show SET status = $1", "COMMIT"}, r.Calls())
+	})
+}
+
+func TestCopyFrom(t *testing.T) {
+	t.Run("the rows are copied within the transaction of the context after the queued writes", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		var copied int64
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			return tm.WithinBatch(ctx, func(ctx context.Context) (err error) {
+				postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+
+				copied, err = postgresql.CopyFrom(ctx, db, "acquired_ticket", []string{"number"}, [][]interface{}{{"0001"}, {"0002"}})
+				return err
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), copied)
+		assert.Equal(t, []string{"BEGIN", "INSERT INTO event_show VALUES ($1)", `COPY "acquired_ticket"`, "COMMIT"}, r.Calls())
+	})
+}
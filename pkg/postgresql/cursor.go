@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNoTx is returned by Cursor when it is not given a transaction, a cursor lives as long as its transaction.
+var ErrNoTx = errors.New("postgresql: a cursor requires a transaction")
+
+// Cursor reads the rows of the query by the server-side cursor of the given name, fetchSize rows at a time, so that a
+// result which does not fit in memory is streamed. The command is the transaction of the cursor, fn is called for every
+// row and its error stops the reading.
+func Cursor(ctx context.Context, cmd Command, name string, fetchSize int, query string, args []any, fn func(rows Rows) error) error {
+	if !inTx(cmd) {
+		return ErrNoTx
+	}
+
+	if _, err := cmd.ExecContext(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", name, query), args...); err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", fetchSize, name)
+	for {
+		fetched, err := fetchCursor(ctx, cmd, fetch, fn)
+		if err != nil {
+			return err
+		}
+
+		if fetched < fetchSize {
+			break
+		}
+	}
+
+	_, err := cmd.ExecContext(ctx, "CLOSE "+name)
+
+	return err
+}
+
+func fetchCursor(ctx context.Context, cmd Command, fetch string, fn func(rows Rows) error) (int, error) {
+	rows, err := cmd.QueryContext(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	fetched := 0
+	for rows.Next() {
+		fetched++
+		if err := fn(rows); err != nil {
+			return fetched, err
+		}
+	}
+
+	return fetched, rows.Err()
+}
+
+// inTx reports whether the command runs its queries within a transaction.
+func inTx(cmd Command) bool {
+	c, ok := cmd.(command)
+	if !ok {
+		return false
+	}
+
+	_, ok = c.db.(pgx.Tx)
+
+	return ok
+}
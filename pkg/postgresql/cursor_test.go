@@ -0,0 +1,65 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+func TestCursor(t *testing.T) {
+	query := "SELECT id FROM acquired_ticket WHERE show_id = $1"
+
+	t.Run("the rows are fetched until a fetch is not full", func(t *testing.T) {
+		db, r := openRecorder(t, 2, 2, 1)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		read := 0
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			return postgresql.Cursor(ctx, postgresql.CommandFromContext(ctx, db), "attendees", 2, query, []any{"SHOW1"}, func(rows postgresql.Rows) error {
+				read++
+				return nil
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, read)
+		assert.Equal(t, []string{
+			"BEGIN",
+			"DECLARE attendees NO SCROLL CURSOR FOR " + query,
+			"FETCH FORWARD 2 FROM attendees",
+			"FETCH FORWARD 2 FROM attendees",
+			"FETCH FORWARD 2 FROM attendees",
+			"CLOSE attendees",
+			"COMMIT",
+		}, r.Calls())
+	})
+
+	t.Run("the error of fn stops the reading", func(t *testing.T) {
+		db, r := openRecorder(t, 2, 2)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		failure := errors.New("failure")
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			return postgresql.Cursor(ctx, postgresql.CommandFromContext(ctx, db), "attendees", 2, query, []any{"SHOW1"}, func(rows postgresql.Rows) error {
+				return failure
+			})
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assert.Equal(t, []string{"BEGIN", "DECLARE attendees NO SCROLL CURSOR FOR " + query, "FETCH FORWARD 2 FROM attendees", "ROLLBACK"}, r.Calls())
+	})
+
+	t.Run("a cursor requires a transaction", func(t *testing.T) {
+		db, r := openRecorder(t)
+
+		err := postgresql.Cursor(context.Background(), postgresql.CommandFromContext(context.Background(), db), "attendees", 2, query, []any{"SHOW1"}, func(rows postgresql.Rows) error {
+			return nil
+		})
+
+		assert.ErrorIs(t, err, postgresql.ErrNoTx)
+		assert.Empty(t, r.Calls())
+	})
+}
@@ -0,0 +1,30 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE codes of the integrity constraint violations which the repositories tell apart.
+const (
+	ForeignKeyViolation = "23503"
+	UniqueViolation     = "23505"
+	CheckViolation      = "23514"
+)
+
+// ConstraintViolation returns the SQLSTATE code and the name of the violated constraint when err is caused by an
+// integrity constraint violation, ok is false otherwise.
+func ConstraintViolation(err error) (code string, constraint string, ok bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", "", false
+	}
+
+	switch pgErr.Code {
+	case ForeignKeyViolation, UniqueViolation, CheckViolation:
+		return pgErr.Code, pgErr.ConstraintName, true
+	}
+
+	return "", "", false
+}
@@ -1,45 +1,72 @@
 package postgresql
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
-	"log"
 	"sync"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tsel-ticketmaster/tm-event/config"
-	"github.com/uptrace/opentelemetry-go-extra/otelsql"
-	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 )
 
-var db *sql.DB
+// DB is a collection of behavior of a pgx pool which the repositories and the unit of work run on, *pgxpool.Pool and
+// pgx.Tx implement it.
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+var db *pgxpool.Pool
+var dbErr error
 var dbSyncOnce sync.Once
 
-func GetDatabase() *sql.DB {
+// GetDatabase returns the pool of the primary, a config which the pool can not be opened with is returned as an error
+// by every call.
+func GetDatabase() (*pgxpool.Pool, error) {
 	dbSyncOnce.Do(func() {
-		db = createConnection()
+		db, dbErr = createConnection()
 	})
 
-	return db
+	return db, dbErr
 }
 
-func createConnection() *sql.DB {
+func createConnection() (*pgxpool.Pool, error) {
 	cfg := config.Get()
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s", cfg.Postgresql.Host, cfg.Postgresql.Port, cfg.Postgresql.User, cfg.Postgresql.Password, cfg.Postgresql.DBName, cfg.Postgresql.SSLMode)
-	conn, err := otelsql.Open(
-		"pgx",
-		dsn,
-		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
-		otelsql.WithDBName(cfg.Postgresql.DBName),
-	)
 
+	return openConnection(cfg.Postgresql.Host, cfg.Postgresql.Port)
+}
+
+// openConnection opens a pgx pool on the given server with the credentials and the pool settings of the config, the
+// primary and its read replicas share them.
+func openConnection(host string, port int) (*pgxpool.Pool, error) {
+	cfg := config.Get()
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s", host, port, cfg.Postgresql.User, cfg.Postgresql.Password, cfg.Postgresql.DBName, cfg.Postgresql.SSLMode)
+	poolConfig, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
-		log.Println(err)
-		return nil
+		return nil, fmt.Errorf("postgresql: invalid config of '%s:%d': %w", host, port, err)
 	}
 
-	conn.SetMaxOpenConns(cfg.Postgresql.MaxOpenConns)
-	conn.SetMaxIdleConns(cfg.Postgresql.MaxIdleConns)
+	if cfg.Postgresql.MaxOpenConns > 0 {
+		poolConfig.MaxConns = int32(cfg.Postgresql.MaxOpenConns)
+	}
+
+	// the pool has no bound of its idle connections, it keeps MaxIdleConns of them open instead of opening them on
+	// demand
+	if cfg.Postgresql.MaxIdleConns > 0 {
+		poolConfig.MinConns = min(int32(cfg.Postgresql.MaxIdleConns), poolConfig.MaxConns)
+	}
+	poolConfig.ConnConfig.Tracer = NewTracer(cfg.Postgresql.DBName)
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("postgresql: could not open '%s:%d': %w", host, port, err)
+	}
 
-	return conn
+	return pool, nil
 }
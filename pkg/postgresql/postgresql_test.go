@@ -1,8 +1,12 @@
 package postgresql_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgconn"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
 )
@@ -16,13 +20,42 @@ func TestGetDatabase(t *testing.T) {
 	t.Setenv("POSTGRES_SSLMODE", "disable")
 
 	t.Run("try to build connection and get the db object", func(t *testing.T) {
-		db := postgresql.GetDatabase()
+		db, err := postgresql.GetDatabase()
+		assert.NoError(t, err)
 		assert.NotNil(t, db, "db object should not be null")
 	})
 	t.Run("db object is singleton", func(t *testing.T) {
-		db1 := postgresql.GetDatabase()
-		db2 := postgresql.GetDatabase()
+		db1, _ := postgresql.GetDatabase()
+		db2, _ := postgresql.GetDatabase()
 
 		assert.Equal(t, db1, db2, "both db1 and db2 should have the same reference")
 	})
 }
+
+func TestConstraintViolation(t *testing.T) {
+	t.Run("a check violation is told apart along with its constraint", func(t *testing.T) {
+		err := fmt.Errorf("updating ticket stock: %w", &pgconn.PgError{Code: "23514", ConstraintName: "ticket_stock_capacity_check"})
+
+		code, constraint, ok := postgresql.ConstraintViolation(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, postgresql.CheckViolation, code)
+		assert.Equal(t, "ticket_stock_capacity_check", constraint)
+	})
+	t.Run("a unique violation is told apart", func(t *testing.T) {
+		code, _, ok := postgresql.ConstraintViolation(&pgconn.PgError{Code: "23505", ConstraintName: "acquired_ticket_number_idx"})
+
+		assert.True(t, ok)
+		assert.Equal(t, postgresql.UniqueViolation, code)
+	})
+	t.Run("other postgres errors are not constraint violations", func(t *testing.T) {
+		_, _, ok := postgresql.ConstraintViolation(&pgconn.PgError{Code: "40001"})
+
+		assert.False(t, ok)
+	})
+	t.Run("non postgres errors are not constraint violations", func(t *testing.T) {
+		_, _, ok := postgresql.ConstraintViolation(errors.New("connection refused"))
+
+		assert.False(t, ok)
+	})
+}
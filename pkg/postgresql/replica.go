@@ -0,0 +1,123 @@
+package postgresql
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tsel-ticketmaster/tm-event/config"
+)
+
+// ReadYourWritesHeader is the header of the request which asks to read from the primary, e.g. right after the client
+// has written something it wants to see in the response.
+const ReadYourWritesHeader = "X-Read-Your-Writes"
+
+type readYourWritesKey struct{}
+
+// WithReadYourWrites returns a context whose reads go to the primary so that they see the writes which are not
+// replicated yet.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+// ReadsYourWrites reports whether the reads of the context have to go to the primary.
+func ReadsYourWrites(ctx context.Context) bool {
+	readYourWrites, _ := ctx.Value(readYourWritesKey{}).(bool)
+
+	return readYourWrites
+}
+
+// ReadYourWritesMiddleware carries the freshness hint of the ReadYourWritesHeader by the context of the request.
+func ReadYourWritesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if readYourWrites, _ := strconv.ParseBool(r.Header.Get(ReadYourWritesHeader)); readYourWrites {
+			r = r.WithContext(WithReadYourWrites(r.Context()))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ReplicaSet is a collection of the read replicas of the database. The reads which tolerate the replication lag are
+// balanced over the replicas, a set without replicas reads from the primary.
+type ReplicaSet struct {
+	replicas []DB
+	next     atomic.Uint64
+}
+
+func NewReplicaSet(replicas ...DB) *ReplicaSet {
+	return &ReplicaSet{
+		replicas: replicas,
+	}
+}
+
+var replicaSet *ReplicaSet
+var replicaSetSyncOnce sync.Once
+
+// GetReplicaSet returns the read replicas of the config.
+func GetReplicaSet() *ReplicaSet {
+	replicaSetSyncOnce.Do(func() {
+		replicaSet = createReplicaSet()
+	})
+
+	return replicaSet
+}
+
+func createReplicaSet() *ReplicaSet {
+	cfg := config.Get()
+
+	replicas := make([]DB, 0, len(cfg.Postgresql.ReplicaHosts))
+	for _, hostPort := range cfg.Postgresql.ReplicaHosts {
+		host, port := hostPort, cfg.Postgresql.Port
+		if h, p, err := net.SplitHostPort(hostPort); err == nil {
+			host = h
+			port, _ = strconv.Atoi(p)
+		}
+
+		conn, err := openConnection(host, port)
+		if err != nil {
+			log.Printf("read replica '%s' is skipped: %s", hostPort, err)
+			continue
+		}
+
+		replicas = append(replicas, conn)
+	}
+
+	return NewReplicaSet(replicas...)
+}
+
+// CommandFromContext returns what a repository runs a read on which tolerates the replication lag: the batch carried by
+// the context, otherwise the transaction carried by the context, otherwise the primary when the context reads its own
+// writes, otherwise the next replica. A read within a batch sends its queued writes first and reads where they are
+// written, a replica would not see them.
+func (s *ReplicaSet) CommandFromContext(ctx context.Context, primary DB) Command {
+	if b := batchFromContext(ctx); b != nil {
+		return b
+	}
+
+	if tx, ok := TxFromContext(ctx); ok {
+		return command{db: tx}
+	}
+
+	if s == nil || len(s.replicas) < 1 || ReadsYourWrites(ctx) {
+		return command{db: primary}
+	}
+
+	return command{db: s.replicas[(s.next.Add(1)-1)%uint64(len(s.replicas))]}
+}
+
+// Close closes the pools of the replicas.
+func (s *ReplicaSet) Close() error {
+	for _, replica := range s.replicas {
+		if pool, ok := replica.(*pgxpool.Pool); ok {
+			pool.Close()
+		}
+	}
+
+	return nil
+}
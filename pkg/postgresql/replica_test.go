@@ -0,0 +1,113 @@
+package postgresql_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+)
+
+func TestReplicaSetCommandFromContext(t *testing.T) {
+	primary, primaryRecorder := openRecorder(t)
+	first, firstRecorder := openRecorder(t)
+	second, secondRecorder := openRecorder(t)
+
+	// read runs a query on the command of the context and returns the recorder which has run it
+	read := func(ctx context.Context, rs *postgresql.ReplicaSet) *recorder {
+		var ran *recorder
+		for _, r := range []*recorder{primaryRecorder, firstRecorder, secondRecorder} {
+			r.mu.Lock()
+			r.calls = nil
+			r.mu.Unlock()
+		}
+
+		rs.CommandFromContext(ctx, primary).QueryRowContext(ctx, "SELECT id FROM event")
+		for _, r := range []*recorder{primaryRecorder, firstRecorder, secondRecorder} {
+			if len(r.Calls()) > 0 {
+				ran = r
+			}
+		}
+
+		return ran
+	}
+
+	t.Run("the reads are balanced over the replicas", func(t *testing.T) {
+		rs := postgresql.NewReplicaSet(first, second)
+
+		assert.Same(t, firstRecorder, read(context.Background(), rs))
+		assert.Same(t, secondRecorder, read(context.Background(), rs))
+		assert.Same(t, firstRecorder, read(context.Background(), rs))
+	})
+
+	t.Run("a set without replicas reads from the primary", func(t *testing.T) {
+		var rs *postgresql.ReplicaSet
+
+		assert.Same(t, primaryRecorder, read(context.Background(), rs))
+		assert.Same(t, primaryRecorder, read(context.Background(), postgresql.NewReplicaSet()))
+	})
+
+	t.Run("a request which reads its own writes reads from the primary", func(t *testing.T) {
+		rs := postgresql.NewReplicaSet(first, second)
+
+		assert.Same(t, primaryRecorder, read(postgresql.WithReadYourWrites(context.Background()), rs))
+	})
+
+	t.Run("the reads within a transaction stay on the primary", func(t *testing.T) {
+		rs := postgresql.NewReplicaSet(first, second)
+
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: primary})
+		tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			assert.Same(t, primaryRecorder, read(ctx, rs))
+			return nil
+		})
+	})
+
+	t.Run("the reads within a batch send the queued writes and read them from the primary", func(t *testing.T) {
+		rs := postgresql.NewReplicaSet(first, second)
+
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: primary})
+		err := tm.WithinBatch(context.Background(), func(ctx context.Context) error {
+			postgresql.CommandFromContext(ctx, primary).ExecContext(ctx, "INSERT INTO event_show VALUES ($1)", "SHOW-1")
+
+			assert.Same(t, primaryRecorder, read(ctx, rs))
+			assert.Equal(t, []string{"INSERT INTO event_show VALUES ($1)", "SELECT id FROM event"}, primaryRecorder.Calls())
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, firstRecorder.Calls())
+		assert.Empty(t, secondRecorder.Calls())
+	})
+}
+
+func TestReadYourWritesMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "the hint is carried by the context", header: "true", want: true},
+		{name: "a request without the hint tolerates the replication lag", header: "", want: false},
+		{name: "an invalid hint is ignored", header: "yes please", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bool
+			handler := postgresql.ReadYourWritesMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = postgresql.ReadsYourWrites(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/tm-event/v1/customer/events", nil)
+			if tt.header != "" {
+				r.Header.Set(postgresql.ReadYourWritesHeader, tt.header)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
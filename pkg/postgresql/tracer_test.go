@@ -0,0 +1,79 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	sr := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return sr
+}
+
+func TestTracer(t *testing.T) {
+	t.Run("a query is traced along with its statement", func(t *testing.T) {
+		sr := recordSpans(t)
+		tracer := postgresql.NewTracer("ticket-master")
+
+		ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT id FROM event WHERE id = $1"})
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+
+		spans := sr.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "postgresql.select", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), semconv.DBSystemPostgreSQL)
+		assert.Contains(t, spans[0].Attributes(), semconv.DBName("ticket-master"))
+		assert.Contains(t, spans[0].Attributes(), semconv.DBStatement("SELECT id FROM event WHERE id = $1"))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+
+	t.Run("a failed copy is traced as an error", func(t *testing.T) {
+		sr := recordSpans(t)
+		tracer := postgresql.NewTracer("ticket-master")
+
+		ctx := tracer.TraceCopyFromStart(context.Background(), nil, pgx.TraceCopyFromStartData{TableName: pgx.Identifier{"acquired_ticket"}})
+		tracer.TraceCopyFromEnd(ctx, nil, pgx.TraceCopyFromEndData{Err: errors.New("connection reset")})
+
+		spans := sr.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "postgresql.copy", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), semconv.DBSQLTable(`"acquired_ticket"`))
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+
+	t.Run("the statements of a batch are events of its span", func(t *testing.T) {
+		sr := recordSpans(t)
+		tracer := postgresql.NewTracer("ticket-master")
+
+		b := &pgx.Batch{}
+		b.Queue("INSERT INTO event_show VALUES ($1)", "SHOW-1")
+		b.Queue("INSERT INTO event_show VALUES ($1)", "SHOW-2")
+
+		ctx := tracer.TraceBatchStart(context.Background(), nil, pgx.TraceBatchStartData{Batch: b})
+		for _, q := range b.QueuedQueries {
+			tracer.TraceBatchQuery(ctx, nil, pgx.TraceBatchQueryData{SQL: q.SQL})
+		}
+		tracer.TraceBatchEnd(ctx, nil, pgx.TraceBatchEndData{})
+
+		spans := sr.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "postgresql.batch", spans[0].Name())
+		assert.Len(t, spans[0].Events(), 2)
+	})
+}
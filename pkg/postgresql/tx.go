@@ -0,0 +1,246 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE codes of the failures after which a transaction succeeds when it is run again.
+const (
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
+)
+
+// Command is a collection of behavior shared by the pool and the transactions which the repositories run their
+// queries on.
+type Command interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) Row
+	QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error)
+}
+
+// Result is what a write affected.
+type Result interface {
+	RowsAffected() (int64, error)
+}
+
+// Row is the result of a query which returns a row, its Scan returns sql.ErrNoRows when there is none.
+type Row interface {
+	Scan(dest ...any) error
+}
+
+// Rows is the result of a query, pgx.Rows implements it.
+type Rows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Close()
+	Err() error
+}
+
+// command runs the queries of a Command on a pgx pool or a pgx transaction.
+type command struct {
+	db DB
+}
+
+type commandTag struct {
+	tag pgconn.CommandTag
+}
+
+func (t commandTag) RowsAffected() (int64, error) { return t.tag.RowsAffected(), nil }
+
+type row struct {
+	row pgx.Row
+}
+
+func (r row) Scan(dest ...any) error {
+	if err := r.row.Scan(dest...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ExecContext implements Command.
+func (c command) ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error) {
+	tag, err := c.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return commandTag{tag: tag}, nil
+}
+
+// QueryRowContext implements Command.
+func (c command) QueryRowContext(ctx context.Context, query string, args ...interface{}) Row {
+	return row{row: c.db.QueryRow(ctx, query, args...)}
+}
+
+// QueryContext implements Command.
+func (c command) QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	return c.db.Query(ctx, query, args...)
+}
+
+type txKey struct{}
+
+type txState struct {
+	tx    pgx.Tx
+	depth int
+}
+
+// TxFromContext returns the transaction which is carried by the context of TxManager.WithinTx.
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return nil, false
+	}
+
+	return state.tx, true
+}
+
+// CommandFromContext returns what a repository runs its queries on: the batch carried by the context, otherwise the
+// transaction carried by the context, otherwise the pool itself.
+func CommandFromContext(ctx context.Context, db DB) Command {
+	if b := batchFromContext(ctx); b != nil {
+		return b
+	}
+
+	if tx, ok := TxFromContext(ctx); ok {
+		return command{db: tx}
+	}
+
+	return command{db: db}
+}
+
+// IsRetryable reports whether err is caused by a serialization failure or a deadlock, the transaction which failed
+// with it may succeed when it is run again.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == SerializationFailure || pgErr.Code == DeadlockDetected
+}
+
+// TxManager is a collection of behavior of the unit of work of the use cases.
+type TxManager interface {
+	// WithinTx runs fn in a transaction which is carried by the context given to fn, the repositories pick it up from
+	// there. The transaction is committed when fn returns nil and rolled back when it returns an error or panics. A
+	// call within fn runs in a savepoint of the same transaction, its error only rolls back to the savepoint. The
+	// outermost transaction is run again when it fails with a serialization failure or a deadlock.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinBatch runs fn with a context whose writes are queued rather than run, they are sent in one round trip
+	// when fn returns nil. Within a transaction the batch is sent within it. A read within fn sends the queued writes
+	// first so that it sees them. A call within fn joins the batch of the context.
+	WithinBatch(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txManager struct {
+	db         DB
+	maxRetries int
+	backoff    time.Duration
+}
+
+type TxManagerProperty struct {
+	DB DB
+	// MaxRetries is how many times a transaction which failed with a serialization failure or a deadlock is run
+	// again.
+	MaxRetries int
+	// Backoff is the wait before the first retry, it doubles on every following retry.
+	Backoff time.Duration
+}
+
+func NewTxManager(props TxManagerProperty) TxManager {
+	return &txManager{
+		db:         props.DB,
+		maxRetries: props.MaxRetries,
+		backoff:    props.Backoff,
+	}
+}
+
+// WithinTx implements TxManager.
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// the writes which are queued before the transaction are sent first, the transaction does not join the batch
+	if b := batchFromContext(ctx); b != nil {
+		if err := b.flush(ctx); err != nil {
+			return err
+		}
+		ctx = context.WithValue(ctx, batchKey{}, (*batch)(nil))
+	}
+
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return m.withinSavepoint(ctx, state, fn)
+	}
+
+	backoff := m.backoff
+	for attempt := 0; ; attempt++ {
+		err := m.withinTx(ctx, fn)
+		if err == nil || !IsRetryable(err) || attempt >= m.maxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = backoff * 2
+	}
+}
+
+func (m *txManager) withinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback(context.Background())
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{tx: tx})); err != nil {
+		tx.Rollback(context.Background())
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (m *txManager) withinSavepoint(ctx context.Context, state *txState, fn func(ctx context.Context) error) (err error) {
+	savepoint := fmt.Sprintf("sp_%d", state.depth+1)
+
+	if _, err := state.tx.Exec(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			state.tx.Exec(context.Background(), "ROLLBACK TO SAVEPOINT "+savepoint)
+			panic(p)
+		}
+	}()
+
+	nested := &txState{tx: state.tx, depth: state.depth + 1}
+	if err := fn(context.WithValue(ctx, txKey{}, nested)); err != nil {
+		if _, rbErr := state.tx.Exec(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+
+	_, err = state.tx.Exec(ctx, "RELEASE SAVEPOINT "+savepoint)
+
+	return err
+}
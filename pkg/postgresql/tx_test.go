@@ -0,0 +1,296 @@
+package postgresql_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperrors "github.com/tsel-ticketmaster/tm-event/pkg/errors"
+	"github.com/tsel-ticketmaster/tm-event/pkg/postgresql"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+)
+
+// recorder is a pgx pool which records the statements and the transaction calls it is given. Its queries return the
+// given numbers of rows one after another.
+type recorder struct {
+	mu      sync.Mutex
+	calls   []string
+	fetches []int
+}
+
+func (r *recorder) record(call string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+func (r *recorder) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.calls...)
+}
+
+func (r *recorder) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	r.record(sql)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (r *recorder) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	r.record(sql)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	if len(r.fetches) > 0 {
+		n, r.fetches = r.fetches[0], r.fetches[1:]
+	}
+
+	return &recorderRows{n: n}, nil
+}
+
+func (r *recorder) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	r.record(sql)
+	return recorderRow{}
+}
+
+func (r *recorder) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	for _, q := range b.QueuedQueries {
+		r.record(q.SQL)
+	}
+	return recorderBatchResults{}
+}
+
+func (r *recorder) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	r.record("COPY " + tableName.Sanitize())
+
+	copied := int64(0)
+	for rowSrc.Next() {
+		copied++
+	}
+	return copied, nil
+}
+
+func (r *recorder) Begin(ctx context.Context) (pgx.Tx, error) {
+	r.record("BEGIN")
+	return &recorderTx{r: r}, nil
+}
+
+// recorderTx is a transaction of the recorder, the methods which the unit of work does not use panic.
+type recorderTx struct {
+	pgx.Tx
+	r *recorder
+}
+
+func (tx *recorderTx) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	return tx.r.Exec(ctx, sql, arguments...)
+}
+func (tx *recorderTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return tx.r.Query(ctx, sql, args...)
+}
+func (tx *recorderTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return tx.r.QueryRow(ctx, sql, args...)
+}
+func (tx *recorderTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return tx.r.SendBatch(ctx, b)
+}
+func (tx *recorderTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return tx.r.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+func (tx *recorderTx) Commit(ctx context.Context) error   { tx.r.record("COMMIT"); return nil }
+func (tx *recorderTx) Rollback(ctx context.Context) error { tx.r.record("ROLLBACK"); return nil }
+
+type recorderRow struct{}
+
+func (recorderRow) Scan(dest ...any) error { return pgx.ErrNoRows }
+
+type recorderRows struct {
+	pgx.Rows
+	i, n int
+}
+
+func (r *recorderRows) Next() bool {
+	if r.i >= r.n {
+		return false
+	}
+	r.i++
+	return true
+}
+func (r *recorderRows) Scan(dest ...any) error {
+	*dest[0].(*int64) = int64(r.i)
+	return nil
+}
+func (r *recorderRows) Close()     {}
+func (r *recorderRows) Err() error { return nil }
+
+type recorderBatchResults struct {
+	pgx.BatchResults
+}
+
+func (recorderBatchResults) Close() error { return nil }
+
+// openRecorder returns a recorder as the pool along with itself, the queries of the pool return the given numbers of
+// rows one after another.
+func openRecorder(t *testing.T, fetches ...int) (postgresql.DB, *recorder) {
+	r := &recorder{fetches: fetches}
+	return r, r
+}
+
+func TestTxManagerWithinTx(t *testing.T) {
+	t.Run("the transaction is committed and carried by the context", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			_, ok := postgresql.TxFromContext(ctx)
+			assert.True(t, ok)
+
+			_, err := postgresql.CommandFromContext(ctx, db).ExecContext(ctx, "UPDATE ticket_stock SET acquired = 1")
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"BEGIN", "UPDATE ticket_stock SET acquired = 1", "COMMIT"}, r.Calls())
+	})
+
+	t.Run("the transaction is rolled back when fn fails", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+		failure := errors.New("failure")
+
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			return failure
+		})
+
+		assert.Equal(t, failure, err)
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK"}, r.Calls())
+	})
+
+	t.Run("the transaction is rolled back when fn panics", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		assert.PanicsWithValue(t, "boom", func() {
+			tm.WithinTx(context.Background(), func(ctx context.Context) error {
+				panic("boom")
+			})
+		})
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK"}, r.Calls())
+	})
+
+	t.Run("a nested call runs in a savepoint", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db})
+
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			nestedErr := tm.WithinTx(ctx, func(ctx context.Context) error {
+				return tm.WithinTx(ctx, func(ctx context.Context) error {
+					return errors.New("failure")
+				})
+			})
+			assert.Error(t, nestedErr)
+
+			return tm.WithinTx(ctx, func(ctx context.Context) error {
+				return nil
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"BEGIN",
+			"SAVEPOINT sp_1",
+			"SAVEPOINT sp_2",
+			"ROLLBACK TO SAVEPOINT sp_2",
+			"ROLLBACK TO SAVEPOINT sp_1",
+			"SAVEPOINT sp_1",
+			"RELEASE SAVEPOINT sp_1",
+			"COMMIT",
+		}, r.Calls())
+	})
+
+	t.Run("a serialization failure is retried", func(t *testing.T) {
+		db, r := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db, MaxRetries: 3, Backoff: time.Millisecond})
+
+		attempts := 0
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return fmt.Errorf("updating: %w", &pgconn.PgError{Code: postgresql.SerializationFailure})
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK", "BEGIN", "ROLLBACK", "BEGIN", "COMMIT"}, r.Calls())
+	})
+
+	t.Run("the retries are bounded", func(t *testing.T) {
+		db, _ := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db, MaxRetries: 2, Backoff: time.Millisecond})
+
+		attempts := 0
+		err := tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return &pgconn.PgError{Code: postgresql.DeadlockDetected}
+		})
+
+		assert.True(t, postgresql.IsRetryable(err))
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("other failures are not retried", func(t *testing.T) {
+		db, _ := openRecorder(t)
+		tm := postgresql.NewTxManager(postgresql.TxManagerProperty{DB: db, MaxRetries: 3, Backoff: time.Millisecond})
+
+		attempts := 0
+		tm.WithinTx(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return &pgconn.PgError{Code: postgresql.UniqueViolation}
+		})
+
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestCommandFromContext(t *testing.T) {
+	t.Run("the queries run on the pool without a transaction", func(t *testing.T) {
+		db, r := openRecorder(t)
+
+		result, err := postgresql.CommandFromContext(context.Background(), db).ExecContext(context.Background(), "UPDATE ticket_stock SET acquired = 1")
+		require.NoError(t, err)
+
+		affected, err := result.RowsAffected()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), affected)
+		assert.Equal(t, []string{"UPDATE ticket_stock SET acquired = 1"}, r.Calls())
+	})
+
+	t.Run("a row which is not found is sql.ErrNoRows", func(t *testing.T) {
+		db, _ := openRecorder(t)
+
+		var ID string
+		err := postgresql.CommandFromContext(context.Background(), db).QueryRowContext(context.Background(), "SELECT id FROM event WHERE id = $1", "EVENT1").Scan(&ID)
+
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	serialization := &pgconn.PgError{Code: postgresql.SerializationFailure}
+
+	assert.True(t, postgresql.IsRetryable(serialization))
+	assert.True(t, postgresql.IsRetryable(apperrors.Wrap(serialization, http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")))
+	assert.False(t, postgresql.IsRetryable(apperrors.New(http.StatusInternalServerError, status.INTERNAL_SERVER_ERROR, "an error occurred while updating ticket stock's prorperties")))
+	assert.False(t, postgresql.IsRetryable(errors.New("connection refused")))
+}
@@ -5,7 +5,11 @@ import (
 	"net/http"
 )
 
+// JSON writes the data as the json body of the response. An envelope is translated into the locale of the response
+// writer, if it has one.
 func JSON(w http.ResponseWriter, statusCode int, data any) error {
+	data = localize(w, data)
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 	return json.NewEncoder(w).Encode(data)
@@ -0,0 +1,42 @@
+package response
+
+import (
+	"net/http"
+
+	"github.com/tsel-ticketmaster/tm-event/pkg/i18n"
+	"github.com/tsel-ticketmaster/tm-event/pkg/status"
+	"golang.org/x/text/language"
+)
+
+// localized is implemented by the response writers which know the locale of their response, see i18n.Middleware.
+type localized interface {
+	Locale() language.Tag
+}
+
+// Localize returns a copy of the envelope for the given locale. The messages are localized where they are created, see
+// i18n.Message, so they are kept as they are. An empty message is replaced by the text of the status, as is the message
+// of an internal server error in a locale other than the default one since it is only meant for the developers.
+func (e RESTEnvelope) Localize(locale language.Tag) RESTEnvelope {
+	if e.Message == "" || (e.Status == status.INTERNAL_SERVER_ERROR && locale != i18n.DefaultLocale) {
+		e.Message = i18n.StatusText(locale, e.Status)
+	}
+
+	return e
+}
+
+func localize(w http.ResponseWriter, data any) any {
+	lw, ok := w.(localized)
+	if !ok {
+		return data
+	}
+
+	switch envelope := data.(type) {
+	case RESTEnvelope:
+		return envelope.Localize(lw.Locale())
+	case *RESTEnvelope:
+		e := envelope.Localize(lw.Locale())
+		return &e
+	}
+
+	return data
+}
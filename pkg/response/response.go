@@ -1,8 +1,11 @@
 package response
 
+import "github.com/tsel-ticketmaster/tm-event/pkg/errors"
+
 type RESTEnvelope struct {
-	Status  string `json:"status"`
-	Message string `json:"message"`
-	Data    any    `json:"data,omitempty"`
-	Meta    any    `json:"meta,omitempty"`
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Errors  []errors.FieldError `json:"errors,omitempty"`
+	Data    any                 `json:"data,omitempty"`
+	Meta    any                 `json:"meta,omitempty"`
 }
@@ -7,11 +7,15 @@ const (
 	UNAUTHORIZED          = "UNAUTHORIZED"
 	FORBIDDEN             = "FORBIDDEN"
 	NOT_FOUND             = "NOT_FOUND"
+	CONFLICT              = "CONFLICT"
 	UNPROCESSABLE_ENTITY  = "UNPROCESSABLE_ENTITY"
 	EXPECTATION_FAILED    = "EXPECTATION_FAILED"
 	INTERNAL_SERVER_ERROR = "INTERNAL_SERVER_ERROR"
 
 	// custom status
-	ALREADY_EXIST     = "ALREADY_EXIST"
-	ALREADY_SIGNED_IN = "ALREADY_SIGNED_IN"
+	ALREADY_EXIST          = "ALREADY_EXIST"
+	ALREADY_SIGNED_IN      = "ALREADY_SIGNED_IN"
+	SEAT_UNAVAILABLE       = "SEAT_UNAVAILABLE"
+	PROMO_CODE_UNAVAILABLE = "PROMO_CODE_UNAVAILABLE"
+	SOLD_OUT               = "SOLD_OUT"
 )
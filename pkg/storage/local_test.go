@@ -0,0 +1,40 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsel-ticketmaster/tm-event/pkg/storage"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := storage.NewLocalStorage(dir, "http://localhost:9000/tm-event/v1/media/")
+	ctx := context.Background()
+
+	t.Run("put, url and delete", func(t *testing.T) {
+		key := "events/EVENT1/MEDIA1/original.jpg"
+
+		assert.NoError(t, s.Put(ctx, key, "image/jpeg", []byte("content")))
+
+		content, err := os.ReadFile(filepath.Join(dir, "events", "EVENT1", "MEDIA1", "original.jpg"))
+		assert.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+		assert.Equal(t, "http://localhost:9000/tm-event/v1/media/events/EVENT1/MEDIA1/original.jpg", s.URL(key))
+
+		assert.NoError(t, s.Delete(ctx, key))
+		assert.NoError(t, s.Delete(ctx, key))
+
+		_, err = os.Stat(filepath.Join(dir, "events", "EVENT1", "MEDIA1", "original.jpg"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "../outside.jpg", "events/../../outside.jpg", "events//a.jpg"} {
+			assert.ErrorIs(t, s.Put(ctx, key, "image/jpeg", []byte("content")), storage.ErrInvalidKey, key)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+// Package storage stores the uploaded files, e.g. the images of the events, under a key and resolves their urls.
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidKey is returned when a key is empty, absolute or escapes the root of the storage.
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// Storage is a collection of behavior of a file storage. The keys are slash separated paths, e.g.
+// "events/EVENT1/MEDIA1/original.jpg".
+type Storage interface {
+	// Put stores the content under the key, an existing content is replaced.
+	Put(ctx context.Context, key string, contentType string, content []byte) error
+	// Delete removes the content stored under the key, a missing content is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the url at which the content stored under the key is served.
+	URL(key string) string
+}
@@ -0,0 +1,23 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// maxFormattedAddressLength is far longer than any postal address, longer values are not addresses.
+const maxFormattedAddressLength = 512
+
+// ValidateFormattedAddress validates a single-line, human-readable address such as "Jl. Pintu Satu Senayan, Gelora,
+// Jakarta Pusat". It must have some non-space text and carry no line breaks or other control characters.
+func ValidateFormattedAddress(fl validator.FieldLevel) bool {
+	address := fl.Field().String()
+
+	if strings.TrimSpace(address) == "" || len(address) > maxFormattedAddressLength {
+		return false
+	}
+
+	return strings.IndexFunc(address, unicode.IsControl) < 0
+}
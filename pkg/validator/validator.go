@@ -1,26 +1,95 @@
 package validator
 
 import (
+	"reflect"
+	"strings"
 	"sync"
 
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	id_translations "github.com/go-playground/validator/v10/translations/id"
+	"github.com/tsel-ticketmaster/tm-event/pkg/money"
 )
 
+// DefaultLocale is the locale of the messages of the validation errors when no other locale is available.
+const DefaultLocale = "en"
+
 var (
 	vld         *validator.Validate
+	uni         *ut.UniversalTranslator
 	vldSyncOnce sync.Once
 )
 
-func new() *validator.Validate {
+func new() (*validator.Validate, *ut.UniversalTranslator) {
 	vld := validator.New()
+	vld.RegisterValidation("money", money.Validate)
+	vld.RegisterValidation("positive_money", money.ValidatePositive)
+	vld.RegisterValidation("formatted_address", ValidateFormattedAddress)
 
-	return vld
+	// the fields of the validation errors are named after the json names of the request
+	vld.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+
+		return name
+	})
+
+	enLocale := en.New()
+	uni := ut.New(enLocale, enLocale, id.New())
+
+	enTrans, _ := uni.GetTranslator("en")
+	en_translations.RegisterDefaultTranslations(vld, enTrans)
+	registerTranslations(vld, enTrans, map[string]string{
+		"money":             "{0} must be an amount with a valid currency",
+		"positive_money":    "{0} must be a positive amount with a valid currency",
+		"formatted_address": "{0} must be a formatted address",
+		"iso4217":           "{0} must be a valid ISO 4217 currency code",
+	})
+
+	idTrans, _ := uni.GetTranslator("id")
+	id_translations.RegisterDefaultTranslations(vld, idTrans)
+	registerTranslations(vld, idTrans, map[string]string{
+		"money":             "{0} harus berupa nominal dengan mata uang yang valid",
+		"positive_money":    "{0} harus berupa nominal positif dengan mata uang yang valid",
+		"formatted_address": "{0} harus berupa alamat yang lengkap",
+		"iso4217":           "{0} harus berupa kode mata uang ISO 4217 yang valid",
+	})
+
+	return vld, uni
+}
+
+// registerTranslations registers the messages of the tags which are not translated by default.
+func registerTranslations(vld *validator.Validate, trans ut.Translator, messages map[string]string) {
+	for tag, message := range messages {
+		message := message
+		vld.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, message, true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T(fe.Tag(), fe.Field())
+			return t
+		})
+	}
 }
 
 func Get() *validator.Validate {
 	vldSyncOnce.Do(func() {
-		vld = new()
+		vld, uni = new()
 	})
 
 	return vld
 }
+
+// Translator returns the translator of the messages of the validation errors in the given locale, it falls back to
+// the default locale.
+func Translator(locale string) ut.Translator {
+	Get()
+
+	trans, _ := uni.FindTranslator(locale, DefaultLocale)
+
+	return trans
+}
@@ -0,0 +1,55 @@
+package xlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tsel-ticketmaster/tm-event/pkg/xlsx"
+)
+
+func readPart(t *testing.T, content []byte, name string) string {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	f, err := zr.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("the rows are written as inline strings of the sheet", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w, err := xlsx.NewWriter(buf, "Attendees & Guests")
+		require.NoError(t, err)
+
+		require.NoError(t, w.Write([]string{"number", "name"}))
+		require.NoError(t, w.Write([]string{"TIX-1", "Budi <VIP>"}))
+		require.NoError(t, w.Close())
+
+		assert.Contains(t, readPart(t, buf.Bytes(), "xl/workbook.xml"), `<sheet name="Attendees &amp; Guests" sheetId="1" r:id="rId1"/>`)
+		assert.Contains(t, readPart(t, buf.Bytes(), "[Content_Types].xml"), `/xl/worksheets/sheet1.xml`)
+
+		sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+		assert.Contains(t, sheet, `<row r="1"><c t="inlineStr"><is><t xml:space="preserve">number</t></is></c>`)
+		assert.Contains(t, sheet, `<row r="2"><c t="inlineStr"><is><t xml:space="preserve">TIX-1</t></is></c><c t="inlineStr"><is><t xml:space="preserve">Budi &lt;VIP&gt;</t></is></c></row>`)
+		assert.Contains(t, sheet, `</sheetData></worksheet>`)
+	})
+
+	t.Run("a closed writer does not write", func(t *testing.T) {
+		w, err := xlsx.NewWriter(&bytes.Buffer{}, "Sheet1")
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		assert.ErrorIs(t, w.Write([]string{"TIX-1"}), xlsx.ErrClosed)
+		assert.ErrorIs(t, w.Close(), xlsx.ErrClosed)
+	})
+}